@@ -2,23 +2,41 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"github.com/GP-Hacks/kdt2024-charity/config"
+	"github.com/GP-Hacks/kdt2024-charity/internal/donation"
 	"github.com/GP-Hacks/kdt2024-charity/internal/grpc-server/handler"
+	"github.com/GP-Hacks/kdt2024-charity/internal/lifecycle"
+	"github.com/GP-Hacks/kdt2024-charity/internal/payment"
+	"github.com/GP-Hacks/kdt2024-charity/internal/seed"
 	"github.com/GP-Hacks/kdt2024-charity/internal/storage"
+	"github.com/GP-Hacks/kdt2024-charity/internal/subscriptions"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/migrate"
+	"github.com/GP-Hacks/kdt2024-commons/outbox"
 	"github.com/GP-Hacks/kdt2024-commons/prettylogger"
 	"github.com/streadway/amqp"
 	"google.golang.org/grpc"
 	"log/slog"
 	"net"
+	"os"
 )
 
 func main() {
 	cfg := config.MustLoad()
 	log := prettylogger.SetupLogger(cfg.Env)
+
+	if len(os.Args) > 1 {
+		if err := runCommand(cfg, log, os.Args[1], os.Args[2:]); err != nil {
+			os.Exit(1)
+		}
+		return
+	}
+
 	log.Info("Configuration and logger initialized", slog.String("environment", cfg.Env))
 	log.Info("Logger initialized")
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(auth.UnaryServerInterceptor()))
 
 	log.Info("Starting TCP listener", slog.String("address", cfg.Address))
 	l, err := net.Listen("tcp", cfg.Address)
@@ -51,7 +69,33 @@ func main() {
 		}
 	}()
 
-	handler.NewGRPCHandler(cfg, grpcServer, storage, log, ch)
+	for _, queue := range []string{cfg.QueueName, cfg.QueueNotifications} {
+		if _, err := ch.QueueDeclare(queue, true, false, false, false, nil); err != nil {
+			log.Error("Failed to declare a queue", slog.String("queue_name", queue), slog.String("error", err.Error()))
+			return
+		}
+	}
+
+	relay, err := outbox.NewRelay(storage, "charity-outbox-", ch, log, cfg.OutboxInterval)
+	if err != nil {
+		log.Error("Failed to setup outbox relay", slog.String("error", err.Error()))
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go relay.Run(ctx)
+
+	payments, err := setupPaymentProvider(cfg, log)
+	if err != nil {
+		log.Error("Failed to setup payment provider", slog.String("error", err.Error()))
+		return
+	}
+
+	donations := donation.NewProcessor(cfg, storage, payments, log)
+	go subscriptions.NewScheduler(cfg, storage, donations, log).Run(ctx)
+	go lifecycle.NewWorker(cfg, storage, donations, log).Run(ctx)
+
+	handler.NewGRPCHandler(cfg, grpcServer, storage, donations, log)
 	serveGRPC(grpcServer, l, log, cfg)
 }
 
@@ -63,18 +107,10 @@ func setupPostgreSQL(cfg *config.Config, log *slog.Logger) (*storage.PostgresSto
 	}
 	log.Info("PostgreSQL connected", slog.String("postgres_address", cfg.PostgresAddress))
 
-	if err := storage.CreateTables(context.Background()); err != nil {
-		log.Error("Error creating tables", slog.String("error", err.Error()))
+	if err := migrate.UpWithLog(context.Background(), storage.Migrate, log); err != nil {
 		return nil, err
 	}
-	log.Info("Tables created or already exist")
 
-	log.Info("Fetching and storing initial data")
-	if err := storage.FetchAndStoreData(context.Background()); err != nil {
-		log.Error("Failed to fetch and store initial data", slog.String("error", err.Error()))
-		return nil, err
-	}
-	log.Info("Initial data fetched and stored successfully")
 	return storage, nil
 }
 
@@ -96,6 +132,16 @@ func setupRabbitMQ(cfg *config.Config, log *slog.Logger) (*amqp.Connection, *amq
 	return conn, ch, nil
 }
 
+func setupPaymentProvider(cfg *config.Config, log *slog.Logger) (payment.Provider, error) {
+	switch cfg.PaymentProvider {
+	case "", "fake":
+		log.Warn("Using fake payment provider, donations are not charged")
+		return payment.NewFakeProvider(), nil
+	default:
+		return nil, fmt.Errorf("unknown payment provider %q", cfg.PaymentProvider)
+	}
+}
+
 func serveGRPC(grpcServer *grpc.Server, l net.Listener, log *slog.Logger, cfg *config.Config) {
 	log.Info("Starting gRPC server", slog.String("address", cfg.Address))
 	if err := grpcServer.Serve(l); err != nil {
@@ -104,3 +150,65 @@ func serveGRPC(grpcServer *grpc.Server, l net.Listener, log *slog.Logger, cfg *c
 		log.Info("gRPC server started successfully", slog.String("address", cfg.Address))
 	}
 }
+
+// runCommand runs a maintenance subcommand instead of starting the service.
+func runCommand(cfg *config.Config, log *slog.Logger, command string, args []string) error {
+	switch command {
+	case "migrate":
+		return runMigrate(cfg, log, args)
+	case "seed":
+		return runSeed(cfg, log, args)
+	default:
+		log.Error("Unknown command, use migrate or seed", slog.String("command", command))
+		return fmt.Errorf("unknown command %q", command)
+	}
+}
+
+// runMigrate runs the migrate subcommand, which applies, reverts or lists the migrations without starting the service.
+func runMigrate(cfg *config.Config, log *slog.Logger, args []string) error {
+	storage, err := storage.NewPostgresStorage(cfg.PostgresAddress + "?sslmode=disable")
+	if err != nil {
+		log.Error("Failed to connect to PostgreSQL", slog.String("error", err.Error()))
+		return err
+	}
+	defer storage.Close()
+
+	migrator, err := storage.Migrator()
+	if err != nil {
+		log.Error("Failed to load migrations", slog.String("error", err.Error()))
+		return err
+	}
+	if err := migrate.Run(context.Background(), migrator, args, os.Stdout); err != nil {
+		log.Error("Migrate command failed", slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
+
+// runSeed runs the seed subcommand, which stores the collections of the given fixture files, or of the bundled fixtures,
+// that are not stored yet. Seeding again adds nothing, so it is safe to run on every deployment.
+func runSeed(cfg *config.Config, log *slog.Logger, args []string) error {
+	storage, err := storage.NewPostgresStorage(cfg.PostgresAddress + "?sslmode=disable")
+	if err != nil {
+		log.Error("Failed to connect to PostgreSQL", slog.String("error", err.Error()))
+		return err
+	}
+	defer storage.Close()
+
+	if err := migrate.UpWithLog(context.Background(), storage.Migrate, log); err != nil {
+		return err
+	}
+
+	collections, err := seed.Load(args)
+	if err != nil {
+		log.Error("Failed to load fixtures", slog.String("error", err.Error()))
+		return err
+	}
+	added, err := storage.SeedCollections(context.Background(), collections)
+	if err != nil {
+		log.Error("Failed to seed collections", slog.String("error", err.Error()))
+		return err
+	}
+	log.Info("Collections seeded", slog.Int("added", added), slog.Int("skipped", len(collections)-added))
+	return nil
+}
@@ -1,21 +1,58 @@
 package config
 
-import "os"
+import (
+	"os"
+	"time"
+)
 
 type Config struct {
-	Env             string
-	Address         string
-	PostgresAddress string
-	RabbitMQAddress string
-	QueueName       string
+	Env                string
+	Address            string
+	PostgresAddress    string
+	RabbitMQAddress    string
+	QueueName          string
+	QueueNotifications string
+	PaymentProvider    string
+	MaxDonation        int
+	OutboxInterval     time.Duration
+	Location           *time.Location
+	// SubscriptionsInterval is how often the scheduler looks for due subscriptions.
+	SubscriptionsInterval time.Duration
+	// SubscriptionsLease is how long a claimed subscription is hidden from other schedulers while it is charged.
+	SubscriptionsLease time.Duration
+	SubscriptionsBatch int
+	// SubscriptionRetries is how many times a failed monthly charge is retried before the month is skipped.
+	SubscriptionRetries int
+	// SubscriptionRetryDelay is the delay before the first retry, it doubles with every next one.
+	SubscriptionRetryDelay time.Duration
+	LifecycleInterval      time.Duration
+	LifecycleBatch         int
+	// FundedCloseDelay is how long a funded collection keeps accepting donations before it is closed.
+	FundedCloseDelay time.Duration
+	// ArchiveAfter is how long a closed collection stays listed as closed before it is archived.
+	ArchiveAfter time.Duration
 }
 
 func MustLoad() *Config {
 	return &Config{
-		Env:             "local",
-		Address:         os.Getenv("SERVICE_ADDRESS"),
-		PostgresAddress: os.Getenv("POSTGRES_ADDRESS"),
-		RabbitMQAddress: os.Getenv("RABBITMQ_ADDRESS"),
-		QueueName:       os.Getenv("QUEUE_NAME"),
+		Env:                    "local",
+		Address:                os.Getenv("SERVICE_ADDRESS"),
+		PostgresAddress:        os.Getenv("POSTGRES_ADDRESS"),
+		RabbitMQAddress:        os.Getenv("RABBITMQ_ADDRESS"),
+		QueueName:              os.Getenv("QUEUE_NAME"),
+		QueueNotifications:     os.Getenv("QUEUE_NOTIFICATIONS"),
+		PaymentProvider:        os.Getenv("PAYMENT_PROVIDER"),
+		MaxDonation:            1000000,
+		OutboxInterval:         time.Second,
+		Location:               time.FixedZone("MSK", 3*60*60),
+		SubscriptionsInterval:  time.Minute,
+		SubscriptionsLease:     10 * time.Minute,
+		SubscriptionsBatch:     50,
+		SubscriptionRetries:    3,
+		SubscriptionRetryDelay: time.Hour,
+		LifecycleInterval:      time.Minute,
+		LifecycleBatch:         50,
+		FundedCloseDelay:       24 * time.Hour,
+		ArchiveAfter:           30 * 24 * time.Hour,
 	}
 }
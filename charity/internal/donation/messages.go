@@ -0,0 +1,39 @@
+package donation
+
+import (
+	"time"
+)
+
+type DonationMessage struct {
+	DonationID     int       `json:"donation_id"`
+	UserToken      string    `json:"user_token"`
+	CollectionID   int       `json:"collection_id"`
+	CollectionName string    `json:"collection_name"`
+	Organization   string    `json:"organization"`
+	DonationTime   time.Time `json:"donation_time"`
+	Amount         int       `json:"amount"`
+}
+
+type DonationRefundMessage struct {
+	DonationID int       `json:"donation_id"`
+	UserToken  string    `json:"user_token"`
+	RefundedAt time.Time `json:"refunded_at"`
+}
+
+type NotificationMessage struct {
+	UserID       string                             `json:"user_id"`
+	Key          string                             `json:"key,omitempty"`
+	Category     string                             `json:"category,omitempty"`
+	Header       string                             `json:"header"`
+	Content      string                             `json:"content"`
+	Time         time.Time                          `json:"time"`
+	Translations map[string]NotificationTranslation `json:"translations,omitempty"`
+}
+
+type NotificationTranslation struct {
+	Header  string `json:"header"`
+	Content string `json:"content"`
+}
+
+// NotificationCategory lets users opt out of donation notifications.
+const NotificationCategory = "donations"
@@ -0,0 +1,189 @@
+package donation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/GP-Hacks/kdt2024-charity/config"
+	"github.com/GP-Hacks/kdt2024-charity/internal/payment"
+	"github.com/GP-Hacks/kdt2024-charity/internal/storage"
+)
+
+// ErrFailed is returned when the charge with the requested reference has already failed.
+var ErrFailed = errors.New("donation failed")
+
+type Request struct {
+	CollectionID int
+	UserID       string
+	Amount       int
+	PaymentToken string
+	// SubscriptionID and Reference are set for scheduled charges. Charging a reference again continues the donation
+	// recorded for it, so a charge interrupted half way is neither lost nor made twice.
+	SubscriptionID int
+	Reference      string
+	// Notification is sent to the donor once the donation is captured, nil sends none.
+	Notification *NotificationMessage
+}
+
+// Processor charges donations through the payment provider and keeps their status and the collection totals in
+// step with it. It is shared by one-off donations and the subscriptions scheduler.
+type Processor struct {
+	cfg      *config.Config
+	storage  *storage.PostgresStorage
+	payments payment.Provider
+	logger   *slog.Logger
+}
+
+func NewProcessor(cfg *config.Config, storage *storage.PostgresStorage, payments payment.Provider, logger *slog.Logger) *Processor {
+	return &Processor{cfg: cfg, storage: storage, payments: payments, logger: logger}
+}
+
+// Charge authorizes and captures the donation. It returns pgx.ErrNoRows when the collection does not exist and
+// storage.ErrCollectionClosed when it does not accept donations. When the payment fails the failed donation is
+// returned together with the error, which wraps payment.ErrDeclined if the provider declined it.
+func (p *Processor) Charge(ctx context.Context, request Request) (*storage.Donation, error) {
+	donation, err := p.storage.CreateDonation(ctx, &storage.Donation{
+		CollectionID:   request.CollectionID,
+		UserToken:      request.UserID,
+		Amount:         request.Amount,
+		SubscriptionID: request.SubscriptionID,
+		Reference:      request.Reference,
+	})
+	if err != nil {
+		return nil, err
+	}
+	id := donation.ID
+	logger := p.logger.With(slog.Int("donation_id", id), slog.Int("collection_id", donation.CollectionID), slog.Int("amount", donation.Amount))
+
+	// Once the provider holds the money the donation must reach a final status even if the caller goes away.
+	ctx = context.WithoutCancel(ctx)
+
+	if donation.Status == storage.DonationPending {
+		reference := request.Reference
+		if reference == "" {
+			reference = fmt.Sprintf("donation-%d", id)
+		}
+		paymentID, err := p.payments.Authorize(ctx, payment.Request{
+			Reference: reference,
+			UserID:    request.UserID,
+			Amount:    donation.Amount,
+			Token:     request.PaymentToken,
+		})
+		if err != nil {
+			return p.fail(ctx, logger, id, "", err), fmt.Errorf("failed to authorize payment: %w", err)
+		}
+
+		donation, err = p.storage.AuthorizeDonation(ctx, id, paymentID)
+		if err != nil {
+			p.fail(ctx, logger, id, paymentID, err)
+			return nil, err
+		}
+	}
+
+	switch donation.Status {
+	case storage.DonationAuthorized:
+	case storage.DonationFailed:
+		return donation, fmt.Errorf("%w: %s", ErrFailed, donation.FailureReason)
+	default:
+		return donation, nil
+	}
+
+	paymentID := donation.PaymentID
+	if err := p.payments.Capture(ctx, paymentID); err != nil {
+		return p.fail(ctx, logger, id, paymentID, err), fmt.Errorf("failed to capture payment: %w", err)
+	}
+
+	captured, err := p.storage.CaptureDonation(ctx, id, p.capturedMessages(request))
+	if err != nil {
+		// The money is charged but not counted, give it back rather than leave the totals inconsistent.
+		logger.Error("Failed to save captured donation, refunding payment", slog.String("error", err.Error()))
+		if err := p.payments.Refund(ctx, paymentID); err != nil {
+			logger.Error("Failed to refund payment of unsaved donation", slog.String("payment_id", paymentID), slog.String("error", err.Error()))
+		} else if _, err := p.storage.FailDonation(ctx, id, "failed to save captured donation"); err != nil {
+			logger.Error("Failed to mark donation as failed", slog.String("error", err.Error()))
+		}
+		return nil, err
+	}
+
+	logger.Info("Donation processed successfully", slog.String("user_token", request.UserID))
+	return captured, nil
+}
+
+// Refund gives the money of the captured donation back and subtracts it from the collection.
+func (p *Processor) Refund(ctx context.Context, donation *storage.Donation) (*storage.Donation, error) {
+	ctx = context.WithoutCancel(ctx)
+	if err := p.payments.Refund(ctx, donation.PaymentID); err != nil {
+		return nil, fmt.Errorf("failed to refund payment: %w", err)
+	}
+	return p.storage.RefundDonation(ctx, donation.ID, p.refundMessages)
+}
+
+// fail marks the donation as failed after an error and releases the payment when it was authorized. It returns the
+// failed donation, nil if it could not be marked.
+func (p *Processor) fail(ctx context.Context, logger *slog.Logger, donationID int, paymentID string, cause error) *storage.Donation {
+	logger.Warn("Donation failed", slog.String("error", cause.Error()))
+	if paymentID != "" {
+		if err := p.payments.Void(ctx, paymentID); err != nil {
+			logger.Error("Failed to void payment", slog.String("payment_id", paymentID), slog.String("error", err.Error()))
+		}
+	}
+	failed, err := p.storage.FailDonation(ctx, donationID, cause.Error())
+	if err != nil {
+		logger.Error("Failed to mark donation as failed", slog.String("error", err.Error()))
+		return nil
+	}
+	return failed
+}
+
+// capturedMessages builds the purchases record of a captured donation and the donor's notification if requested.
+func (p *Processor) capturedMessages(request Request) func(donation *storage.Donation) ([]*storage.OutboxMessage, error) {
+	return func(donation *storage.Donation) ([]*storage.OutboxMessage, error) {
+		payload, err := json.Marshal(DonationMessage{
+			DonationID:     donation.ID,
+			UserToken:      donation.UserToken,
+			CollectionID:   donation.CollectionID,
+			CollectionName: donation.CollectionName,
+			Organization:   donation.Organization,
+			DonationTime:   donation.UpdatedAt,
+			Amount:         donation.Amount,
+		})
+		if err != nil {
+			return nil, err
+		}
+		messages := []*storage.OutboxMessage{{Queue: p.cfg.QueueName, Payload: payload}}
+
+		if request.Notification == nil {
+			return messages, nil
+		}
+		notification, err := p.NotificationOutbox(request.Notification)
+		if err != nil {
+			return nil, err
+		}
+		return append(messages, notification), nil
+	}
+}
+
+// refundMessages tells purchases to drop the donation from the donor's receipts and statements.
+func (p *Processor) refundMessages(donation *storage.Donation) ([]*storage.OutboxMessage, error) {
+	payload, err := json.Marshal(DonationRefundMessage{
+		DonationID: donation.ID,
+		UserToken:  donation.UserToken,
+		RefundedAt: donation.UpdatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []*storage.OutboxMessage{{Queue: p.cfg.QueueName, Payload: payload}}, nil
+}
+
+// NotificationOutbox wraps the notification for the notifications queue.
+func (p *Processor) NotificationOutbox(notification *NotificationMessage) (*storage.OutboxMessage, error) {
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		return nil, err
+	}
+	return &storage.OutboxMessage{Queue: p.cfg.QueueNotifications, Payload: payload}, nil
+}
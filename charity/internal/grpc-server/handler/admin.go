@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/url"
+	"time"
+	"unicode/utf8"
+
+	"github.com/GP-Hacks/kdt2024-charity/internal/storage"
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *GRPCHandler) CreateCollection(ctx context.Context, request *proto.CreateCollectionRequest) (*proto.CollectionResponse, error) {
+	h.logger.Debug("Received CreateCollection request", slog.Any("request", request))
+
+	actor, err := h.requireAdmin(ctx, "CreateCollection")
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := collectionFromInput(request.GetCollection())
+	if err != nil {
+		return nil, err
+	}
+	if collection.Deadline != nil && !collection.Deadline.After(time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "Deadline must be in the future")
+	}
+
+	created, err := h.storage.CreateCollection(ctx, actor, collection)
+	if err != nil {
+		return nil, h.handleStorageError(err, "collection")
+	}
+
+	h.logger.Info("Collection created", slog.String("actor", actor), slog.Int("collection_id", created.ID))
+	return &proto.CollectionResponse{Response: "Collection created", Collection: toProtoCollection(created)}, nil
+}
+
+func (h *GRPCHandler) UpdateCollection(ctx context.Context, request *proto.UpdateCollectionRequest) (*proto.CollectionResponse, error) {
+	h.logger.Debug("Received UpdateCollection request", slog.Any("request", request))
+
+	actor, err := h.requireAdmin(ctx, "UpdateCollection")
+	if err != nil {
+		return nil, err
+	}
+
+	if request.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid collection id")
+	}
+	collection, err := collectionFromInput(request.GetCollection())
+	if err != nil {
+		return nil, err
+	}
+	collection.ID = int(request.GetId())
+
+	// A deadline in the past is allowed, it closes the collection on the next lifecycle run.
+	updated, err := h.storage.UpdateCollection(ctx, actor, collection)
+	if err != nil {
+		return nil, h.handleStorageError(err, "collection")
+	}
+
+	h.logger.Info("Collection updated", slog.String("actor", actor), slog.Int("collection_id", updated.ID))
+	return &proto.CollectionResponse{Response: "Collection updated", Collection: toProtoCollection(updated)}, nil
+}
+
+func (h *GRPCHandler) DeleteCollection(ctx context.Context, request *proto.DeleteCollectionRequest) (*proto.DeleteCollectionResponse, error) {
+	h.logger.Debug("Received DeleteCollection request", slog.Any("request", request))
+
+	actor, err := h.requireAdmin(ctx, "DeleteCollection")
+	if err != nil {
+		return nil, err
+	}
+
+	id := int(request.GetId())
+	if id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid collection id")
+	}
+
+	if err := h.storage.DeleteCollection(ctx, actor, id); err != nil {
+		return nil, h.handleStorageError(err, "collection")
+	}
+
+	h.logger.Info("Collection deleted", slog.String("actor", actor), slog.Int("collection_id", id))
+	return &proto.DeleteCollectionResponse{Response: "Collection deleted"}, nil
+}
+
+// requireAdmin returns the ID of the admin making the request.
+func (h *GRPCHandler) requireAdmin(ctx context.Context, method string) (string, error) {
+	select {
+	case <-ctx.Done():
+		h.logger.Warn(method + " request was cancelled by client")
+		return "", status.Errorf(codes.Canceled, "Request was cancelled")
+	default:
+	}
+
+	actor, err := auth.RequireRole(ctx, auth.RoleAdmin)
+	if err != nil {
+		h.logger.Warn(method+" request is not allowed", slog.String("error", err.Error()))
+		return "", err
+	}
+	return actor, nil
+}
+
+// collectionFromInput validates the editable fields of a collection.
+func collectionFromInput(input *proto.CollectionInput) (*storage.Collection, error) {
+	if input == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Collection is required")
+	}
+
+	required := []struct{ name, value string }{
+		{"category", input.GetCategory()},
+		{"name", input.GetName()},
+		{"description", input.GetDescription()},
+		{"organization", input.GetOrganization()},
+	}
+	for _, field := range required {
+		if field.value == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "Field %s is required", field.name)
+		}
+	}
+	if utf8.RuneCountInString(input.GetCategory()) > 255 {
+		return nil, status.Errorf(codes.InvalidArgument, "Category must be at most 255 characters")
+	}
+	if utf8.RuneCountInString(input.GetPhone()) > 50 {
+		return nil, status.Errorf(codes.InvalidArgument, "Phone must be at most 50 characters")
+	}
+	if input.GetWebsite() != "" && (!isURL(input.GetWebsite()) || utf8.RuneCountInString(input.GetWebsite()) > 255) {
+		return nil, status.Errorf(codes.InvalidArgument, "Website must be an http(s) URL of at most 255 characters")
+	}
+	if input.GetPhoto() != "" && !isURL(input.GetPhoto()) {
+		return nil, status.Errorf(codes.InvalidArgument, "Photo must be an http(s) URL")
+	}
+	if input.GetGoal() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Goal must be positive")
+	}
+
+	collection := &storage.Collection{
+		Category:     input.GetCategory(),
+		Name:         input.GetName(),
+		Description:  input.GetDescription(),
+		Organization: input.GetOrganization(),
+		Phone:        input.GetPhone(),
+		Website:      input.GetWebsite(),
+		Goal:         int(input.GetGoal()),
+		Photo:        input.GetPhoto(),
+	}
+	if input.GetDeadline() != nil {
+		deadline := input.GetDeadline().AsTime()
+		collection.Deadline = &deadline
+	}
+	return collection, nil
+}
+
+func isURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
@@ -2,38 +2,33 @@ package handler
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"time"
 
 	"github.com/GP-Hacks/kdt2024-charity/config"
+	"github.com/GP-Hacks/kdt2024-charity/internal/donation"
+	"github.com/GP-Hacks/kdt2024-charity/internal/payment"
 	"github.com/GP-Hacks/kdt2024-charity/internal/storage"
 	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/pagination"
 	"github.com/jackc/pgx/v5"
-	"github.com/streadway/amqp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"log/slog"
 )
 
-type DonationMessage struct {
-	UserToken    string    `json:"user_token"`
-	CollectionID int       `json:"collection_id"`
-	DonationTime time.Time `json:"donation_time"`
-	Amount       int       `json:"amount"`
-}
-
 type GRPCHandler struct {
 	cfg *config.Config
 	proto.UnimplementedCharityServiceServer
-	storage *storage.PostgresStorage
-	logger  *slog.Logger
-	mqch    *amqp.Channel
+	storage   *storage.PostgresStorage
+	donations *donation.Processor
+	logger    *slog.Logger
 }
 
-func NewGRPCHandler(cfg *config.Config, server *grpc.Server, storage *storage.PostgresStorage, logger *slog.Logger, mqch *amqp.Channel) *GRPCHandler {
-	handler := &GRPCHandler{cfg: cfg, storage: storage, logger: logger, mqch: mqch}
+func NewGRPCHandler(cfg *config.Config, server *grpc.Server, storage *storage.PostgresStorage, donations *donation.Processor, logger *slog.Logger) *GRPCHandler {
+	handler := &GRPCHandler{cfg: cfg, storage: storage, donations: donations, logger: logger}
 	proto.RegisterCharityServiceServer(server, handler)
 	logger.Info("GRPCHandler initialized", slog.String("address", cfg.Address))
 	return handler
@@ -49,37 +44,83 @@ func (h *GRPCHandler) GetCollections(ctx context.Context, request *proto.GetColl
 	default:
 	}
 
-	var collections []*storage.Collection
-	var err error
+	states := request.GetStates()
+	if len(states) == 0 {
+		states = storage.OpenCollectionStates
+	}
+	for _, state := range states {
+		if !isCollectionState(state) {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown collection state %q", state)
+		}
+	}
 
 	category := request.GetCategory()
 	if category == "all" {
-		collections, err = h.storage.GetCollections(ctx)
-	} else {
-		collections, err = h.storage.GetCollectionsByCategory(ctx, category)
+		category = ""
+	}
+
+	query := &storage.CollectionsQuery{Category: category, States: states}
+	var err error
+	if query.Sort, err = pagination.ParseSort(request.GetSort(), storage.CollectionSortID, storage.CollectionSortID, storage.CollectionSortProgress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if query.Limit, err = pagination.PageSize(request.GetPageSize()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if query.AfterID, err = pagination.Decode(request.GetPageToken(), query.Sort, &query.AfterProgress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	collections, hasMore, err := h.storage.GetCollections(ctx, query)
 	if err != nil {
 		return nil, h.handleStorageError(err, "collections")
 	}
 
 	var responseCollections []*proto.Collection
 	for _, collection := range collections {
-		responseCollections = append(responseCollections, &proto.Collection{
-			Id:           int32(collection.ID),
-			Category:     collection.Category,
-			Name:         collection.Name,
-			Description:  collection.Description,
-			Organization: collection.Organization,
-			Phone:        collection.Phone,
-			Website:      collection.Website,
-			Goal:         int32(collection.Goal),
-			Current:      int32(collection.Current),
-			Photo:        collection.Photo,
-		})
-	}
-
-	return &proto.GetCollectionsResponse{Response: responseCollections}, nil
+		responseCollections = append(responseCollections, toProtoCollection(collection))
+	}
+
+	response := &proto.GetCollectionsResponse{Response: responseCollections}
+	if hasMore {
+		last := collections[len(collections)-1]
+		var key interface{}
+		if query.Sort.Key == storage.CollectionSortProgress {
+			key = last.Progress()
+		}
+		if response.NextPageToken, err = pagination.Encode(query.Sort, key, last.ID); err != nil {
+			h.logger.Error("Failed to encode page token", slog.String("error", err.Error()))
+			return nil, status.Errorf(codes.Internal, "An internal error occurred, please try again later")
+		}
+	}
+
+	return response, nil
+}
+
+func toProtoCollection(collection *storage.Collection) *proto.Collection {
+	result := &proto.Collection{
+		Id:           int32(collection.ID),
+		Category:     collection.Category,
+		Name:         collection.Name,
+		Description:  collection.Description,
+		Organization: collection.Organization,
+		Phone:        collection.Phone,
+		Website:      collection.Website,
+		Goal:         int32(collection.Goal),
+		Current:      int32(collection.Current),
+		Photo:        collection.Photo,
+		State:        collection.State,
+	}
+	if collection.Deadline != nil {
+		result.Deadline = timestamppb.New(*collection.Deadline)
+	}
+	if collection.FundedAt != nil {
+		result.FundedAt = timestamppb.New(*collection.FundedAt)
+	}
+	if collection.ClosedAt != nil {
+		result.ClosedAt = timestamppb.New(*collection.ClosedAt)
+	}
+	return result
 }
 
 func (h *GRPCHandler) GetCategories(ctx context.Context, request *proto.GetCategoriesRequest) (*proto.GetCategoriesResponse, error) {
@@ -110,79 +151,107 @@ func (h *GRPCHandler) Donate(ctx context.Context, request *proto.DonateRequest)
 	default:
 	}
 
-	donationMessage := DonationMessage{
-		UserToken:    request.GetToken(),
-		CollectionID: int(request.GetCollectionId()),
-		DonationTime: time.Now(),
-		Amount:       int(request.GetAmount()),
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		h.logger.Warn("Donate request is not authenticated")
+		return nil, err
 	}
 
-	h.logger.Info("Publishing donation to RabbitMQ", slog.String("queue_name", h.cfg.QueueName))
-	if err := h.publishToRabbitMQ(donationMessage, h.cfg.QueueName); err != nil {
-		h.logger.Error("Failed to publish donation to RabbitMQ", slog.Any("error", err.Error()))
-		return nil, status.Errorf(codes.Internal, "Failed to process donation, please try again later")
+	collectionID := int(request.GetCollectionId())
+	amount := int(request.GetAmount())
+	if collectionID <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid collection id")
+	}
+	if amount <= 0 || amount > h.cfg.MaxDonation {
+		return nil, status.Errorf(codes.InvalidArgument, "Amount must be between 1 and %d", h.cfg.MaxDonation)
 	}
 
-	h.logger.Info("Updating collection in database", slog.Int("collection_id", donationMessage.CollectionID), slog.Int("amount", donationMessage.Amount))
-	if err := h.storage.UpdateCollection(ctx, donationMessage.CollectionID, donationMessage.Amount); err != nil {
-		h.logger.Error("Failed to update collection in database", slog.Any("error", err.Error()))
+	charged, err := h.donations.Charge(ctx, donation.Request{
+		CollectionID: collectionID,
+		UserID:       userID,
+		Amount:       amount,
+		PaymentToken: request.GetPaymentToken(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, h.handleStorageError(err, "collection")
+	}
+	if errors.Is(err, storage.ErrCollectionClosed) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Collection is not accepting donations")
+	}
+	if errors.Is(err, payment.ErrDeclined) && charged != nil {
+		// The declined donation is recorded as failed, which is an answer rather than an error.
+		return &proto.DonateResponse{
+			Response:   "Payment was declined",
+			DonationId: int32(charged.ID),
+			Status:     charged.Status,
+		}, nil
+	}
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to process donation, please try again later")
 	}
 
-	h.logger.Info("Donation processed successfully", slog.String("user_token", donationMessage.UserToken), slog.Int("collection_id", donationMessage.CollectionID), slog.Int("amount", donationMessage.Amount))
-
 	return &proto.DonateResponse{
-		Response: "Thank you for your donation!",
+		Response:   "Thank you for your donation!",
+		DonationId: int32(charged.ID),
+		Status:     charged.Status,
 	}, nil
 }
 
-func (h *GRPCHandler) HealthCheck(ctx context.Context, req *proto.HealthCheckRequest) (*proto.HealthCheckResponse, error) {
-	h.logger.Debug("Received HealthCheck request")
+func (h *GRPCHandler) RefundDonation(ctx context.Context, request *proto.RefundDonationRequest) (*proto.RefundDonationResponse, error) {
+	h.logger.Debug("Received RefundDonation request", slog.Any("request", request))
 
-	h.logger.Info("HealthCheck passed")
-	return &proto.HealthCheckResponse{IsHealthy: true}, nil
-}
+	select {
+	case <-ctx.Done():
+		h.logger.Warn("RefundDonation request was cancelled by client")
+		return nil, status.Errorf(codes.Canceled, "Request was cancelled")
+	default:
+	}
 
-func (h *GRPCHandler) publishToRabbitMQ(message interface{}, queueName string) error {
-	h.logger.Debug("Declaring RabbitMQ queue", slog.String("queue_name", queueName))
-	q, err := h.mqch.QueueDeclare(
-		queueName,
-		true,
-		false,
-		false,
-		false,
-		nil,
-	)
+	staffID, err := auth.RequireRole(ctx, auth.RoleStaff)
 	if err != nil {
-		h.logger.Error("Failed to declare RabbitMQ queue", slog.String("queue_name", queueName), slog.Any("error", err.Error()))
-		return err
+		h.logger.Warn("RefundDonation request is not allowed")
+		return nil, err
 	}
 
-	h.logger.Debug("Marshalling message to JSON")
-	body, err := json.Marshal(message)
+	donation, err := h.storage.GetDonation(ctx, int(request.GetDonationId()))
 	if err != nil {
-		h.logger.Error("Failed to marshal message to JSON", slog.Any("error", err.Error()))
-		return err
-	}
-
-	h.logger.Debug("Publishing message to RabbitMQ", slog.String("queue_name", q.Name))
-	err = h.mqch.Publish(
-		"",
-		q.Name,
-		false,
-		false,
-		amqp.Publishing{
-			ContentType: "application/json",
-			Body:        body,
-		},
-	)
+		return nil, h.handleStorageError(err, "donation")
+	}
+	if donation.Status != storage.DonationCaptured {
+		return nil, status.Errorf(codes.FailedPrecondition, "Only captured donations can be refunded, donation is %s", donation.Status)
+	}
+	logger := h.logger.With(slog.Int("donation_id", donation.ID), slog.String("staff_id", staffID))
+
+	refunded, err := h.donations.Refund(ctx, donation)
+	if errors.Is(err, payment.ErrPaymentNotFound) || errors.Is(err, payment.ErrInvalidState) {
+		logger.Error("Failed to refund payment", slog.String("payment_id", donation.PaymentID), slog.String("error", err.Error()))
+		return nil, status.Errorf(codes.FailedPrecondition, "Payment of the donation cannot be refunded")
+	}
+	if errors.Is(err, storage.ErrInvalidTransition) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Donation was changed concurrently, please try again")
+	}
 	if err != nil {
-		h.logger.Error("Failed to publish message to RabbitMQ", slog.String("queue_name", q.Name), slog.Any("error", err.Error()))
-		return err
+		logger.Error("Failed to refund donation", slog.String("error", err.Error()))
+		return nil, status.Errorf(codes.Internal, "Failed to refund donation, please try again later")
 	}
 
-	h.logger.Info("Message published to RabbitMQ successfully", slog.String("queue_name", q.Name))
-	return nil
+	logger.Info("Donation refunded", slog.Int("amount", refunded.Amount))
+	return &proto.RefundDonationResponse{Response: "Donation refunded", Status: refunded.Status}, nil
+}
+
+func isCollectionState(state string) bool {
+	switch state {
+	case storage.CollectionActive, storage.CollectionFunded, storage.CollectionClosed, storage.CollectionArchived:
+		return true
+	}
+	return false
+}
+
+func (h *GRPCHandler) HealthCheck(ctx context.Context, req *proto.HealthCheckRequest) (*proto.HealthCheckResponse, error) {
+	h.logger.Debug("Received HealthCheck request")
+
+	h.logger.Info("HealthCheck passed")
+	return &proto.HealthCheckResponse{IsHealthy: true}, nil
 }
 
 func (h *GRPCHandler) handleStorageError(err error, entity string) error {
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"context"
+	"github.com/GP-Hacks/kdt2024-charity/internal/storage"
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/search"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+)
+
+func (h *GRPCHandler) Search(ctx context.Context, request *proto.SearchRequest) (*proto.SearchResponse, error) {
+	h.logger.Debug("Received Search request", slog.Any("request", request))
+
+	select {
+	case <-ctx.Done():
+		h.logger.Warn("Search request was cancelled by client")
+		return nil, status.Errorf(codes.Canceled, "Request was cancelled")
+	default:
+	}
+
+	query, limit, err := search.Query(request.GetQuery(), request.GetLimit())
+	if err != nil {
+		h.logger.Warn("Invalid Search request", slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	results, err := h.storage.Search(ctx, query, storage.OpenCollectionStates, limit, search.HeadlineOptions)
+	if err != nil {
+		return nil, h.handleStorageError(err, "search results")
+	}
+
+	response := &proto.SearchResponse{Results: make([]*proto.SearchResult, 0, len(results))}
+	for _, result := range results {
+		response.Results = append(response.Results, &proto.SearchResult{
+			Id:       int32(result.ID),
+			Category: result.Category,
+			Name:     result.Name,
+			Photo:    result.Photo,
+			Headline: search.Highlight(result.Headline),
+			Rank:     result.Rank,
+		})
+	}
+
+	h.logger.Info("Search completed", slog.Int("results", len(response.Results)))
+	return response, nil
+}
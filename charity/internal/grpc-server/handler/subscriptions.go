@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/GP-Hacks/kdt2024-charity/internal/storage"
+	"github.com/GP-Hacks/kdt2024-charity/internal/subscriptions"
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *GRPCHandler) CreateSubscription(ctx context.Context, request *proto.CreateSubscriptionRequest) (*proto.SubscriptionResponse, error) {
+	h.logger.Debug("Received CreateSubscription request", slog.Any("request", request))
+
+	select {
+	case <-ctx.Done():
+		h.logger.Warn("CreateSubscription request was cancelled by client")
+		return nil, status.Errorf(codes.Canceled, "Request was cancelled")
+	default:
+	}
+
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		h.logger.Warn("CreateSubscription request is not authenticated")
+		return nil, err
+	}
+
+	collectionID := int(request.GetCollectionId())
+	amount := int(request.GetAmount())
+	if collectionID <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid collection id")
+	}
+	if amount <= 0 || amount > h.cfg.MaxDonation {
+		return nil, status.Errorf(codes.InvalidArgument, "Amount must be between 1 and %d", h.cfg.MaxDonation)
+	}
+	if request.GetPaymentToken() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Payment token is required")
+	}
+
+	// The first month is charged right away by the scheduler, the next ones on the same day of month.
+	now := time.Now().In(h.cfg.Location)
+	subscription, err := h.storage.CreateSubscription(ctx, &storage.Subscription{
+		CollectionID: collectionID,
+		UserToken:    userID,
+		Amount:       amount,
+		PaymentToken: request.GetPaymentToken(),
+		BillingDay:   now.Day(),
+		Period:       now,
+	})
+	if errors.Is(err, storage.ErrCollectionClosed) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Collection is not accepting donations")
+	}
+	if err != nil {
+		return nil, h.handleStorageError(err, "collection")
+	}
+
+	h.logger.Info("Subscription created", slog.Int("subscription_id", subscription.ID), slog.Int("collection_id", collectionID), slog.Int("amount", amount))
+	return &proto.SubscriptionResponse{Response: "Subscription created", Subscription: toProtoSubscription(subscription)}, nil
+}
+
+func (h *GRPCHandler) GetSubscriptions(ctx context.Context, request *proto.GetSubscriptionsRequest) (*proto.GetSubscriptionsResponse, error) {
+	h.logger.Debug("Received GetSubscriptions request", slog.Any("request", request))
+
+	select {
+	case <-ctx.Done():
+		h.logger.Warn("GetSubscriptions request was cancelled by client")
+		return nil, status.Errorf(codes.Canceled, "Request was cancelled")
+	default:
+	}
+
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		h.logger.Warn("GetSubscriptions request is not authenticated")
+		return nil, err
+	}
+
+	subscriptions, err := h.storage.GetSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, h.handleStorageError(err, "subscriptions")
+	}
+
+	response := make([]*proto.Subscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		response = append(response, toProtoSubscription(subscription))
+	}
+	return &proto.GetSubscriptionsResponse{Response: response}, nil
+}
+
+func (h *GRPCHandler) PauseSubscription(ctx context.Context, request *proto.SubscriptionActionRequest) (*proto.SubscriptionResponse, error) {
+	return h.changeSubscription(ctx, "PauseSubscription", request, storage.SubscriptionPaused, "Subscription paused")
+}
+
+func (h *GRPCHandler) ResumeSubscription(ctx context.Context, request *proto.SubscriptionActionRequest) (*proto.SubscriptionResponse, error) {
+	return h.changeSubscription(ctx, "ResumeSubscription", request, storage.SubscriptionActive, "Subscription resumed")
+}
+
+func (h *GRPCHandler) CancelSubscription(ctx context.Context, request *proto.SubscriptionActionRequest) (*proto.SubscriptionResponse, error) {
+	return h.changeSubscription(ctx, "CancelSubscription", request, storage.SubscriptionCancelled, "Subscription cancelled")
+}
+
+// changeSubscription moves the caller's subscription to status. A resumed subscription is charged next on the
+// first billing date that has not passed yet.
+func (h *GRPCHandler) changeSubscription(ctx context.Context, method string, request *proto.SubscriptionActionRequest, to string, response string) (*proto.SubscriptionResponse, error) {
+	h.logger.Debug("Received "+method+" request", slog.Any("request", request))
+
+	select {
+	case <-ctx.Done():
+		h.logger.Warn(method + " request was cancelled by client")
+		return nil, status.Errorf(codes.Canceled, "Request was cancelled")
+	default:
+	}
+
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		h.logger.Warn(method + " request is not authenticated")
+		return nil, err
+	}
+
+	id := int(request.GetSubscriptionId())
+	if id <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid subscription id")
+	}
+
+	var period *time.Time
+	if to == storage.SubscriptionActive {
+		current, err := h.storage.GetSubscription(ctx, id, userID)
+		if err != nil {
+			return nil, h.handleStorageError(err, "subscription")
+		}
+		resumed := subscriptions.ResumePeriod(current.Period.In(h.cfg.Location), current.BillingDay, time.Now())
+		period = &resumed
+	}
+
+	subscription, err := h.storage.SetSubscriptionStatus(ctx, id, userID, to, period)
+	if errors.Is(err, storage.ErrInvalidTransition) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Subscription cannot become %s", to)
+	}
+	if err != nil {
+		return nil, h.handleStorageError(err, "subscription")
+	}
+
+	h.logger.Info("Subscription status changed", slog.Int("subscription_id", id), slog.String("status", to))
+	return &proto.SubscriptionResponse{Response: response, Subscription: toProtoSubscription(subscription)}, nil
+}
+
+func toProtoSubscription(subscription *storage.Subscription) *proto.Subscription {
+	result := &proto.Subscription{
+		Id:           int32(subscription.ID),
+		CollectionId: int32(subscription.CollectionID),
+		Amount:       int32(subscription.Amount),
+		Status:       subscription.Status,
+		BillingDay:   int32(subscription.BillingDay),
+		LastError:    subscription.LastError,
+		CreatedAt:    timestamppb.New(subscription.CreatedAt),
+	}
+	if subscription.Status == storage.SubscriptionActive {
+		result.NextCharge = timestamppb.New(subscription.NextChargeAt)
+	}
+	if subscription.LastChargedAt != nil {
+		result.LastCharged = timestamppb.New(*subscription.LastChargedAt)
+	}
+	return result
+}
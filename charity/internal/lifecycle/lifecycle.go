@@ -0,0 +1,122 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/GP-Hacks/kdt2024-charity/config"
+	"github.com/GP-Hacks/kdt2024-charity/internal/donation"
+	"github.com/GP-Hacks/kdt2024-charity/internal/storage"
+)
+
+// Worker moves collections through their states: it funds collections that reached their goal and notifies their
+// donors, closes funded collections after a grace period and active ones past their deadline, and archives
+// collections closed long ago.
+type Worker struct {
+	cfg       *config.Config
+	storage   *storage.PostgresStorage
+	processor *donation.Processor
+	logger    *slog.Logger
+}
+
+func NewWorker(cfg *config.Config, storage *storage.PostgresStorage, processor *donation.Processor, logger *slog.Logger) *Worker {
+	return &Worker{cfg: cfg, storage: storage, processor: processor, logger: logger}
+}
+
+func (w *Worker) Run(ctx context.Context) {
+	w.logger.Info("Collections lifecycle worker started", slog.Duration("interval", w.cfg.LifecycleInterval))
+	ticker := time.NewTicker(w.cfg.LifecycleInterval)
+	defer ticker.Stop()
+
+	for {
+		w.step(ctx)
+
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Collections lifecycle worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Worker) step(ctx context.Context) {
+	if funded, err := w.storage.FundCollections(ctx); err != nil {
+		w.logger.Error("Failed to fund collections", slog.String("error", err.Error()))
+	} else if funded > 0 {
+		w.logger.Info("Collections funded", slog.Int("count", funded))
+	}
+
+	if notified, err := w.storage.NotifyGoalReached(ctx, w.cfg.LifecycleBatch, w.goalReachedMessages); err != nil {
+		w.logger.Error("Failed to notify donors of reached goals", slog.String("error", err.Error()))
+	} else if notified > 0 {
+		w.logger.Info("Donors notified of reached goals", slog.Int("collections", notified))
+	}
+
+	now := time.Now()
+	if closed, err := w.storage.CloseCollections(ctx, now, now.Add(-w.cfg.FundedCloseDelay), w.cfg.LifecycleBatch, w.closedMessages); err != nil {
+		w.logger.Error("Failed to close collections", slog.String("error", err.Error()))
+	} else if closed > 0 {
+		w.logger.Info("Collections closed", slog.Int("count", closed))
+	}
+
+	if archived, err := w.storage.ArchiveCollections(ctx, now.Add(-w.cfg.ArchiveAfter)); err != nil {
+		w.logger.Error("Failed to archive collections", slog.String("error", err.Error()))
+	} else if archived > 0 {
+		w.logger.Info("Collections archived", slog.Int("count", archived))
+	}
+}
+
+// goalReachedMessages thanks every donor of the funded collection.
+func (w *Worker) goalReachedMessages(collection *storage.Collection, donors []string) ([]*storage.OutboxMessage, error) {
+	now := time.Now()
+	messages := make([]*storage.OutboxMessage, 0, len(donors))
+	for _, donor := range donors {
+		message, err := w.processor.NotificationOutbox(&donation.NotificationMessage{
+			UserID:   donor,
+			Category: donation.NotificationCategory,
+			Header:   "Сбор достиг цели!",
+			Content:  fmt.Sprintf("Благодаря вам сбор «%s» собрал %d ₽. Спасибо за помощь!", collection.Name, collection.Current),
+			Time:     now,
+			Translations: map[string]donation.NotificationTranslation{
+				"en": {
+					Header:  "The goal is reached!",
+					Content: fmt.Sprintf("Thanks to you «%s» has raised %d ₽. Thank you for your help!", collection.Name, collection.Current),
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
+
+// closedMessages tells the users whose subscriptions to the closed collection were cancelled.
+func (w *Worker) closedMessages(collection *storage.Collection, subscribers []string) ([]*storage.OutboxMessage, error) {
+	now := time.Now()
+	messages := make([]*storage.OutboxMessage, 0, len(subscribers))
+	for _, subscriber := range subscribers {
+		message, err := w.processor.NotificationOutbox(&donation.NotificationMessage{
+			UserID:   subscriber,
+			Category: donation.NotificationCategory,
+			Header:   "Ежемесячное пожертвование отменено",
+			Content:  fmt.Sprintf("Сбор «%s» завершен, ежемесячные пожертвования в него больше не списываются", collection.Name),
+			Time:     now,
+			Translations: map[string]donation.NotificationTranslation{
+				"en": {
+					Header:  "Monthly donation cancelled",
+					Content: fmt.Sprintf("«%s» is closed, monthly donations to it are no longer charged", collection.Name),
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+	return messages, nil
+}
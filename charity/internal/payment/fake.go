@@ -0,0 +1,90 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// FakeDeclineToken makes FakeProvider decline the authorization.
+const FakeDeclineToken = "fake-decline"
+
+const (
+	fakeStatusAuthorized = "authorized"
+	fakeStatusCaptured   = "captured"
+	fakeStatusVoided     = "voided"
+	fakeStatusRefunded   = "refunded"
+)
+
+type fakePayment struct {
+	request Request
+	status  string
+}
+
+// FakeProvider is an in-memory provider for local development. It approves every payment except the ones made
+// with FakeDeclineToken, and returns the same payment for repeated authorizations with one reference.
+type FakeProvider struct {
+	mu          sync.Mutex
+	payments    map[string]*fakePayment
+	byReference map[string]string
+	nextID      int
+}
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{
+		payments:    make(map[string]*fakePayment),
+		byReference: make(map[string]string),
+	}
+}
+
+func (p *FakeProvider) Authorize(ctx context.Context, request Request) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if request.Token == FakeDeclineToken {
+		return "", ErrDeclined
+	}
+	if request.Amount <= 0 {
+		return "", fmt.Errorf("%w: amount must be positive", ErrDeclined)
+	}
+	if id, ok := p.byReference[request.Reference]; ok {
+		return id, nil
+	}
+
+	p.nextID++
+	id := fmt.Sprintf("fake_%d", p.nextID)
+	p.payments[id] = &fakePayment{request: request, status: fakeStatusAuthorized}
+	p.byReference[request.Reference] = id
+	return id, nil
+}
+
+func (p *FakeProvider) Capture(ctx context.Context, paymentID string) error {
+	return p.transition(paymentID, fakeStatusAuthorized, fakeStatusCaptured)
+}
+
+func (p *FakeProvider) Void(ctx context.Context, paymentID string) error {
+	return p.transition(paymentID, fakeStatusAuthorized, fakeStatusVoided)
+}
+
+func (p *FakeProvider) Refund(ctx context.Context, paymentID string) error {
+	return p.transition(paymentID, fakeStatusCaptured, fakeStatusRefunded)
+}
+
+// transition moves the payment from one status to another. Repeating a completed transition succeeds.
+func (p *FakeProvider) transition(paymentID, from, to string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	payment, ok := p.payments[paymentID]
+	if !ok {
+		return ErrPaymentNotFound
+	}
+	if payment.status == to {
+		return nil
+	}
+	if payment.status != from {
+		return fmt.Errorf("%w: payment is %s", ErrInvalidState, payment.status)
+	}
+	payment.status = to
+	return nil
+}
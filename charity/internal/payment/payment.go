@@ -0,0 +1,33 @@
+package payment
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrDeclined is returned when the provider refuses the payment, e.g. because of insufficient funds.
+	ErrDeclined        = errors.New("payment declined")
+	ErrPaymentNotFound = errors.New("payment not found")
+	// ErrInvalidState is returned when the operation does not apply to the payment in its current state.
+	ErrInvalidState = errors.New("invalid payment state")
+)
+
+type Request struct {
+	// Reference identifies the payment on our side; providers use it to deduplicate retries.
+	Reference string
+	UserID    string
+	Amount    int
+	// Token is the payment method token issued to the client by the provider SDK.
+	Token string
+}
+
+// Provider is a payment provider holding funds in two steps: Authorize reserves the amount on the payment method
+// and Capture charges it.
+type Provider interface {
+	Authorize(ctx context.Context, request Request) (paymentID string, err error)
+	Capture(ctx context.Context, paymentID string) error
+	// Void releases an authorized payment that will not be captured.
+	Void(ctx context.Context, paymentID string) error
+	Refund(ctx context.Context, paymentID string) error
+}
@@ -0,0 +1,74 @@
+// Package seed loads the fixture collections used to populate local and test environments.
+package seed
+
+import (
+	"embed"
+	"fmt"
+	"github.com/GP-Hacks/kdt2024-charity/internal/storage"
+	"github.com/GP-Hacks/kdt2024-commons/fixtures"
+)
+
+//go:embed fixtures/collections.yaml
+var bundled embed.FS
+
+type file struct {
+	Collections []collection `yaml:"collections" json:"collections"`
+}
+
+type collection struct {
+	Category     string `yaml:"category" json:"category"`
+	Name         string `yaml:"name" json:"name"`
+	Description  string `yaml:"description" json:"description"`
+	Organization string `yaml:"organization" json:"organization"`
+	Phone        string `yaml:"phone" json:"phone"`
+	Website      string `yaml:"website" json:"website"`
+	Goal         int    `yaml:"goal" json:"goal"`
+	Current      int    `yaml:"current" json:"current"`
+	Photo        string `yaml:"photo" json:"photo"`
+}
+
+// Load reads the collections in the YAML or JSON fixture files at paths, or the bundled fixtures when no paths are
+// given.
+func Load(paths []string) ([]*storage.SeedCollection, error) {
+	const op = "seed.Load"
+
+	var files []file
+	if len(paths) == 0 {
+		var f file
+		if err := fixtures.ReadFS(bundled, "fixtures/collections.yaml", &f); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		files = append(files, f)
+	}
+	for _, path := range paths {
+		var f file
+		if err := fixtures.Read(path, &f); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		files = append(files, f)
+	}
+
+	var collections []*storage.SeedCollection
+	for _, f := range files {
+		for i, c := range f.Collections {
+			if c.Name == "" || c.Organization == "" || c.Category == "" {
+				return nil, fmt.Errorf("%s: collection %d needs a category, name and organization", op, i+1)
+			}
+			if c.Goal <= 0 || c.Current < 0 {
+				return nil, fmt.Errorf("%s: collection %q needs a positive goal and a non-negative current amount", op, c.Name)
+			}
+			collections = append(collections, &storage.SeedCollection{
+				Category:     c.Category,
+				Name:         c.Name,
+				Description:  c.Description,
+				Organization: c.Organization,
+				Phone:        c.Phone,
+				Website:      c.Website,
+				Goal:         c.Goal,
+				Current:      c.Current,
+				Photo:        c.Photo,
+			})
+		}
+	}
+	return collections, nil
+}
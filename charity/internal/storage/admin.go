@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/GP-Hacks/kdt2024-commons/audit"
+	"github.com/jackc/pgx/v5"
+)
+
+// auditTable is the audit log of the admin changes, see audit.Change.
+const auditTable = "charity_audit_log"
+
+// CreateCollection stores a new active collection from the editable fields of collection: everything but the ID,
+// the raised amount, the state and its timestamps.
+func (s *PostgresStorage) CreateCollection(ctx context.Context, actor string, collection *Collection) (*Collection, error) {
+	const op = "storage.postgresql.CreateCollection"
+
+	var created *Collection
+	err := s.audited(ctx, actor, audit.Create, "charity", 0, func(tx pgx.Tx) (int, error) {
+		var err error
+		created, err = scanCollection(tx.QueryRow(ctx, `
+			INSERT INTO charity (category, name, description, organization, phone, website, goal, current, photo, deadline)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, 0, $8, $9)
+			RETURNING `+collectionColumns,
+			collection.Category, collection.Name, collection.Description, collection.Organization, collection.Phone,
+			collection.Website, collection.Goal, collection.Photo, collection.Deadline))
+		if err != nil {
+			return 0, err
+		}
+		return created.ID, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return created, nil
+}
+
+// UpdateCollection replaces the editable fields of the collection with the ID of collection. Lowering the goal
+// below the raised amount funds the collection on the next lifecycle run. It returns pgx.ErrNoRows when the
+// collection does not exist or was deleted.
+func (s *PostgresStorage) UpdateCollection(ctx context.Context, actor string, collection *Collection) (*Collection, error) {
+	const op = "storage.postgresql.UpdateCollection"
+
+	var updated *Collection
+	err := s.audited(ctx, actor, audit.Update, "charity", collection.ID, func(tx pgx.Tx) (int, error) {
+		var err error
+		updated, err = scanCollection(tx.QueryRow(ctx, `
+			UPDATE charity
+			SET category = $2, name = $3, description = $4, organization = $5, phone = $6, website = $7, goal = $8,
+				photo = $9, deadline = $10
+			WHERE id = $1
+			RETURNING `+collectionColumns,
+			collection.ID, collection.Category, collection.Name, collection.Description, collection.Organization,
+			collection.Phone, collection.Website, collection.Goal, collection.Photo, collection.Deadline))
+		if err != nil {
+			return 0, err
+		}
+		return updated.ID, nil
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return updated, nil
+}
+
+// DeleteCollection hides the collection from users and stops its donations. Its subscriptions are cancelled, while
+// its donations are kept for receipts and refunds. It returns pgx.ErrNoRows when the collection does not exist or
+// was already deleted.
+func (s *PostgresStorage) DeleteCollection(ctx context.Context, actor string, id int) error {
+	const op = "storage.postgresql.DeleteCollection"
+
+	err := s.audited(ctx, actor, audit.Delete, "charity", id, func(tx pgx.Tx) (int, error) {
+		if _, err := tx.Exec(ctx, `UPDATE charity SET deleted_at = NOW() WHERE id = $1`, id); err != nil {
+			return 0, err
+		}
+		_, err := tx.Exec(ctx, `
+			UPDATE charity_subscriptions
+			SET status = $2, last_error = 'collection deleted', updated_at = NOW()
+			WHERE collection_id = $1 AND status = ANY($3)`, id, SubscriptionCancelled, subscriptionTransitions[SubscriptionCancelled])
+		if err != nil {
+			return 0, fmt.Errorf("failed to cancel subscriptions: %w", err)
+		}
+		return id, nil
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// audited runs change in a transaction and records it in the audit log, see audit.Change.
+func (s *PostgresStorage) audited(ctx context.Context, actor string, action string, table string, id int, change func(tx pgx.Tx) (int, error)) error {
+	return audit.Change(ctx, s.db, auditTable, actor, action, table, id, change)
+}
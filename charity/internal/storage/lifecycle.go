@@ -0,0 +1,134 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// FundCollections marks active collections that have reached their goal as funded. Captured donations fund
+// collections on their own, this catches the ones whose goal was lowered.
+func (s *PostgresStorage) FundCollections(ctx context.Context) (int, error) {
+	const op = "storage.postgresql.FundCollections"
+
+	tag, err := s.db.Exec(ctx, `
+		UPDATE charity SET state = $2, funded_at = NOW()
+		WHERE deleted_at IS NULL AND state = $1 AND current >= goal`, CollectionActive, CollectionFunded)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// NotifyGoalReached hands up to limit funded collections whose donors were not notified yet to buildMessages,
+// together with their donors, and stores the messages in the outbox. A collection is notified once even with
+// several replicas running: rows are locked with SKIP LOCKED and marked in the same transaction.
+func (s *PostgresStorage) NotifyGoalReached(ctx context.Context, limit int, buildMessages func(collection *Collection, donors []string) ([]*OutboxMessage, error)) (int, error) {
+	const op = "storage.postgresql.NotifyGoalReached"
+
+	return s.processCollections(ctx, op, `
+		SELECT `+collectionColumns+` FROM charity
+		WHERE deleted_at IS NULL AND funded_at IS NOT NULL AND goal_notified_at IS NULL
+		ORDER BY funded_at
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED`, []any{limit},
+		func(tx pgx.Tx, collection *Collection) ([]*OutboxMessage, error) {
+			donors, err := queryStrings(ctx, tx, `
+				SELECT DISTINCT user_token FROM charity_donations
+				WHERE collection_id = $1 AND status = $2 AND user_token <> ''`, collection.ID, DonationCaptured)
+			if err != nil {
+				return nil, fmt.Errorf("failed to query donors: %w", err)
+			}
+			if _, err := tx.Exec(ctx, `UPDATE charity SET goal_notified_at = NOW() WHERE id = $1`, collection.ID); err != nil {
+				return nil, fmt.Errorf("failed to mark collection as notified: %w", err)
+			}
+			return buildMessages(collection, donors)
+		})
+}
+
+// CloseCollections closes up to limit collections: active ones past their deadline and funded ones funded before
+// fundedBefore. Their subscriptions are cancelled, and buildMessages gets every closed collection with the users
+// whose subscriptions were cancelled.
+func (s *PostgresStorage) CloseCollections(ctx context.Context, now time.Time, fundedBefore time.Time, limit int, buildMessages func(collection *Collection, subscribers []string) ([]*OutboxMessage, error)) (int, error) {
+	const op = "storage.postgresql.CloseCollections"
+
+	return s.processCollections(ctx, op, `
+		SELECT `+collectionColumns+` FROM charity
+		WHERE deleted_at IS NULL AND ((state = $1 AND deadline <= $2) OR (state = $3 AND funded_at <= $4))
+		ORDER BY id
+		LIMIT $5
+		FOR UPDATE SKIP LOCKED`, []any{CollectionActive, now, CollectionFunded, fundedBefore, limit},
+		func(tx pgx.Tx, collection *Collection) ([]*OutboxMessage, error) {
+			if _, err := tx.Exec(ctx, `UPDATE charity SET state = $2, closed_at = NOW() WHERE id = $1`, collection.ID, CollectionClosed); err != nil {
+				return nil, fmt.Errorf("failed to close collection: %w", err)
+			}
+			collection.State = CollectionClosed
+
+			subscribers, err := queryStrings(ctx, tx, `
+				UPDATE charity_subscriptions
+				SET status = $2, last_error = 'collection closed', updated_at = NOW()
+				WHERE collection_id = $1 AND status = ANY($3)
+				RETURNING user_token`, collection.ID, SubscriptionCancelled, subscriptionTransitions[SubscriptionCancelled])
+			if err != nil {
+				return nil, fmt.Errorf("failed to cancel subscriptions: %w", err)
+			}
+			return buildMessages(collection, subscribers)
+		})
+}
+
+// ArchiveCollections archives the collections closed before closedBefore.
+func (s *PostgresStorage) ArchiveCollections(ctx context.Context, closedBefore time.Time) (int, error) {
+	const op = "storage.postgresql.ArchiveCollections"
+
+	tag, err := s.db.Exec(ctx, `
+		UPDATE charity SET state = $2
+		WHERE state = $1 AND closed_at <= $3`, CollectionClosed, CollectionArchived, closedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// processCollections locks the collections selected by query and runs process on each of them in one
+// transaction, storing the outbox messages it returns.
+func (s *PostgresStorage) processCollections(ctx context.Context, op string, query string, args []any, process func(tx pgx.Tx, collection *Collection) ([]*OutboxMessage, error)) (int, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to begin transaction: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to query collections: %w", op, err)
+	}
+	collections, err := scanCollections(rows)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, collection := range collections {
+		messages, err := process(tx, collection)
+		if err != nil {
+			return 0, fmt.Errorf("%s: collection %d: %w", op, collection.ID, err)
+		}
+		if err := insertOutbox(ctx, tx, messages); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: failed to commit transaction: %w", op, err)
+	}
+	return len(collections), nil
+}
+
+func queryStrings(ctx context.Context, tx pgx.Tx, query string, args ...any) ([]string, error) {
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS charity_audit_log;
+DROP TABLE IF EXISTS charity_outbox;
+DROP TABLE IF EXISTS charity_donations;
+DROP TABLE IF EXISTS charity_subscriptions;
+DROP TABLE IF EXISTS charity;
@@ -0,0 +1,93 @@
+-- Baseline of the schema created before migrations were introduced, the statements are idempotent so the
+-- migration also applies to databases created by earlier releases.
+
+CREATE TABLE IF NOT EXISTS charity (
+    id SERIAL PRIMARY KEY,
+    category VARCHAR(255),
+    name TEXT,
+    description TEXT,
+    organization TEXT,
+    phone VARCHAR(50),
+    website VARCHAR(255),
+    goal INT,
+    current INT,
+    photo TEXT
+);
+
+ALTER TABLE charity ADD COLUMN IF NOT EXISTS state VARCHAR(20) NOT NULL DEFAULT 'active';
+
+ALTER TABLE charity ADD COLUMN IF NOT EXISTS deadline TIMESTAMPTZ;
+
+ALTER TABLE charity ADD COLUMN IF NOT EXISTS funded_at TIMESTAMPTZ;
+
+ALTER TABLE charity ADD COLUMN IF NOT EXISTS closed_at TIMESTAMPTZ;
+
+ALTER TABLE charity ADD COLUMN IF NOT EXISTS goal_notified_at TIMESTAMPTZ;
+
+ALTER TABLE charity ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS charity_state_idx ON charity (state);
+
+CREATE TABLE IF NOT EXISTS charity_donations (
+    id SERIAL PRIMARY KEY,
+    collection_id INT NOT NULL REFERENCES charity(id),
+    user_token VARCHAR(255) NOT NULL,
+    amount INT NOT NULL CHECK (amount > 0),
+    status VARCHAR(20) NOT NULL,
+    payment_id VARCHAR(255),
+    failure_reason TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS charity_donations_collection_id_status_idx ON charity_donations (collection_id, status);
+
+CREATE TABLE IF NOT EXISTS charity_subscriptions (
+    id SERIAL PRIMARY KEY,
+    collection_id INT NOT NULL REFERENCES charity(id),
+    user_token VARCHAR(255) NOT NULL,
+    amount INT NOT NULL CHECK (amount > 0),
+    payment_token TEXT NOT NULL,
+    billing_day INT NOT NULL CHECK (billing_day BETWEEN 1 AND 31),
+    status VARCHAR(20) NOT NULL,
+    period TIMESTAMPTZ NOT NULL,
+    next_charge_at TIMESTAMPTZ NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    last_charged_at TIMESTAMPTZ,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS charity_subscriptions_user_token_idx ON charity_subscriptions (user_token);
+
+CREATE INDEX IF NOT EXISTS charity_subscriptions_due_idx ON charity_subscriptions (next_charge_at) WHERE status = 'active';
+
+ALTER TABLE charity_donations ADD COLUMN IF NOT EXISTS subscription_id INT REFERENCES charity_subscriptions(id);
+
+ALTER TABLE charity_donations ADD COLUMN IF NOT EXISTS reference VARCHAR(255);
+
+CREATE UNIQUE INDEX IF NOT EXISTS charity_donations_reference_idx ON charity_donations (reference);
+
+CREATE TABLE IF NOT EXISTS charity_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    queue VARCHAR(255) NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS charity_outbox_unpublished_idx ON charity_outbox (id) WHERE published_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS charity_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    actor VARCHAR(255) NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    entity VARCHAR(50) NOT NULL,
+    entity_id INT NOT NULL,
+    before JSONB,
+    after JSONB,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS charity_audit_log_entity_idx ON charity_audit_log (entity, entity_id);
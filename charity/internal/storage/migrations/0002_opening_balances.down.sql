@@ -0,0 +1,2 @@
+-- The opening balances are part of the collection totals, so they are kept when the migration is reverted.
+SELECT 1;
@@ -0,0 +1,8 @@
+-- Records the amounts raised before donations were recorded as captured opening balance donations, which earlier
+-- releases did on every start.
+INSERT INTO charity_donations (collection_id, user_token, amount, status, payment_id)
+SELECT c.id, '', c.current - COALESCE(SUM(d.amount), 0), 'captured', 'opening-balance'
+FROM charity c
+LEFT JOIN charity_donations d ON d.collection_id = c.id AND d.status = 'captured'
+GROUP BY c.id, c.current
+HAVING c.current - COALESCE(SUM(d.amount), 0) > 0;
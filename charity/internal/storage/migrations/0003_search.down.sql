@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS charity_organization_trgm_idx;
+DROP INDEX IF EXISTS charity_name_trgm_idx;
+DROP INDEX IF EXISTS charity_search_idx;
+ALTER TABLE charity DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS charity_progress_idx;
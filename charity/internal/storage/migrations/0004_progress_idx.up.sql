@@ -0,0 +1,3 @@
+-- Collections are listed in pages by the share of their goal collected, the expression is the one of GetCollections.
+CREATE INDEX IF NOT EXISTS charity_progress_idx ON charity ((COALESCE(current::float8 / NULLIF(goal, 0), 0)), id)
+    WHERE deleted_at IS NULL;
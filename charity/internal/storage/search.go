@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+)
+
+type SearchResult struct {
+	ID       int
+	Category string
+	Name     string
+	Photo    string
+	Headline string
+	Rank     float64
+}
+
+// Search returns at most limit collections in one of states whose names, organizations or descriptions match query,
+// or whose names or organizations look like a word of query, best match first. The headlines are built with
+// headlineOptions.
+func (s *PostgresStorage) Search(ctx context.Context, query string, states []string, limit int, headlineOptions string) ([]*SearchResult, error) {
+	const op = "storage.postgresql.Search"
+
+	rows, err := s.db.Query(ctx, `
+		SELECT c.id, COALESCE(c.category, ''), COALESCE(c.name, ''), COALESCE(c.photo, ''),
+			ts_headline('russian', COALESCE(c.description, ''), q, $2),
+			ts_rank(c.search_vector, q) +
+				GREATEST(COALESCE(word_similarity($1, c.name), 0), COALESCE(word_similarity($1, c.organization), 0)) AS rank
+		FROM charity c, websearch_to_tsquery('russian', $1) AS q
+		WHERE c.deleted_at IS NULL AND c.state = ANY($4) AND (c.search_vector @@ q OR $1 <% c.name OR $1 <% c.organization)
+		ORDER BY rank DESC, c.id
+		LIMIT $3`, query, headlineOptions, limit, states)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to search collections: %w", op, err)
+	}
+	defer rows.Close()
+
+	results := []*SearchResult{}
+	for rows.Next() {
+		result := &SearchResult{}
+		if err := rows.Scan(&result.ID, &result.Category, &result.Name, &result.Photo, &result.Headline, &result.Rank); err != nil {
+			return nil, fmt.Errorf("%s: failed to scan collection: %w", op, err)
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: error occurred while iterating over collections: %w", op, err)
+	}
+	return results, nil
+}
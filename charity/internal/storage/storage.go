@@ -2,11 +2,52 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/GP-Hacks/kdt2024-commons/outbox"
+	"github.com/GP-Hacks/kdt2024-commons/pagination"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"time"
 )
 
+const (
+	DonationPending    = "pending"
+	DonationAuthorized = "authorized"
+	DonationCaptured   = "captured"
+	DonationFailed     = "failed"
+	DonationRefunded   = "refunded"
+)
+
+// donationTransitions lists for every donation status the statuses it can be reached from.
+var donationTransitions = map[string][]string{
+	DonationAuthorized: {DonationPending},
+	DonationCaptured:   {DonationAuthorized},
+	DonationFailed:     {DonationPending, DonationAuthorized},
+	DonationRefunded:   {DonationCaptured},
+}
+
+// ErrInvalidTransition is returned when the donation or subscription is not in a status the requested one can be
+// reached from.
+var ErrInvalidTransition = errors.New("invalid status transition")
+
+const (
+	CollectionActive   = "active"
+	CollectionFunded   = "funded"
+	CollectionClosed   = "closed"
+	CollectionArchived = "archived"
+)
+
+// OpenCollectionStates are the states of collections that accept donations. A funded collection keeps accepting
+// them until it is closed.
+var OpenCollectionStates = []string{CollectionActive, CollectionFunded}
+
+// ErrCollectionClosed is returned when donating to a collection that no longer accepts donations.
+var ErrCollectionClosed = errors.New("collection is closed")
+
+// openingBalancePayment marks the captured donations standing for amounts raised before donations were recorded.
+const openingBalancePayment = "opening-balance"
+
 type Collection struct {
 	ID           int
 	Category     string
@@ -18,8 +59,37 @@ type Collection struct {
 	Goal         int
 	Current      int
 	Photo        string
+	State        string
+	// Deadline is when an active collection is closed, nil for collections open until funded.
+	Deadline *time.Time
+	FundedAt *time.Time
+	ClosedAt *time.Time
+}
+
+type Donation struct {
+	ID            int
+	CollectionID  int
+	UserToken     string
+	Amount        int
+	Status        string
+	PaymentID     string
+	FailureReason string
+	// SubscriptionID is the subscription the donation was charged for, zero for one-off donations.
+	SubscriptionID int
+	// Reference identifies a scheduled charge, so retrying it continues the same donation instead of charging twice.
+	Reference string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// CollectionName and Organization are only set by CaptureDonation and RefundDonation.
+	CollectionName string
+	Organization   string
 }
 
+// OutboxMessage is a message stored in the outbox of the service.
+type OutboxMessage = outbox.Message
+
+const outboxTable = "charity_outbox"
+
 type PostgresStorage struct {
 	db *pgxpool.Pool
 }
@@ -41,7 +111,7 @@ func (s *PostgresStorage) Close() {
 func (s *PostgresStorage) GetCategories(ctx context.Context) ([]string, error) {
 	const op = "storage.postgresql.GetCategories"
 
-	rows, err := s.db.Query(ctx, "SELECT DISTINCT category FROM charity")
+	rows, err := s.db.Query(ctx, "SELECT DISTINCT category FROM charity WHERE deleted_at IS NULL")
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -63,30 +133,269 @@ func (s *PostgresStorage) GetCategories(ctx context.Context) ([]string, error) {
 	return categories, nil
 }
 
-func (s *PostgresStorage) GetCollections(ctx context.Context) ([]*Collection, error) {
-	query := "SELECT id, category, name, description, organization, phone, website, goal, current, photo FROM charity"
-	return s.fetchCollections(ctx, query)
+const collectionColumns = "id, category, name, description, organization, phone, website, goal, current, photo, state, deadline, funded_at, closed_at"
+
+const (
+	CollectionSortID       = "id"
+	CollectionSortProgress = "progress"
+)
+
+// collectionProgress is the share of its goal a collection has collected.
+const collectionProgress = "COALESCE(current::float8 / NULLIF(goal, 0), 0)"
+
+// CollectionsQuery selects a page of the collections in one of States, only the ones of Category unless it is empty.
+type CollectionsQuery struct {
+	Category string
+	States   []string
+	Sort     pagination.Sort
+	Limit    int
+	// AfterProgress and AfterID are the cursor of the page, the progress and the ID of the collection before it.
+	// AfterProgress is only used when sorting by progress, AfterID is 0 for the first page.
+	AfterProgress float64
+	AfterID       int
+}
+
+// GetCollections returns a page of the collections matching query and whether there are collections after it. It
+// returns pgx.ErrNoRows when the category has no such collections.
+func (s *PostgresStorage) GetCollections(ctx context.Context, query *CollectionsQuery) ([]*Collection, bool, error) {
+	args := []interface{}{query.States, query.Category}
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	sql := "SELECT " + collectionColumns + " FROM charity WHERE deleted_at IS NULL AND state = ANY($1) AND ($2 = '' OR category = $2)"
+	key := "id"
+	if query.Sort.Key == CollectionSortProgress {
+		key = collectionProgress
+	}
+	if query.AfterID > 0 {
+		idArg := arg(query.AfterID)
+		keyArg := idArg
+		if key == collectionProgress {
+			keyArg = arg(query.AfterProgress) + "::float8"
+		}
+		sql += " AND " + query.Sort.After(key, "id", keyArg, idArg)
+	}
+	sql += " ORDER BY " + query.Sort.OrderBy(key, "id") + " LIMIT " + arg(query.Limit+1)
+
+	collections, err := s.fetchCollections(ctx, sql, args...)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(collections) == 0 && query.Category != "" && query.AfterID == 0 {
+		return nil, false, pgx.ErrNoRows
+	}
+	hasMore := len(collections) > query.Limit
+	if hasMore {
+		collections = collections[:query.Limit]
+	}
+	return collections, hasMore, nil
+}
+
+// Progress returns the share of its goal the collection has collected.
+func (c *Collection) Progress() float64 {
+	if c.Goal == 0 {
+		return 0
+	}
+	return float64(c.Current) / float64(c.Goal)
+}
+
+const donationColumns = "id, collection_id, user_token, amount, status, COALESCE(payment_id, ''), COALESCE(failure_reason, ''), " +
+	"COALESCE(subscription_id, 0), COALESCE(reference, ''), created_at, updated_at"
+
+// CreateDonation records a pending donation. When a donation with the same non-empty Reference exists it is returned
+// unchanged. It returns pgx.ErrNoRows when the collection does not exist and ErrCollectionClosed when it does not
+// accept donations.
+func (s *PostgresStorage) CreateDonation(ctx context.Context, donation *Donation) (*Donation, error) {
+	const op = "storage.postgresql.CreateDonation"
+
+	row := s.db.QueryRow(ctx, `
+		INSERT INTO charity_donations (collection_id, user_token, amount, status, subscription_id, reference)
+		SELECT id, $2, $3, $4, NULLIF($5, 0), NULLIF($6, '') FROM charity WHERE id = $1 AND deleted_at IS NULL AND state = ANY($7)
+		ON CONFLICT (reference) DO NOTHING
+		RETURNING `+donationColumns,
+		donation.CollectionID, donation.UserToken, donation.Amount, DonationPending, donation.SubscriptionID, donation.Reference,
+		OpenCollectionStates)
+	created, err := scanDonation(row)
+	if err == nil {
+		return created, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if donation.Reference != "" {
+		existing, err := scanDonation(s.db.QueryRow(ctx, `SELECT `+donationColumns+` FROM charity_donations WHERE reference = $1`, donation.Reference))
+		if err == nil {
+			return existing, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := s.checkCollectionOpen(ctx, donation.CollectionID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, ErrCollectionClosed) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return nil, fmt.Errorf("%s: collection %d was closed concurrently: %w", op, donation.CollectionID, ErrCollectionClosed)
+}
+
+// checkCollectionOpen returns pgx.ErrNoRows when the collection does not exist or was deleted and ErrCollectionClosed
+// when it does not accept donations.
+func (s *PostgresStorage) checkCollectionOpen(ctx context.Context, id int) error {
+	var state string
+	if err := s.db.QueryRow(ctx, `SELECT state FROM charity WHERE id = $1 AND deleted_at IS NULL`, id).Scan(&state); err != nil {
+		return err
+	}
+	for _, open := range OpenCollectionStates {
+		if state == open {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: collection is %s", ErrCollectionClosed, state)
+}
+
+func (s *PostgresStorage) GetDonation(ctx context.Context, id int) (*Donation, error) {
+	const op = "storage.postgresql.GetDonation"
+
+	donation, err := scanDonation(s.db.QueryRow(ctx, `SELECT `+donationColumns+` FROM charity_donations WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return donation, nil
+}
+
+// AuthorizeDonation stores the provider's payment for the pending donation.
+func (s *PostgresStorage) AuthorizeDonation(ctx context.Context, id int, paymentID string) (*Donation, error) {
+	const op = "storage.postgresql.AuthorizeDonation"
+
+	donation, err := setDonationStatus(ctx, s.db, id, DonationAuthorized, paymentID, "")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return donation, nil
+}
+
+// FailDonation marks a donation that was not captured as failed.
+func (s *PostgresStorage) FailDonation(ctx context.Context, id int, reason string) (*Donation, error) {
+	const op = "storage.postgresql.FailDonation"
+
+	donation, err := setDonationStatus(ctx, s.db, id, DonationFailed, "", reason)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return donation, nil
 }
 
-func (s *PostgresStorage) GetCollectionsByCategory(ctx context.Context, category string) ([]*Collection, error) {
-	query := "SELECT id, category, name, description, organization, phone, website, goal, current, photo FROM charity WHERE category = $1"
-	return s.fetchCollections(ctx, query, category)
+// CaptureDonation marks the authorized donation as captured, adds its amount to the collection and stores the
+// outbox messages built for it in one transaction, so the collection total always equals the sum of its captured
+// donations.
+func (s *PostgresStorage) CaptureDonation(ctx context.Context, id int, buildMessages func(donation *Donation) ([]*OutboxMessage, error)) (*Donation, error) {
+	const op = "storage.postgresql.CaptureDonation"
+
+	return s.changeCollectionTotal(ctx, op, id, DonationCaptured, 1, buildMessages)
+}
+
+// RefundDonation marks the captured donation as refunded and subtracts its amount from the collection in one transaction.
+func (s *PostgresStorage) RefundDonation(ctx context.Context, id int, buildMessages func(donation *Donation) ([]*OutboxMessage, error)) (*Donation, error) {
+	const op = "storage.postgresql.RefundDonation"
+
+	return s.changeCollectionTotal(ctx, op, id, DonationRefunded, -1, buildMessages)
 }
 
-func (s *PostgresStorage) UpdateCollection(ctx context.Context, collectionId int, amount int) error {
-	const op = "storage.postgresql.UpdateCollection"
-	query := `
-		UPDATE charity 
-		SET current = current + $1 
+func (s *PostgresStorage) changeCollectionTotal(ctx context.Context, op string, id int, status string, sign int, buildMessages func(donation *Donation) ([]*OutboxMessage, error)) (*Donation, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to begin transaction: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	donation, err := setDonationStatus(ctx, tx, id, status, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Reaching the goal funds an active collection, a refund taking it below the goal again does not reopen it.
+	err = tx.QueryRow(ctx, `
+		UPDATE charity
+		SET current = current + $1,
+			state = CASE WHEN state = $3 AND current + $1 >= goal THEN $4 ELSE state END,
+			funded_at = CASE WHEN state = $3 AND current + $1 >= goal THEN NOW() ELSE funded_at END
 		WHERE id = $2
-	`
+		RETURNING name, organization`, sign*donation.Amount, donation.CollectionID, CollectionActive, CollectionFunded).
+		Scan(&donation.CollectionName, &donation.Organization)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to update collection: %w", op, err)
+	}
 
-	_, err := s.db.Exec(ctx, query, amount, collectionId)
+	messages, err := buildMessages(donation)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: failed to build outbox messages: %w", op, err)
+	}
+	if err := insertOutbox(ctx, tx, messages); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("%s: failed to commit transaction: %w", op, err)
+	}
+
+	return donation, nil
+}
+
+// setDonationStatus moves the donation to status if donationTransitions allows it from the current one. Empty
+// paymentID and reason keep the stored values.
+func setDonationStatus(ctx context.Context, q interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}, id int, status string, paymentID string, reason string) (*Donation, error) {
+	row := q.QueryRow(ctx, `
+		UPDATE charity_donations
+		SET status = $2,
+			payment_id = COALESCE(NULLIF($3, ''), payment_id),
+			failure_reason = COALESCE(NULLIF($4, ''), failure_reason),
+			updated_at = NOW()
+		WHERE id = $1 AND status = ANY($5)
+		RETURNING `+donationColumns, id, status, paymentID, reason, donationTransitions[status])
+	donation, err := scanDonation(row)
+	if err == nil {
+		return donation, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to update donation: %w", err)
 	}
 
-	return nil
+	var current string
+	if err := q.QueryRow(ctx, `SELECT status FROM charity_donations WHERE id = $1`, id).Scan(&current); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("%w: donation is %s, cannot become %s", ErrInvalidTransition, current, status)
+}
+
+func scanDonation(row pgx.Row) (*Donation, error) {
+	donation := &Donation{}
+	err := row.Scan(&donation.ID, &donation.CollectionID, &donation.UserToken, &donation.Amount, &donation.Status,
+		&donation.PaymentID, &donation.FailureReason, &donation.SubscriptionID, &donation.Reference, &donation.CreatedAt, &donation.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return donation, nil
+}
+
+// PublishOutbox hands up to limit unpublished messages of the outbox to publish, see outbox.Publish.
+func (s *PostgresStorage) PublishOutbox(ctx context.Context, limit int, publish func(*OutboxMessage) error) (int, error) {
+	return outbox.Publish(ctx, s.db, outboxTable, limit, publish)
+}
+
+func insertOutbox(ctx context.Context, tx pgx.Tx, messages []*OutboxMessage) error {
+	return outbox.Insert(ctx, tx, outboxTable, messages)
 }
 
 func (s *PostgresStorage) fetchCollections(ctx context.Context, query string, args ...interface{}) ([]*Collection, error) {
@@ -96,119 +405,103 @@ func (s *PostgresStorage) fetchCollections(ctx context.Context, query string, ar
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	collections, err := scanCollections(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return collections, nil
+}
+
+// scanCollections reads and closes rows selected with collectionColumns.
+func scanCollections(rows pgx.Rows) ([]*Collection, error) {
 	defer rows.Close()
 
 	var collections []*Collection
 	for rows.Next() {
-		collection := &Collection{}
-		err := rows.Scan(
-			&collection.ID, &collection.Category, &collection.Name, &collection.Description, &collection.Organization,
-			&collection.Phone, &collection.Website, &collection.Goal, &collection.Current, &collection.Photo,
-		)
+		collection, err := scanCollection(rows)
 		if err != nil {
-			return nil, fmt.Errorf("%s: %w", op, err)
+			return nil, err
 		}
 		collections = append(collections, collection)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-
-	if len(collections) == 0 && len(args) > 0 {
-		return nil, pgx.ErrNoRows
+		return nil, err
 	}
 
 	return collections, nil
 }
 
-func (s *PostgresStorage) CreateTables(ctx context.Context) error {
-	const op = "storage.postgresql.CreateTables"
-	_, err := s.db.Exec(ctx, `
-		CREATE TABLE IF NOT EXISTS charity (
-			id SERIAL PRIMARY KEY,
-			category VARCHAR(255),
-			name TEXT,
-			description TEXT,
-			organization TEXT,
-			phone VARCHAR(50),
-			website VARCHAR(255),
-			goal INT,
-			current INT,
-			photo TEXT
-		)
-	`)
+func scanCollection(row pgx.Row) (*Collection, error) {
+	collection := &Collection{}
+	err := row.Scan(
+		&collection.ID, &collection.Category, &collection.Name, &collection.Description, &collection.Organization,
+		&collection.Phone, &collection.Website, &collection.Goal, &collection.Current, &collection.Photo,
+		&collection.State, &collection.Deadline, &collection.FundedAt, &collection.ClosedAt,
+	)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return nil, err
 	}
-	return nil
+	return collection, nil
+}
+
+// SeedCollection is the part of Collection set by fixtures, the collections start active without a deadline.
+type SeedCollection struct {
+	Category     string
+	Name         string
+	Description  string
+	Organization string
+	Phone        string
+	Website      string
+	Goal         int
+	Current      int
+	Photo        string
 }
 
-func (s *PostgresStorage) FetchAndStoreData(ctx context.Context) error {
-	const op = "storage.postgresql.FetchAndStoreData"
+// SeedCollections stores the collections not stored yet and returns how many were added. A collection is already
+// stored when one with the same name and organization exists, deleted ones included, so seeding neither duplicates
+// collections nor brings back deleted ones. The amounts raised of the added collections are recorded as opening
+// balances.
+func (s *PostgresStorage) SeedCollections(ctx context.Context, collections []*SeedCollection) (int, error) {
+	const op = "storage.postgresql.SeedCollections"
 
-	var count int
-	err := s.db.QueryRow(ctx, `SELECT COUNT(*) FROM charity`).Scan(&count)
+	tx, err := s.db.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
-
-	if count > 0 {
-		return nil
-	}
-
-	collections := []Collection{
-		{1, "Здравоохранение и медицинская помощь", "Помощь людям больных остеогенезом", " «Хрупкие люди» — это команда единомышленников, неравнодушных к проблемам больных несовершенным остеогенезом. Каждый день нашей работы направлен на открытие новых возможностей для улучшения здоровья людей с врожденной хрупкостью костей и создание условий для их полноценной жизни в обществе.", "Хрупкие люди", "+79035900400", "https://nuzhnapomosh.ru/funds/khrupkie_lyudi_1147799018454/", 6350000, 6328299, "https://encrypted-tbn0.gstatic.com/images?q=tbn:ANd9GcT4c0QC58ZG8baBP4xH7wd90Er2K5BmG7IlHw&s"},
-		{
-			2, "Социальные услуги", "Помощь жителям Татарстана имеющих трудные жизненные обстоятельства", "Наша миссия заключается не только в очевидном спасении нуждающихся, но и в облагораживании внутреннего мира многих людей. Помогая другим в трудных жизненных обстоятельствах, мы духовно и нравственно растем, а значит, и качество жизни общества со временем улучшается, а уровень безопасности внутри страны растет. На нашем сайте, освещающем нашу работу в Казани, вы можете убедиться, что благотворительность — это не просто слова, а реальная помощь. Наш благотворительный фонд — это не закрытая организация, деятельность которой нужно держать в секрете. Мы принимаем помощь других и всегда готовы представить отчет о своих действиях. ", "Добро даром", "+79370090960", "https://dobrodarom.ru", 800000, 580000, "https://sun9-11.userapi.com/impf/Ss1C5VOs_0dc-Qg4y1pkwhVND0yoGTqahFdLZg/cHmxfR95I94.jpg?size=1920x768&quality=95&crop=0,105,2560,1022&sign=2803c5ed79d705f945b87ab7bc4ee79e&type=cover_group",
-		},
-		{
-			3, "Образование и обучение", "Поддержка сельских школ Татарстана", "Сбор средств на обеспечение сельских школ Татарстана современными учебными материалами и оборудованием для обеспечения качественного образования.", "Благотворительный фонд «Школьное будущее» ", "+78435550001", "http://schoolfuture-tatarstan.ru", 2000000, 1250000, "https://encrypted-tbn0.gstatic.com/images?q=tbn:ANd9GcQ6Af8SqN5FdLF9FzjOec4P_NHyq5v9uVK_2A&s",
-		},
-		{
-			4, "Здравоохранение и медицинская помощь", "Лечение детей с редкими болезнями", "Сбор средств на лечение детей с редкими генетическими заболеваниями, на покупку медикаментов и проведение необходимых операций.", "Фонд помощи детям «Солнечный свет»", "+78431234567", "http://sunlightfund.ru", 8000000, 3460000, "https://emckzn.ru/templates/yootheme/cache/e8/6-e83a431c.jpeg",
-		},
-		{
-			5, "Социальные услуги", "Поддержка семьям, находящимся в трудной жизненной ситуации", "Сбор средств для оказания материальной и психологической поддержки семьям, оказавшимся в сложной жизненной ситуации.", "Социальная служба «Надежда и Опора»", "+78436789012", "http://nadezhda-opora-tatarstan.ru", 1500000, 850000, "https://islam.ru/sites/default/files/img/2016/veroeshenie/zakyat07_1.jpg",
-		},
-		{
-			6, "Защита окружающей среды и животного мира", "Сохранение редких видов флоры и фауны Татарстана", "Сбор средств на проекты по защите и сохранению редких видов растений и животных на территории Татарстана.", "Экологический фонд «Зеленая Республика» ", "+78433334455", "http://greenrepublicfund.ru", 4000000, 1980000, "https://zooinform.ru/wp-content/uploads/2022/11/elderly-person-and-children-holding-plant_.jpg",
-		},
-		{
-			7, "Культура и искусство", "Поддержка молодым артистам Татарстана", "Сбор средств для организации конкурсов, фестивалей и мастер-классов для молодых талантов в области музыки, театра и изобразительного искусства.", "Культурный фонд «Молодые таланты» ", "+78438887766", "http://youngtalents-tatarstan.ru", 3000000, 1530000, "https://профориентация51.рф/wp-content/uploads/2019/12/Zastavka.jpg",
-		},
-		{
-			8, "Образование и обучение", "Программа Стипендий для Студентов из Малообеспеченных Семей", "Сбор средств на предоставление образовательных грантов для студентов из малообеспеченных семей.", "Фонд «Образование для всех»", "+74951234567", "http://educationforall.org", 5000000, 2750000, "https://www.adeli.ee/wp-content/uploads/2016/04/MG_2451.jpg",
-		},
-		{
-			9, "Здравоохранение и медицинская помощь", "Помощь детям с онкологическими заболеваниями", "Сбор средств на лечение детей с онкологическими заболеваниями в ведущих клиниках страны и за рубежом.", "Благотворительный фонд «Надежда»", "+7812987653", "http://hopefund.org", 10000000, 4300000, "https://gbuzmood.ru/upload/resize_cache/iblock/ff5/780_600_2/ff5aea2f1fbe468cedf5fc103f8a91f6.jpg",
-		},
-		{
-			10, "Социальные услуги", "Помощь бездомным", "Сбор средств на обеспечение едой, одеждой и временным жильем для бездомных людей.", "Фонд «Дорога к дому»", "+74991112233", "http://roadtohome.org", 3500000, 1950000, "https://www.pravoslavie.ru/sas/image/102863/286372.p.jpg",
-		},
-		{
-			11, "Защита окружающей среды и животного мира", "Спасение амурского тигра", "Сбор средств на охрану амурских тигров, их среды обитания и борьбу с браконьерами.", "Фонд охраны дикой природы", "+742177778899", "http://wildlifefund.org", 6000000, 2870000, "https://s15.stc.yc.kpcdn.net/share/i/12/12268877/de-1200x900.jpg",
-		},
-		{
-			12, "Помощь пострадавшим", "Фонд помощи Курску ‘Вместе сильнее’", "Фонд ‘Вместе сильнее’ занимается сбором средств для оказания помощи пострадавшим в результате недавнего стихийного бедствия в Курске. Собранные средства будут направлены на обеспечение пищи, воды, медикаментов и временного жилья для пострадавших семей.", "Благотворительный фонд ‘Вместе сильнее’", "+74951234567", "http://www.vmestesilnee.ru", 10000000, 2500000, "https://encrypted-tbn0.gstatic.com/images?q=tbn:ANd9GcT9JlgL2l8zznDCDJceM3kWdJrl6jpmTw3XOw&s",
-		},
-		{
-			13, "Культура и искусство", "Реконструкция исторического музея", "Сбор средств на реконструкцию старинного музейного здания в центре города.", "Ассоциация Культурного Наследия", "+74952233344", "http://culturalheritage.org", 12000000, 5620000, "https://artocratia.ru/bucket/items/62446a55b7b3dd41640f0b76/62446a7cb989a43da636ef73/original.jpg",
-		},
-		{
-			14, "Катастрофы и чрезвычайные ситуации", "Помощь пострадавшим от землетрясения в Турции", "Сбор средств на оказание помощи пострадавшим от разрушительного землетрясения в Турции: обеспечение медикаментами, едой и временным жильем.", "Врачи без границ", "+33140212929", "http://msf.org", 1500000000, 790000000, "https://icdn.lenta.ru/images/2023/02/06/10/20230206101353579/preview_0bc327ce2b15656ec5eafb3123f6cbce.jpg",
-		},
+		return 0, fmt.Errorf("%s: %w", op, err)
 	}
+	defer tx.Rollback(ctx)
 
+	added := 0
 	for _, collection := range collections {
-		_, err := s.db.Exec(ctx, `
+		tag, err := tx.Exec(ctx, `
 			INSERT INTO charity (category, name, description, organization, phone, website, goal, current, photo)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9
+			WHERE NOT EXISTS (SELECT 1 FROM charity WHERE btrim(name) = btrim($2) AND btrim(organization) = btrim($4))`,
 			collection.Category, collection.Name, collection.Description, collection.Organization, collection.Phone, collection.Website, collection.Goal, collection.Current, collection.Photo)
 		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
+			return 0, fmt.Errorf("%s: %w", op, err)
 		}
+		added += int(tag.RowsAffected())
 	}
 
-	return nil
+	if err := recordOpeningBalances(ctx, tx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return added, nil
+}
+
+// recordOpeningBalances adds a captured donation for the part of every collection total not covered by captured
+// donations, i.e. the amounts raised before donations were recorded.
+func recordOpeningBalances(ctx context.Context, tx pgx.Tx) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO charity_donations (collection_id, user_token, amount, status, payment_id)
+		SELECT c.id, '', c.current - COALESCE(SUM(d.amount), 0), $1, $2
+		FROM charity c
+		LEFT JOIN charity_donations d ON d.collection_id = c.id AND d.status = $1
+		GROUP BY c.id, c.current
+		HAVING c.current - COALESCE(SUM(d.amount), 0) > 0`, DonationCaptured, openingBalancePayment)
+	return err
 }
@@ -0,0 +1,252 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	SubscriptionActive    = "active"
+	SubscriptionPaused    = "paused"
+	SubscriptionCancelled = "cancelled"
+)
+
+// subscriptionTransitions lists for every subscription status the statuses it can be reached from.
+var subscriptionTransitions = map[string][]string{
+	SubscriptionActive:    {SubscriptionPaused},
+	SubscriptionPaused:    {SubscriptionActive},
+	SubscriptionCancelled: {SubscriptionActive, SubscriptionPaused},
+}
+
+type Subscription struct {
+	ID           int
+	CollectionID int
+	UserToken    string
+	Amount       int
+	PaymentToken string
+	// BillingDay is the day of month the subscription is charged on, months without it are charged on their last day.
+	BillingDay int
+	Status     string
+	// Period is the billing date being charged, it stays the same while a failed charge is retried.
+	Period time.Time
+	// NextChargeAt is when the scheduler picks the subscription up: the period itself or the next retry.
+	NextChargeAt  time.Time
+	Attempts      int
+	LastError     string
+	LastChargedAt *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	// CollectionName is only set by ClaimDueSubscriptions.
+	CollectionName string
+}
+
+const subscriptionColumns = "s.id, s.collection_id, s.user_token, s.amount, s.payment_token, s.billing_day, s.status, s.period, " +
+	"s.next_charge_at, s.attempts, COALESCE(s.last_error, ''), s.last_charged_at, s.created_at, s.updated_at"
+
+// CreateSubscription stores an active subscription first charged at its Period. It returns pgx.ErrNoRows when the
+// collection does not exist and ErrCollectionClosed when it does not accept donations.
+func (s *PostgresStorage) CreateSubscription(ctx context.Context, subscription *Subscription) (*Subscription, error) {
+	const op = "storage.postgresql.CreateSubscription"
+
+	row := s.db.QueryRow(ctx, `
+		INSERT INTO charity_subscriptions AS s (collection_id, user_token, amount, payment_token, billing_day, status, period, next_charge_at)
+		SELECT id, $2, $3, $4, $5, $6, $7, $7 FROM charity WHERE id = $1 AND deleted_at IS NULL AND state = ANY($8)
+		RETURNING `+subscriptionColumns,
+		subscription.CollectionID, subscription.UserToken, subscription.Amount, subscription.PaymentToken,
+		subscription.BillingDay, SubscriptionActive, subscription.Period, OpenCollectionStates)
+	created, err := scanSubscription(row)
+	if err == nil {
+		return created, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.checkCollectionOpen(ctx, subscription.CollectionID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, ErrCollectionClosed) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return nil, fmt.Errorf("%s: collection %d was closed concurrently: %w", op, subscription.CollectionID, ErrCollectionClosed)
+}
+
+// GetSubscription returns the user's subscription. It returns pgx.ErrNoRows when the user has no such subscription.
+func (s *PostgresStorage) GetSubscription(ctx context.Context, id int, userToken string) (*Subscription, error) {
+	const op = "storage.postgresql.GetSubscription"
+
+	subscription, err := scanSubscription(s.db.QueryRow(ctx, `
+		SELECT `+subscriptionColumns+` FROM charity_subscriptions s
+		WHERE s.id = $1 AND s.user_token = $2`, id, userToken))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return subscription, nil
+}
+
+// GetSubscriptions returns the user's subscriptions that are not cancelled.
+func (s *PostgresStorage) GetSubscriptions(ctx context.Context, userToken string) ([]*Subscription, error) {
+	const op = "storage.postgresql.GetSubscriptions"
+
+	rows, err := s.db.Query(ctx, `
+		SELECT `+subscriptionColumns+` FROM charity_subscriptions s
+		WHERE s.user_token = $1 AND s.status <> $2
+		ORDER BY s.id`, userToken, SubscriptionCancelled)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var subscriptions []*Subscription
+	for rows.Next() {
+		subscription, err := scanSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return subscriptions, nil
+}
+
+// SetSubscriptionStatus moves the user's subscription to status if subscriptionTransitions allows it. A non-nil
+// period replaces the billing date being charged and resets the retries. It returns pgx.ErrNoRows when the user
+// has no such subscription.
+func (s *PostgresStorage) SetSubscriptionStatus(ctx context.Context, id int, userToken string, status string, period *time.Time) (*Subscription, error) {
+	const op = "storage.postgresql.SetSubscriptionStatus"
+
+	row := s.db.QueryRow(ctx, `
+		UPDATE charity_subscriptions s
+		SET status = $3,
+			period = COALESCE($4, s.period),
+			next_charge_at = COALESCE($4, s.next_charge_at),
+			attempts = CASE WHEN $4::TIMESTAMPTZ IS NULL THEN s.attempts ELSE 0 END,
+			updated_at = NOW()
+		WHERE s.id = $1 AND s.user_token = $2 AND s.status = ANY($5)
+		RETURNING `+subscriptionColumns, id, userToken, status, period, subscriptionTransitions[status])
+	subscription, err := scanSubscription(row)
+	if err == nil {
+		return subscription, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var current string
+	err = s.db.QueryRow(ctx, `SELECT status FROM charity_subscriptions WHERE id = $1 AND user_token = $2`, id, userToken).Scan(&current)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return nil, fmt.Errorf("%w: subscription is %s, cannot become %s", ErrInvalidTransition, current, status)
+}
+
+// ClaimDueSubscriptions leases up to limit active subscriptions due at now by moving their next charge to
+// now+lease, so a crashed worker's charges are picked up again once the lease expires. Rows are locked with
+// SKIP LOCKED so several replicas can charge at once.
+func (s *PostgresStorage) ClaimDueSubscriptions(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*Subscription, error) {
+	const op = "storage.postgresql.ClaimDueSubscriptions"
+
+	rows, err := s.db.Query(ctx, `
+		WITH due AS (
+			SELECT id FROM charity_subscriptions
+			WHERE status = $1 AND next_charge_at <= $2
+			ORDER BY next_charge_at
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE charity_subscriptions s
+		SET next_charge_at = $3, updated_at = NOW()
+		FROM due, charity c
+		WHERE s.id = due.id AND c.id = s.collection_id
+		RETURNING `+subscriptionColumns+`, c.name`, SubscriptionActive, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var subscriptions []*Subscription
+	for rows.Next() {
+		subscription := &Subscription{}
+		if err := rows.Scan(append(subscriptionFields(subscription), &subscription.CollectionName)...); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return subscriptions, nil
+}
+
+// RescheduleSubscription records the outcome of the charge of the billing date period: the billing date to charge
+// next, when to try it, the failed attempts so far and the last error, and stores the outbox messages about it in the
+// same transaction. charged marks a successful charge. The schedule is only changed while the subscription is still
+// active at period, so a subscription paused, cancelled or resumed during the charge keeps the status and the
+// billing date it got meanwhile.
+func (s *PostgresStorage) RescheduleSubscription(ctx context.Context, period time.Time, subscription *Subscription, charged bool, messages []*OutboxMessage) error {
+	const op = "storage.postgresql.RescheduleSubscription"
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: failed to begin transaction: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		UPDATE charity_subscriptions
+		SET period = $2,
+			next_charge_at = $3,
+			attempts = $4,
+			last_error = NULLIF($5, ''),
+			updated_at = NOW()
+		WHERE id = $1 AND status = $6 AND period = $7`,
+		subscription.ID, subscription.Period, subscription.NextChargeAt, subscription.Attempts, subscription.LastError,
+		SubscriptionActive, period)
+	if err != nil {
+		return fmt.Errorf("%s: failed to update subscription: %w", op, err)
+	}
+	if charged {
+		_, err = tx.Exec(ctx, `UPDATE charity_subscriptions SET last_charged_at = NOW() WHERE id = $1`, subscription.ID)
+		if err != nil {
+			return fmt.Errorf("%s: failed to record charge: %w", op, err)
+		}
+	}
+	if err := insertOutbox(ctx, tx, messages); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: failed to commit transaction: %w", op, err)
+	}
+	return nil
+}
+
+func subscriptionFields(subscription *Subscription) []any {
+	return []any{
+		&subscription.ID, &subscription.CollectionID, &subscription.UserToken, &subscription.Amount, &subscription.PaymentToken,
+		&subscription.BillingDay, &subscription.Status, &subscription.Period, &subscription.NextChargeAt, &subscription.Attempts,
+		&subscription.LastError, &subscription.LastChargedAt, &subscription.CreatedAt, &subscription.UpdatedAt,
+	}
+}
+
+func scanSubscription(row pgx.Row) (*Subscription, error) {
+	subscription := &Subscription{}
+	if err := row.Scan(subscriptionFields(subscription)...); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
@@ -0,0 +1,29 @@
+package subscriptions
+
+import (
+	"time"
+)
+
+// NextPeriod returns the billing date a month after period. Months shorter than day are billed on their last day.
+func NextPeriod(period time.Time, day int) time.Time {
+	return billingDate(period.Year(), period.Month()+1, day, period)
+}
+
+// ResumePeriod returns the first billing date of a resumed subscription: the one of the current month unless it
+// has already passed. Months missed while the subscription was paused are not charged.
+func ResumePeriod(period time.Time, day int, now time.Time) time.Time {
+	now = now.In(period.Location())
+	resumed := billingDate(now.Year(), now.Month(), day, period)
+	if resumed.Before(now) {
+		return NextPeriod(resumed, day)
+	}
+	return resumed
+}
+
+// billingDate returns the date with the given day in the month, at the time of day of clock.
+func billingDate(year int, month time.Month, day int, clock time.Time) time.Time {
+	if last := time.Date(year, month+1, 0, 0, 0, 0, 0, clock.Location()).Day(); day > last {
+		day = last
+	}
+	return time.Date(year, month, day, clock.Hour(), clock.Minute(), clock.Second(), 0, clock.Location())
+}
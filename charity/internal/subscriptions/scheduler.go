@@ -0,0 +1,140 @@
+package subscriptions
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/GP-Hacks/kdt2024-charity/config"
+	"github.com/GP-Hacks/kdt2024-charity/internal/donation"
+	"github.com/GP-Hacks/kdt2024-charity/internal/storage"
+)
+
+// Scheduler charges monthly subscriptions on their billing dates. A failed charge is retried with a doubling delay
+// and the month is skipped once the retries run out. Several instances can run at once: every subscription is
+// leased to a single scheduler while it is charged.
+type Scheduler struct {
+	cfg       *config.Config
+	storage   *storage.PostgresStorage
+	processor *donation.Processor
+	logger    *slog.Logger
+}
+
+func NewScheduler(cfg *config.Config, storage *storage.PostgresStorage, processor *donation.Processor, logger *slog.Logger) *Scheduler {
+	return &Scheduler{cfg: cfg, storage: storage, processor: processor, logger: logger}
+}
+
+func (s *Scheduler) Run(ctx context.Context) {
+	s.logger.Info("Subscriptions scheduler started", slog.Duration("interval", s.cfg.SubscriptionsInterval))
+	ticker := time.NewTicker(s.cfg.SubscriptionsInterval)
+	defer ticker.Stop()
+
+	for {
+		due, err := s.storage.ClaimDueSubscriptions(ctx, time.Now(), s.cfg.SubscriptionsLease, s.cfg.SubscriptionsBatch)
+		if err != nil {
+			s.logger.Error("Failed to claim due subscriptions", slog.String("error", err.Error()))
+		}
+		for _, subscription := range due {
+			s.charge(ctx, subscription)
+		}
+		if len(due) > 0 {
+			s.logger.Info("Due subscriptions processed", slog.Int("count", len(due)))
+		}
+
+		// Keep draining without waiting while there is a backlog.
+		if err == nil && len(due) == s.cfg.SubscriptionsBatch {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Subscriptions scheduler stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) charge(ctx context.Context, subscription *storage.Subscription) {
+	period := subscription.Period.In(s.cfg.Location)
+	logger := s.logger.With(slog.Int("subscription_id", subscription.ID), slog.Time("period", period), slog.Int("attempt", subscription.Attempts+1))
+
+	// The attempt is part of the reference, so a retry is a new payment while a charge interrupted by a crash is
+	// continued once the lease expires.
+	_, err := s.processor.Charge(ctx, donation.Request{
+		CollectionID:   subscription.CollectionID,
+		UserID:         subscription.UserToken,
+		Amount:         subscription.Amount,
+		PaymentToken:   subscription.PaymentToken,
+		SubscriptionID: subscription.ID,
+		Reference:      fmt.Sprintf("subscription-%d-%s-%d", subscription.ID, period.Format("2006-01-02"), subscription.Attempts),
+		Notification:   chargedNotification(subscription, time.Now()),
+	})
+
+	next := *subscription
+	var messages []*storage.OutboxMessage
+	switch {
+	case err == nil:
+		logger.Info("Subscription charged")
+		next.Period = NextPeriod(period, subscription.BillingDay)
+		next.NextChargeAt = next.Period
+		next.Attempts = 0
+		next.LastError = ""
+	case subscription.Attempts < s.cfg.SubscriptionRetries:
+		next.NextChargeAt = time.Now().Add(s.cfg.SubscriptionRetryDelay << subscription.Attempts)
+		next.Attempts++
+		next.LastError = err.Error()
+		logger.Warn("Subscription charge failed, will retry", slog.Time("retry_at", next.NextChargeAt), slog.String("error", err.Error()))
+	default:
+		next.Period = NextPeriod(period, subscription.BillingDay)
+		next.NextChargeAt = next.Period
+		next.Attempts = 0
+		next.LastError = err.Error()
+		logger.Warn("Subscription charge failed, skipping the month", slog.String("error", err.Error()))
+
+		message, err := s.processor.NotificationOutbox(failedNotification(subscription, time.Now()))
+		if err != nil {
+			logger.Error("Failed to build subscription notification", slog.String("error", err.Error()))
+		} else {
+			messages = append(messages, message)
+		}
+	}
+
+	if err := s.storage.RescheduleSubscription(ctx, subscription.Period, &next, err == nil, messages); err != nil {
+		// The lease expires and the same attempt is made again, continuing the donation if it was charged.
+		logger.Error("Failed to reschedule subscription", slog.String("error", err.Error()))
+	}
+}
+
+func chargedNotification(subscription *storage.Subscription, now time.Time) *donation.NotificationMessage {
+	return &donation.NotificationMessage{
+		UserID:   subscription.UserToken,
+		Category: donation.NotificationCategory,
+		Header:   "Ежемесячное пожертвование",
+		Content:  fmt.Sprintf("Спасибо! %d ₽ направлены в сбор «%s»", subscription.Amount, subscription.CollectionName),
+		Time:     now,
+		Translations: map[string]donation.NotificationTranslation{
+			"en": {
+				Header:  "Monthly donation",
+				Content: fmt.Sprintf("Thank you! %d ₽ went to «%s»", subscription.Amount, subscription.CollectionName),
+			},
+		},
+	}
+}
+
+func failedNotification(subscription *storage.Subscription, now time.Time) *donation.NotificationMessage {
+	return &donation.NotificationMessage{
+		UserID:   subscription.UserToken,
+		Category: donation.NotificationCategory,
+		Header:   "Не удалось списать пожертвование",
+		Content:  fmt.Sprintf("Ежемесячное пожертвование в сбор «%s» в этом месяце не списано. Проверьте способ оплаты", subscription.CollectionName),
+		Time:     now,
+		Translations: map[string]donation.NotificationTranslation{
+			"en": {
+				Header:  "Donation was not charged",
+				Content: fmt.Sprintf("This month's donation to «%s» could not be charged. Please check your payment method", subscription.CollectionName),
+			},
+		},
+	}
+}
@@ -159,11 +159,21 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	PlacesService_GetPlaces_FullMethodName     = "/api.PlacesService/GetPlaces"
-	PlacesService_GetCategories_FullMethodName = "/api.PlacesService/GetCategories"
-	PlacesService_BuyTicket_FullMethodName     = "/api.PlacesService/BuyTicket"
-	PlacesService_GetTickets_FullMethodName    = "/api.PlacesService/GetTickets"
-	PlacesService_HealthCheck_FullMethodName   = "/api.PlacesService/HealthCheck"
+	PlacesService_GetPlaces_FullMethodName         = "/api.PlacesService/GetPlaces"
+	PlacesService_GetCategories_FullMethodName     = "/api.PlacesService/GetCategories"
+	PlacesService_BuyTicket_FullMethodName         = "/api.PlacesService/BuyTicket"
+	PlacesService_GetTickets_FullMethodName        = "/api.PlacesService/GetTickets"
+	PlacesService_CancelTicket_FullMethodName      = "/api.PlacesService/CancelTicket"
+	PlacesService_GetTicketCode_FullMethodName     = "/api.PlacesService/GetTicketCode"
+	PlacesService_ValidateTicket_FullMethodName    = "/api.PlacesService/ValidateTicket"
+	PlacesService_GetAvailableSlots_FullMethodName = "/api.PlacesService/GetAvailableSlots"
+	PlacesService_Search_FullMethodName            = "/api.PlacesService/Search"
+	PlacesService_CreatePlace_FullMethodName       = "/api.PlacesService/CreatePlace"
+	PlacesService_UpdatePlace_FullMethodName       = "/api.PlacesService/UpdatePlace"
+	PlacesService_DeletePlace_FullMethodName       = "/api.PlacesService/DeletePlace"
+	PlacesService_AddPlacePhoto_FullMethodName     = "/api.PlacesService/AddPlacePhoto"
+	PlacesService_DeletePlacePhoto_FullMethodName  = "/api.PlacesService/DeletePlacePhoto"
+	PlacesService_HealthCheck_FullMethodName       = "/api.PlacesService/HealthCheck"
 )
 
 // PlacesServiceClient is the client API for PlacesService service.
@@ -174,6 +184,17 @@ type PlacesServiceClient interface {
 	GetCategories(ctx context.Context, in *GetCategoriesRequest, opts ...grpc.CallOption) (*GetCategoriesResponse, error)
 	BuyTicket(ctx context.Context, in *BuyTicketRequest, opts ...grpc.CallOption) (*BuyTicketResponse, error)
 	GetTickets(ctx context.Context, in *GetTicketsRequest, opts ...grpc.CallOption) (*GetTicketsResponse, error)
+	CancelTicket(ctx context.Context, in *CancelTicketRequest, opts ...grpc.CallOption) (*CancelTicketResponse, error)
+	GetTicketCode(ctx context.Context, in *GetTicketCodeRequest, opts ...grpc.CallOption) (*GetTicketCodeResponse, error)
+	ValidateTicket(ctx context.Context, in *ValidateTicketRequest, opts ...grpc.CallOption) (*ValidateTicketResponse, error)
+	GetAvailableSlots(ctx context.Context, in *GetAvailableSlotsRequest, opts ...grpc.CallOption) (*GetAvailableSlotsResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// Admin API, requires the "admin" role.
+	CreatePlace(ctx context.Context, in *CreatePlaceRequest, opts ...grpc.CallOption) (*PlaceResponse, error)
+	UpdatePlace(ctx context.Context, in *UpdatePlaceRequest, opts ...grpc.CallOption) (*PlaceResponse, error)
+	DeletePlace(ctx context.Context, in *DeletePlaceRequest, opts ...grpc.CallOption) (*DeletePlaceResponse, error)
+	AddPlacePhoto(ctx context.Context, in *AddPlacePhotoRequest, opts ...grpc.CallOption) (*PlacePhotoResponse, error)
+	DeletePlacePhoto(ctx context.Context, in *DeletePlacePhotoRequest, opts ...grpc.CallOption) (*DeletePlaceResponse, error)
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 }
 
@@ -225,6 +246,106 @@ func (c *placesServiceClient) GetTickets(ctx context.Context, in *GetTicketsRequ
 	return out, nil
 }
 
+func (c *placesServiceClient) CancelTicket(ctx context.Context, in *CancelTicketRequest, opts ...grpc.CallOption) (*CancelTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelTicketResponse)
+	err := c.cc.Invoke(ctx, PlacesService_CancelTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *placesServiceClient) GetTicketCode(ctx context.Context, in *GetTicketCodeRequest, opts ...grpc.CallOption) (*GetTicketCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTicketCodeResponse)
+	err := c.cc.Invoke(ctx, PlacesService_GetTicketCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *placesServiceClient) ValidateTicket(ctx context.Context, in *ValidateTicketRequest, opts ...grpc.CallOption) (*ValidateTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateTicketResponse)
+	err := c.cc.Invoke(ctx, PlacesService_ValidateTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *placesServiceClient) GetAvailableSlots(ctx context.Context, in *GetAvailableSlotsRequest, opts ...grpc.CallOption) (*GetAvailableSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAvailableSlotsResponse)
+	err := c.cc.Invoke(ctx, PlacesService_GetAvailableSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *placesServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, PlacesService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *placesServiceClient) CreatePlace(ctx context.Context, in *CreatePlaceRequest, opts ...grpc.CallOption) (*PlaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceResponse)
+	err := c.cc.Invoke(ctx, PlacesService_CreatePlace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *placesServiceClient) UpdatePlace(ctx context.Context, in *UpdatePlaceRequest, opts ...grpc.CallOption) (*PlaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceResponse)
+	err := c.cc.Invoke(ctx, PlacesService_UpdatePlace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *placesServiceClient) DeletePlace(ctx context.Context, in *DeletePlaceRequest, opts ...grpc.CallOption) (*DeletePlaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePlaceResponse)
+	err := c.cc.Invoke(ctx, PlacesService_DeletePlace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *placesServiceClient) AddPlacePhoto(ctx context.Context, in *AddPlacePhotoRequest, opts ...grpc.CallOption) (*PlacePhotoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlacePhotoResponse)
+	err := c.cc.Invoke(ctx, PlacesService_AddPlacePhoto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *placesServiceClient) DeletePlacePhoto(ctx context.Context, in *DeletePlacePhotoRequest, opts ...grpc.CallOption) (*DeletePlaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePlaceResponse)
+	err := c.cc.Invoke(ctx, PlacesService_DeletePlacePhoto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *placesServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HealthCheckResponse)
@@ -243,6 +364,17 @@ type PlacesServiceServer interface {
 	GetCategories(context.Context, *GetCategoriesRequest) (*GetCategoriesResponse, error)
 	BuyTicket(context.Context, *BuyTicketRequest) (*BuyTicketResponse, error)
 	GetTickets(context.Context, *GetTicketsRequest) (*GetTicketsResponse, error)
+	CancelTicket(context.Context, *CancelTicketRequest) (*CancelTicketResponse, error)
+	GetTicketCode(context.Context, *GetTicketCodeRequest) (*GetTicketCodeResponse, error)
+	ValidateTicket(context.Context, *ValidateTicketRequest) (*ValidateTicketResponse, error)
+	GetAvailableSlots(context.Context, *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// Admin API, requires the "admin" role.
+	CreatePlace(context.Context, *CreatePlaceRequest) (*PlaceResponse, error)
+	UpdatePlace(context.Context, *UpdatePlaceRequest) (*PlaceResponse, error)
+	DeletePlace(context.Context, *DeletePlaceRequest) (*DeletePlaceResponse, error)
+	AddPlacePhoto(context.Context, *AddPlacePhotoRequest) (*PlacePhotoResponse, error)
+	DeletePlacePhoto(context.Context, *DeletePlacePhotoRequest) (*DeletePlaceResponse, error)
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
 	mustEmbedUnimplementedPlacesServiceServer()
 }
@@ -266,6 +398,36 @@ func (UnimplementedPlacesServiceServer) BuyTicket(context.Context, *BuyTicketReq
 func (UnimplementedPlacesServiceServer) GetTickets(context.Context, *GetTicketsRequest) (*GetTicketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTickets not implemented")
 }
+func (UnimplementedPlacesServiceServer) CancelTicket(context.Context, *CancelTicketRequest) (*CancelTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTicket not implemented")
+}
+func (UnimplementedPlacesServiceServer) GetTicketCode(context.Context, *GetTicketCodeRequest) (*GetTicketCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicketCode not implemented")
+}
+func (UnimplementedPlacesServiceServer) ValidateTicket(context.Context, *ValidateTicketRequest) (*ValidateTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateTicket not implemented")
+}
+func (UnimplementedPlacesServiceServer) GetAvailableSlots(context.Context, *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableSlots not implemented")
+}
+func (UnimplementedPlacesServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedPlacesServiceServer) CreatePlace(context.Context, *CreatePlaceRequest) (*PlaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlace not implemented")
+}
+func (UnimplementedPlacesServiceServer) UpdatePlace(context.Context, *UpdatePlaceRequest) (*PlaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlace not implemented")
+}
+func (UnimplementedPlacesServiceServer) DeletePlace(context.Context, *DeletePlaceRequest) (*DeletePlaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlace not implemented")
+}
+func (UnimplementedPlacesServiceServer) AddPlacePhoto(context.Context, *AddPlacePhotoRequest) (*PlacePhotoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPlacePhoto not implemented")
+}
+func (UnimplementedPlacesServiceServer) DeletePlacePhoto(context.Context, *DeletePlacePhotoRequest) (*DeletePlaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlacePhoto not implemented")
+}
 func (UnimplementedPlacesServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
@@ -362,6 +524,186 @@ func _PlacesService_GetTickets_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlacesService_CancelTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlacesServiceServer).CancelTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlacesService_CancelTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlacesServiceServer).CancelTicket(ctx, req.(*CancelTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlacesService_GetTicketCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTicketCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlacesServiceServer).GetTicketCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlacesService_GetTicketCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlacesServiceServer).GetTicketCode(ctx, req.(*GetTicketCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlacesService_ValidateTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlacesServiceServer).ValidateTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlacesService_ValidateTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlacesServiceServer).ValidateTicket(ctx, req.(*ValidateTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlacesService_GetAvailableSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailableSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlacesServiceServer).GetAvailableSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlacesService_GetAvailableSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlacesServiceServer).GetAvailableSlots(ctx, req.(*GetAvailableSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlacesService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlacesServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlacesService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlacesServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlacesService_CreatePlace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlacesServiceServer).CreatePlace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlacesService_CreatePlace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlacesServiceServer).CreatePlace(ctx, req.(*CreatePlaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlacesService_UpdatePlace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlacesServiceServer).UpdatePlace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlacesService_UpdatePlace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlacesServiceServer).UpdatePlace(ctx, req.(*UpdatePlaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlacesService_DeletePlace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlacesServiceServer).DeletePlace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlacesService_DeletePlace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlacesServiceServer).DeletePlace(ctx, req.(*DeletePlaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlacesService_AddPlacePhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPlacePhotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlacesServiceServer).AddPlacePhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlacesService_AddPlacePhoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlacesServiceServer).AddPlacePhoto(ctx, req.(*AddPlacePhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlacesService_DeletePlacePhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlacePhotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlacesServiceServer).DeletePlacePhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlacesService_DeletePlacePhoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlacesServiceServer).DeletePlacePhoto(ctx, req.(*DeletePlacePhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PlacesService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HealthCheckRequest)
 	if err := dec(in); err != nil {
@@ -403,6 +745,46 @@ var PlacesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTickets",
 			Handler:    _PlacesService_GetTickets_Handler,
 		},
+		{
+			MethodName: "CancelTicket",
+			Handler:    _PlacesService_CancelTicket_Handler,
+		},
+		{
+			MethodName: "GetTicketCode",
+			Handler:    _PlacesService_GetTicketCode_Handler,
+		},
+		{
+			MethodName: "ValidateTicket",
+			Handler:    _PlacesService_ValidateTicket_Handler,
+		},
+		{
+			MethodName: "GetAvailableSlots",
+			Handler:    _PlacesService_GetAvailableSlots_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _PlacesService_Search_Handler,
+		},
+		{
+			MethodName: "CreatePlace",
+			Handler:    _PlacesService_CreatePlace_Handler,
+		},
+		{
+			MethodName: "UpdatePlace",
+			Handler:    _PlacesService_UpdatePlace_Handler,
+		},
+		{
+			MethodName: "DeletePlace",
+			Handler:    _PlacesService_DeletePlace_Handler,
+		},
+		{
+			MethodName: "AddPlacePhoto",
+			Handler:    _PlacesService_AddPlacePhoto_Handler,
+		},
+		{
+			MethodName: "DeletePlacePhoto",
+			Handler:    _PlacesService_DeletePlacePhoto_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _PlacesService_HealthCheck_Handler,
@@ -413,10 +795,20 @@ var PlacesService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	CharityService_GetCollections_FullMethodName = "/api.CharityService/GetCollections"
-	CharityService_GetCategories_FullMethodName  = "/api.CharityService/GetCategories"
-	CharityService_Donate_FullMethodName         = "/api.CharityService/Donate"
-	CharityService_HealthCheck_FullMethodName    = "/api.CharityService/HealthCheck"
+	CharityService_GetCollections_FullMethodName     = "/api.CharityService/GetCollections"
+	CharityService_GetCategories_FullMethodName      = "/api.CharityService/GetCategories"
+	CharityService_Donate_FullMethodName             = "/api.CharityService/Donate"
+	CharityService_RefundDonation_FullMethodName     = "/api.CharityService/RefundDonation"
+	CharityService_CreateSubscription_FullMethodName = "/api.CharityService/CreateSubscription"
+	CharityService_GetSubscriptions_FullMethodName   = "/api.CharityService/GetSubscriptions"
+	CharityService_PauseSubscription_FullMethodName  = "/api.CharityService/PauseSubscription"
+	CharityService_ResumeSubscription_FullMethodName = "/api.CharityService/ResumeSubscription"
+	CharityService_CancelSubscription_FullMethodName = "/api.CharityService/CancelSubscription"
+	CharityService_Search_FullMethodName             = "/api.CharityService/Search"
+	CharityService_CreateCollection_FullMethodName   = "/api.CharityService/CreateCollection"
+	CharityService_UpdateCollection_FullMethodName   = "/api.CharityService/UpdateCollection"
+	CharityService_DeleteCollection_FullMethodName   = "/api.CharityService/DeleteCollection"
+	CharityService_HealthCheck_FullMethodName        = "/api.CharityService/HealthCheck"
 )
 
 // CharityServiceClient is the client API for CharityService service.
@@ -426,6 +818,17 @@ type CharityServiceClient interface {
 	GetCollections(ctx context.Context, in *GetCollectionsRequest, opts ...grpc.CallOption) (*GetCollectionsResponse, error)
 	GetCategories(ctx context.Context, in *GetCategoriesRequest, opts ...grpc.CallOption) (*GetCategoriesResponse, error)
 	Donate(ctx context.Context, in *DonateRequest, opts ...grpc.CallOption) (*DonateResponse, error)
+	RefundDonation(ctx context.Context, in *RefundDonationRequest, opts ...grpc.CallOption) (*RefundDonationResponse, error)
+	CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
+	GetSubscriptions(ctx context.Context, in *GetSubscriptionsRequest, opts ...grpc.CallOption) (*GetSubscriptionsResponse, error)
+	PauseSubscription(ctx context.Context, in *SubscriptionActionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
+	ResumeSubscription(ctx context.Context, in *SubscriptionActionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
+	CancelSubscription(ctx context.Context, in *SubscriptionActionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// Admin API, requires the "admin" role.
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CollectionResponse, error)
+	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*CollectionResponse, error)
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 }
 
@@ -467,36 +870,147 @@ func (c *charityServiceClient) Donate(ctx context.Context, in *DonateRequest, op
 	return out, nil
 }
 
-func (c *charityServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+func (c *charityServiceClient) RefundDonation(ctx context.Context, in *RefundDonationRequest, opts ...grpc.CallOption) (*RefundDonationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(HealthCheckResponse)
-	err := c.cc.Invoke(ctx, CharityService_HealthCheck_FullMethodName, in, out, cOpts...)
+	out := new(RefundDonationResponse)
+	err := c.cc.Invoke(ctx, CharityService_RefundDonation_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// CharityServiceServer is the server API for CharityService service.
-// All implementations must embed UnimplementedCharityServiceServer
-// for forward compatibility.
-type CharityServiceServer interface {
-	GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error)
-	GetCategories(context.Context, *GetCategoriesRequest) (*GetCategoriesResponse, error)
-	Donate(context.Context, *DonateRequest) (*DonateResponse, error)
-	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
-	mustEmbedUnimplementedCharityServiceServer()
+func (c *charityServiceClient) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionResponse)
+	err := c.cc.Invoke(ctx, CharityService_CreateSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedCharityServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedCharityServiceServer struct{}
-
-func (UnimplementedCharityServiceServer) GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCollections not implemented")
+func (c *charityServiceClient) GetSubscriptions(ctx context.Context, in *GetSubscriptionsRequest, opts ...grpc.CallOption) (*GetSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, CharityService_GetSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *charityServiceClient) PauseSubscription(ctx context.Context, in *SubscriptionActionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionResponse)
+	err := c.cc.Invoke(ctx, CharityService_PauseSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *charityServiceClient) ResumeSubscription(ctx context.Context, in *SubscriptionActionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionResponse)
+	err := c.cc.Invoke(ctx, CharityService_ResumeSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *charityServiceClient) CancelSubscription(ctx context.Context, in *SubscriptionActionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionResponse)
+	err := c.cc.Invoke(ctx, CharityService_CancelSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *charityServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, CharityService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *charityServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*CollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionResponse)
+	err := c.cc.Invoke(ctx, CharityService_CreateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *charityServiceClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*CollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionResponse)
+	err := c.cc.Invoke(ctx, CharityService_UpdateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *charityServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCollectionResponse)
+	err := c.cc.Invoke(ctx, CharityService_DeleteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *charityServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, CharityService_HealthCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CharityServiceServer is the server API for CharityService service.
+// All implementations must embed UnimplementedCharityServiceServer
+// for forward compatibility.
+type CharityServiceServer interface {
+	GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error)
+	GetCategories(context.Context, *GetCategoriesRequest) (*GetCategoriesResponse, error)
+	Donate(context.Context, *DonateRequest) (*DonateResponse, error)
+	RefundDonation(context.Context, *RefundDonationRequest) (*RefundDonationResponse, error)
+	CreateSubscription(context.Context, *CreateSubscriptionRequest) (*SubscriptionResponse, error)
+	GetSubscriptions(context.Context, *GetSubscriptionsRequest) (*GetSubscriptionsResponse, error)
+	PauseSubscription(context.Context, *SubscriptionActionRequest) (*SubscriptionResponse, error)
+	ResumeSubscription(context.Context, *SubscriptionActionRequest) (*SubscriptionResponse, error)
+	CancelSubscription(context.Context, *SubscriptionActionRequest) (*SubscriptionResponse, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	// Admin API, requires the "admin" role.
+	CreateCollection(context.Context, *CreateCollectionRequest) (*CollectionResponse, error)
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*CollectionResponse, error)
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
+	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
+	mustEmbedUnimplementedCharityServiceServer()
+}
+
+// UnimplementedCharityServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCharityServiceServer struct{}
+
+func (UnimplementedCharityServiceServer) GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollections not implemented")
 }
 func (UnimplementedCharityServiceServer) GetCategories(context.Context, *GetCategoriesRequest) (*GetCategoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCategories not implemented")
@@ -504,6 +1018,36 @@ func (UnimplementedCharityServiceServer) GetCategories(context.Context, *GetCate
 func (UnimplementedCharityServiceServer) Donate(context.Context, *DonateRequest) (*DonateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Donate not implemented")
 }
+func (UnimplementedCharityServiceServer) RefundDonation(context.Context, *RefundDonationRequest) (*RefundDonationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundDonation not implemented")
+}
+func (UnimplementedCharityServiceServer) CreateSubscription(context.Context, *CreateSubscriptionRequest) (*SubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
+}
+func (UnimplementedCharityServiceServer) GetSubscriptions(context.Context, *GetSubscriptionsRequest) (*GetSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscriptions not implemented")
+}
+func (UnimplementedCharityServiceServer) PauseSubscription(context.Context, *SubscriptionActionRequest) (*SubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSubscription not implemented")
+}
+func (UnimplementedCharityServiceServer) ResumeSubscription(context.Context, *SubscriptionActionRequest) (*SubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSubscription not implemented")
+}
+func (UnimplementedCharityServiceServer) CancelSubscription(context.Context, *SubscriptionActionRequest) (*SubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSubscription not implemented")
+}
+func (UnimplementedCharityServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedCharityServiceServer) CreateCollection(context.Context, *CreateCollectionRequest) (*CollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedCharityServiceServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*CollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
+}
+func (UnimplementedCharityServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
+}
 func (UnimplementedCharityServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
@@ -582,6 +1126,186 @@ func _CharityService_Donate_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CharityService_RefundDonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundDonationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharityServiceServer).RefundDonation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharityService_RefundDonation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharityServiceServer).RefundDonation(ctx, req.(*RefundDonationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharityService_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharityServiceServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharityService_CreateSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharityServiceServer).CreateSubscription(ctx, req.(*CreateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharityService_GetSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharityServiceServer).GetSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharityService_GetSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharityServiceServer).GetSubscriptions(ctx, req.(*GetSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharityService_PauseSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharityServiceServer).PauseSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharityService_PauseSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharityServiceServer).PauseSubscription(ctx, req.(*SubscriptionActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharityService_ResumeSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharityServiceServer).ResumeSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharityService_ResumeSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharityServiceServer).ResumeSubscription(ctx, req.(*SubscriptionActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharityService_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharityServiceServer).CancelSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharityService_CancelSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharityServiceServer).CancelSubscription(ctx, req.(*SubscriptionActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharityService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharityServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharityService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharityServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharityService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharityServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharityService_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharityServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharityService_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharityServiceServer).UpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharityService_UpdateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharityServiceServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharityService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharityServiceServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharityService_DeleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharityServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CharityService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HealthCheckRequest)
 	if err := dec(in); err != nil {
@@ -619,6 +1343,46 @@ var CharityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Donate",
 			Handler:    _CharityService_Donate_Handler,
 		},
+		{
+			MethodName: "RefundDonation",
+			Handler:    _CharityService_RefundDonation_Handler,
+		},
+		{
+			MethodName: "CreateSubscription",
+			Handler:    _CharityService_CreateSubscription_Handler,
+		},
+		{
+			MethodName: "GetSubscriptions",
+			Handler:    _CharityService_GetSubscriptions_Handler,
+		},
+		{
+			MethodName: "PauseSubscription",
+			Handler:    _CharityService_PauseSubscription_Handler,
+		},
+		{
+			MethodName: "ResumeSubscription",
+			Handler:    _CharityService_ResumeSubscription_Handler,
+		},
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _CharityService_CancelSubscription_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _CharityService_Search_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _CharityService_CreateCollection_Handler,
+		},
+		{
+			MethodName: "UpdateCollection",
+			Handler:    _CharityService_UpdateCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _CharityService_DeleteCollection_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _CharityService_HealthCheck_Handler,
@@ -629,15 +1393,27 @@ var CharityService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	VotesService_GetVotes_FullMethodName        = "/api.VotesService/GetVotes"
-	VotesService_GetCategories_FullMethodName   = "/api.VotesService/GetCategories"
-	VotesService_GetRateInfo_FullMethodName     = "/api.VotesService/GetRateInfo"
-	VotesService_GetPetitionInfo_FullMethodName = "/api.VotesService/GetPetitionInfo"
-	VotesService_GetChoiceInfo_FullMethodName   = "/api.VotesService/GetChoiceInfo"
-	VotesService_VoteRate_FullMethodName        = "/api.VotesService/VoteRate"
-	VotesService_VotePetition_FullMethodName    = "/api.VotesService/VotePetition"
-	VotesService_VoteChoice_FullMethodName      = "/api.VotesService/VoteChoice"
-	VotesService_HealthCheck_FullMethodName     = "/api.VotesService/HealthCheck"
+	VotesService_GetVotes_FullMethodName            = "/api.VotesService/GetVotes"
+	VotesService_GetCategories_FullMethodName       = "/api.VotesService/GetCategories"
+	VotesService_GetRateInfo_FullMethodName         = "/api.VotesService/GetRateInfo"
+	VotesService_GetPetitionInfo_FullMethodName     = "/api.VotesService/GetPetitionInfo"
+	VotesService_GetChoiceInfo_FullMethodName       = "/api.VotesService/GetChoiceInfo"
+	VotesService_GetMultiSelectInfo_FullMethodName  = "/api.VotesService/GetMultiSelectInfo"
+	VotesService_GetRankedInfo_FullMethodName       = "/api.VotesService/GetRankedInfo"
+	VotesService_Search_FullMethodName              = "/api.VotesService/Search"
+	VotesService_GetPetitions_FullMethodName        = "/api.VotesService/GetPetitions"
+	VotesService_VoteRate_FullMethodName            = "/api.VotesService/VoteRate"
+	VotesService_VotePetition_FullMethodName        = "/api.VotesService/VotePetition"
+	VotesService_VoteChoice_FullMethodName          = "/api.VotesService/VoteChoice"
+	VotesService_VoteMultiSelect_FullMethodName     = "/api.VotesService/VoteMultiSelect"
+	VotesService_VoteRanked_FullMethodName          = "/api.VotesService/VoteRanked"
+	VotesService_CreateVote_FullMethodName          = "/api.VotesService/CreateVote"
+	VotesService_UpdateVote_FullMethodName          = "/api.VotesService/UpdateVote"
+	VotesService_DeleteVote_FullMethodName          = "/api.VotesService/DeleteVote"
+	VotesService_AddVoteOption_FullMethodName       = "/api.VotesService/AddVoteOption"
+	VotesService_DeleteVoteOption_FullMethodName    = "/api.VotesService/DeleteVoteOption"
+	VotesService_UpdatePetitionState_FullMethodName = "/api.VotesService/UpdatePetitionState"
+	VotesService_HealthCheck_FullMethodName         = "/api.VotesService/HealthCheck"
 )
 
 // VotesServiceClient is the client API for VotesService service.
@@ -649,9 +1425,22 @@ type VotesServiceClient interface {
 	GetRateInfo(ctx context.Context, in *GetVoteInfoRequest, opts ...grpc.CallOption) (*GetRateInfoResponse, error)
 	GetPetitionInfo(ctx context.Context, in *GetVoteInfoRequest, opts ...grpc.CallOption) (*GetPetitionInfoResponse, error)
 	GetChoiceInfo(ctx context.Context, in *GetVoteInfoRequest, opts ...grpc.CallOption) (*GetChoiceInfoResponse, error)
+	GetMultiSelectInfo(ctx context.Context, in *GetVoteInfoRequest, opts ...grpc.CallOption) (*GetMultiSelectInfoResponse, error)
+	GetRankedInfo(ctx context.Context, in *GetVoteInfoRequest, opts ...grpc.CallOption) (*GetRankedInfoResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	GetPetitions(ctx context.Context, in *GetPetitionsRequest, opts ...grpc.CallOption) (*GetPetitionsResponse, error)
 	VoteRate(ctx context.Context, in *VoteRateRequest, opts ...grpc.CallOption) (*VoteResponse, error)
 	VotePetition(ctx context.Context, in *VotePetitionRequest, opts ...grpc.CallOption) (*VoteResponse, error)
 	VoteChoice(ctx context.Context, in *VoteChoiceRequest, opts ...grpc.CallOption) (*VoteResponse, error)
+	VoteMultiSelect(ctx context.Context, in *VoteMultiSelectRequest, opts ...grpc.CallOption) (*VoteResponse, error)
+	VoteRanked(ctx context.Context, in *VoteRankedRequest, opts ...grpc.CallOption) (*VoteResponse, error)
+	// Admin API, requires the "admin" role.
+	CreateVote(ctx context.Context, in *CreateVoteRequest, opts ...grpc.CallOption) (*AdminVoteResponse, error)
+	UpdateVote(ctx context.Context, in *UpdateVoteRequest, opts ...grpc.CallOption) (*AdminVoteResponse, error)
+	DeleteVote(ctx context.Context, in *DeleteVoteRequest, opts ...grpc.CallOption) (*DeleteVoteResponse, error)
+	AddVoteOption(ctx context.Context, in *AddVoteOptionRequest, opts ...grpc.CallOption) (*VoteOptionResponse, error)
+	DeleteVoteOption(ctx context.Context, in *DeleteVoteOptionRequest, opts ...grpc.CallOption) (*DeleteVoteResponse, error)
+	UpdatePetitionState(ctx context.Context, in *UpdatePetitionStateRequest, opts ...grpc.CallOption) (*GetPetitionInfoResponse, error)
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 }
 
@@ -713,37 +1502,157 @@ func (c *votesServiceClient) GetChoiceInfo(ctx context.Context, in *GetVoteInfoR
 	return out, nil
 }
 
-func (c *votesServiceClient) VoteRate(ctx context.Context, in *VoteRateRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
+func (c *votesServiceClient) GetMultiSelectInfo(ctx context.Context, in *GetVoteInfoRequest, opts ...grpc.CallOption) (*GetMultiSelectInfoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(VoteResponse)
-	err := c.cc.Invoke(ctx, VotesService_VoteRate_FullMethodName, in, out, cOpts...)
+	out := new(GetMultiSelectInfoResponse)
+	err := c.cc.Invoke(ctx, VotesService_GetMultiSelectInfo_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *votesServiceClient) VotePetition(ctx context.Context, in *VotePetitionRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
+func (c *votesServiceClient) GetRankedInfo(ctx context.Context, in *GetVoteInfoRequest, opts ...grpc.CallOption) (*GetRankedInfoResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(VoteResponse)
-	err := c.cc.Invoke(ctx, VotesService_VotePetition_FullMethodName, in, out, cOpts...)
+	out := new(GetRankedInfoResponse)
+	err := c.cc.Invoke(ctx, VotesService_GetRankedInfo_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *votesServiceClient) VoteChoice(ctx context.Context, in *VoteChoiceRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
+func (c *votesServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(VoteResponse)
-	err := c.cc.Invoke(ctx, VotesService_VoteChoice_FullMethodName, in, out, cOpts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, VotesService_Search_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *votesServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+func (c *votesServiceClient) GetPetitions(ctx context.Context, in *GetPetitionsRequest, opts ...grpc.CallOption) (*GetPetitionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPetitionsResponse)
+	err := c.cc.Invoke(ctx, VotesService_GetPetitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votesServiceClient) VoteRate(ctx context.Context, in *VoteRateRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteResponse)
+	err := c.cc.Invoke(ctx, VotesService_VoteRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votesServiceClient) VotePetition(ctx context.Context, in *VotePetitionRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteResponse)
+	err := c.cc.Invoke(ctx, VotesService_VotePetition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votesServiceClient) VoteChoice(ctx context.Context, in *VoteChoiceRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteResponse)
+	err := c.cc.Invoke(ctx, VotesService_VoteChoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votesServiceClient) VoteMultiSelect(ctx context.Context, in *VoteMultiSelectRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteResponse)
+	err := c.cc.Invoke(ctx, VotesService_VoteMultiSelect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votesServiceClient) VoteRanked(ctx context.Context, in *VoteRankedRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteResponse)
+	err := c.cc.Invoke(ctx, VotesService_VoteRanked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votesServiceClient) CreateVote(ctx context.Context, in *CreateVoteRequest, opts ...grpc.CallOption) (*AdminVoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminVoteResponse)
+	err := c.cc.Invoke(ctx, VotesService_CreateVote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votesServiceClient) UpdateVote(ctx context.Context, in *UpdateVoteRequest, opts ...grpc.CallOption) (*AdminVoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminVoteResponse)
+	err := c.cc.Invoke(ctx, VotesService_UpdateVote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votesServiceClient) DeleteVote(ctx context.Context, in *DeleteVoteRequest, opts ...grpc.CallOption) (*DeleteVoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteVoteResponse)
+	err := c.cc.Invoke(ctx, VotesService_DeleteVote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votesServiceClient) AddVoteOption(ctx context.Context, in *AddVoteOptionRequest, opts ...grpc.CallOption) (*VoteOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteOptionResponse)
+	err := c.cc.Invoke(ctx, VotesService_AddVoteOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votesServiceClient) DeleteVoteOption(ctx context.Context, in *DeleteVoteOptionRequest, opts ...grpc.CallOption) (*DeleteVoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteVoteResponse)
+	err := c.cc.Invoke(ctx, VotesService_DeleteVoteOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votesServiceClient) UpdatePetitionState(ctx context.Context, in *UpdatePetitionStateRequest, opts ...grpc.CallOption) (*GetPetitionInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPetitionInfoResponse)
+	err := c.cc.Invoke(ctx, VotesService_UpdatePetitionState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *votesServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HealthCheckResponse)
 	err := c.cc.Invoke(ctx, VotesService_HealthCheck_FullMethodName, in, out, cOpts...)
@@ -762,9 +1671,22 @@ type VotesServiceServer interface {
 	GetRateInfo(context.Context, *GetVoteInfoRequest) (*GetRateInfoResponse, error)
 	GetPetitionInfo(context.Context, *GetVoteInfoRequest) (*GetPetitionInfoResponse, error)
 	GetChoiceInfo(context.Context, *GetVoteInfoRequest) (*GetChoiceInfoResponse, error)
+	GetMultiSelectInfo(context.Context, *GetVoteInfoRequest) (*GetMultiSelectInfoResponse, error)
+	GetRankedInfo(context.Context, *GetVoteInfoRequest) (*GetRankedInfoResponse, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	GetPetitions(context.Context, *GetPetitionsRequest) (*GetPetitionsResponse, error)
 	VoteRate(context.Context, *VoteRateRequest) (*VoteResponse, error)
 	VotePetition(context.Context, *VotePetitionRequest) (*VoteResponse, error)
 	VoteChoice(context.Context, *VoteChoiceRequest) (*VoteResponse, error)
+	VoteMultiSelect(context.Context, *VoteMultiSelectRequest) (*VoteResponse, error)
+	VoteRanked(context.Context, *VoteRankedRequest) (*VoteResponse, error)
+	// Admin API, requires the "admin" role.
+	CreateVote(context.Context, *CreateVoteRequest) (*AdminVoteResponse, error)
+	UpdateVote(context.Context, *UpdateVoteRequest) (*AdminVoteResponse, error)
+	DeleteVote(context.Context, *DeleteVoteRequest) (*DeleteVoteResponse, error)
+	AddVoteOption(context.Context, *AddVoteOptionRequest) (*VoteOptionResponse, error)
+	DeleteVoteOption(context.Context, *DeleteVoteOptionRequest) (*DeleteVoteResponse, error)
+	UpdatePetitionState(context.Context, *UpdatePetitionStateRequest) (*GetPetitionInfoResponse, error)
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
 	mustEmbedUnimplementedVotesServiceServer()
 }
@@ -791,6 +1713,18 @@ func (UnimplementedVotesServiceServer) GetPetitionInfo(context.Context, *GetVote
 func (UnimplementedVotesServiceServer) GetChoiceInfo(context.Context, *GetVoteInfoRequest) (*GetChoiceInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChoiceInfo not implemented")
 }
+func (UnimplementedVotesServiceServer) GetMultiSelectInfo(context.Context, *GetVoteInfoRequest) (*GetMultiSelectInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMultiSelectInfo not implemented")
+}
+func (UnimplementedVotesServiceServer) GetRankedInfo(context.Context, *GetVoteInfoRequest) (*GetRankedInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRankedInfo not implemented")
+}
+func (UnimplementedVotesServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedVotesServiceServer) GetPetitions(context.Context, *GetPetitionsRequest) (*GetPetitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPetitions not implemented")
+}
 func (UnimplementedVotesServiceServer) VoteRate(context.Context, *VoteRateRequest) (*VoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VoteRate not implemented")
 }
@@ -800,6 +1734,30 @@ func (UnimplementedVotesServiceServer) VotePetition(context.Context, *VotePetiti
 func (UnimplementedVotesServiceServer) VoteChoice(context.Context, *VoteChoiceRequest) (*VoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VoteChoice not implemented")
 }
+func (UnimplementedVotesServiceServer) VoteMultiSelect(context.Context, *VoteMultiSelectRequest) (*VoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteMultiSelect not implemented")
+}
+func (UnimplementedVotesServiceServer) VoteRanked(context.Context, *VoteRankedRequest) (*VoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteRanked not implemented")
+}
+func (UnimplementedVotesServiceServer) CreateVote(context.Context, *CreateVoteRequest) (*AdminVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVote not implemented")
+}
+func (UnimplementedVotesServiceServer) UpdateVote(context.Context, *UpdateVoteRequest) (*AdminVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVote not implemented")
+}
+func (UnimplementedVotesServiceServer) DeleteVote(context.Context, *DeleteVoteRequest) (*DeleteVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVote not implemented")
+}
+func (UnimplementedVotesServiceServer) AddVoteOption(context.Context, *AddVoteOptionRequest) (*VoteOptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVoteOption not implemented")
+}
+func (UnimplementedVotesServiceServer) DeleteVoteOption(context.Context, *DeleteVoteOptionRequest) (*DeleteVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVoteOption not implemented")
+}
+func (UnimplementedVotesServiceServer) UpdatePetitionState(context.Context, *UpdatePetitionStateRequest) (*GetPetitionInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePetitionState not implemented")
+}
 func (UnimplementedVotesServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
@@ -914,6 +1872,78 @@ func _VotesService_GetChoiceInfo_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VotesService_GetMultiSelectInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVoteInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotesServiceServer).GetMultiSelectInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotesService_GetMultiSelectInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotesServiceServer).GetMultiSelectInfo(ctx, req.(*GetVoteInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VotesService_GetRankedInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVoteInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotesServiceServer).GetRankedInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotesService_GetRankedInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotesServiceServer).GetRankedInfo(ctx, req.(*GetVoteInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VotesService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotesServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotesService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotesServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VotesService_GetPetitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPetitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotesServiceServer).GetPetitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotesService_GetPetitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotesServiceServer).GetPetitions(ctx, req.(*GetPetitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VotesService_VoteRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VoteRateRequest)
 	if err := dec(in); err != nil {
@@ -968,66 +1998,804 @@ func _VotesService_VoteChoice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VotesService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HealthCheckRequest)
+func _VotesService_VoteMultiSelect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteMultiSelectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VotesServiceServer).HealthCheck(ctx, in)
+		return srv.(VotesServiceServer).VoteMultiSelect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VotesService_HealthCheck_FullMethodName,
+		FullMethod: VotesService_VoteMultiSelect_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VotesServiceServer).HealthCheck(ctx, req.(*HealthCheckRequest))
+		return srv.(VotesServiceServer).VoteMultiSelect(ctx, req.(*VoteMultiSelectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// VotesService_ServiceDesc is the grpc.ServiceDesc for VotesService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var VotesService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "api.VotesService",
-	HandlerType: (*VotesServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetVotes",
-			Handler:    _VotesService_GetVotes_Handler,
-		},
-		{
-			MethodName: "GetCategories",
-			Handler:    _VotesService_GetCategories_Handler,
-		},
-		{
-			MethodName: "GetRateInfo",
-			Handler:    _VotesService_GetRateInfo_Handler,
-		},
-		{
-			MethodName: "GetPetitionInfo",
-			Handler:    _VotesService_GetPetitionInfo_Handler,
-		},
-		{
-			MethodName: "GetChoiceInfo",
-			Handler:    _VotesService_GetChoiceInfo_Handler,
-		},
-		{
-			MethodName: "VoteRate",
-			Handler:    _VotesService_VoteRate_Handler,
-		},
-		{
-			MethodName: "VotePetition",
-			Handler:    _VotesService_VotePetition_Handler,
-		},
-		{
-			MethodName: "VoteChoice",
-			Handler:    _VotesService_VoteChoice_Handler,
-		},
-		{
-			MethodName: "HealthCheck",
-			Handler:    _VotesService_HealthCheck_Handler,
+func _VotesService_VoteRanked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteRankedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotesServiceServer).VoteRanked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotesService_VoteRanked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotesServiceServer).VoteRanked(ctx, req.(*VoteRankedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VotesService_CreateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotesServiceServer).CreateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotesService_CreateVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotesServiceServer).CreateVote(ctx, req.(*CreateVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VotesService_UpdateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotesServiceServer).UpdateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotesService_UpdateVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotesServiceServer).UpdateVote(ctx, req.(*UpdateVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VotesService_DeleteVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotesServiceServer).DeleteVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotesService_DeleteVote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotesServiceServer).DeleteVote(ctx, req.(*DeleteVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VotesService_AddVoteOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVoteOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotesServiceServer).AddVoteOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotesService_AddVoteOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotesServiceServer).AddVoteOption(ctx, req.(*AddVoteOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VotesService_DeleteVoteOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVoteOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotesServiceServer).DeleteVoteOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotesService_DeleteVoteOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotesServiceServer).DeleteVoteOption(ctx, req.(*DeleteVoteOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VotesService_UpdatePetitionState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePetitionStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotesServiceServer).UpdatePetitionState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotesService_UpdatePetitionState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotesServiceServer).UpdatePetitionState(ctx, req.(*UpdatePetitionStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VotesService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VotesServiceServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VotesService_HealthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VotesServiceServer).HealthCheck(ctx, req.(*HealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VotesService_ServiceDesc is the grpc.ServiceDesc for VotesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VotesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.VotesService",
+	HandlerType: (*VotesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetVotes",
+			Handler:    _VotesService_GetVotes_Handler,
+		},
+		{
+			MethodName: "GetCategories",
+			Handler:    _VotesService_GetCategories_Handler,
+		},
+		{
+			MethodName: "GetRateInfo",
+			Handler:    _VotesService_GetRateInfo_Handler,
+		},
+		{
+			MethodName: "GetPetitionInfo",
+			Handler:    _VotesService_GetPetitionInfo_Handler,
+		},
+		{
+			MethodName: "GetChoiceInfo",
+			Handler:    _VotesService_GetChoiceInfo_Handler,
+		},
+		{
+			MethodName: "GetMultiSelectInfo",
+			Handler:    _VotesService_GetMultiSelectInfo_Handler,
+		},
+		{
+			MethodName: "GetRankedInfo",
+			Handler:    _VotesService_GetRankedInfo_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _VotesService_Search_Handler,
+		},
+		{
+			MethodName: "GetPetitions",
+			Handler:    _VotesService_GetPetitions_Handler,
+		},
+		{
+			MethodName: "VoteRate",
+			Handler:    _VotesService_VoteRate_Handler,
+		},
+		{
+			MethodName: "VotePetition",
+			Handler:    _VotesService_VotePetition_Handler,
+		},
+		{
+			MethodName: "VoteChoice",
+			Handler:    _VotesService_VoteChoice_Handler,
+		},
+		{
+			MethodName: "VoteMultiSelect",
+			Handler:    _VotesService_VoteMultiSelect_Handler,
+		},
+		{
+			MethodName: "VoteRanked",
+			Handler:    _VotesService_VoteRanked_Handler,
+		},
+		{
+			MethodName: "CreateVote",
+			Handler:    _VotesService_CreateVote_Handler,
+		},
+		{
+			MethodName: "UpdateVote",
+			Handler:    _VotesService_UpdateVote_Handler,
+		},
+		{
+			MethodName: "DeleteVote",
+			Handler:    _VotesService_DeleteVote_Handler,
+		},
+		{
+			MethodName: "AddVoteOption",
+			Handler:    _VotesService_AddVoteOption_Handler,
+		},
+		{
+			MethodName: "DeleteVoteOption",
+			Handler:    _VotesService_DeleteVoteOption_Handler,
+		},
+		{
+			MethodName: "UpdatePetitionState",
+			Handler:    _VotesService_UpdatePetitionState_Handler,
+		},
+		{
+			MethodName: "HealthCheck",
+			Handler:    _VotesService_HealthCheck_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/kdt.proto",
+}
+
+const (
+	NotificationsService_GetPreferences_FullMethodName        = "/api.NotificationsService/GetPreferences"
+	NotificationsService_UpdatePreferences_FullMethodName     = "/api.NotificationsService/UpdatePreferences"
+	NotificationsService_RegisterToken_FullMethodName         = "/api.NotificationsService/RegisterToken"
+	NotificationsService_DeleteToken_FullMethodName           = "/api.NotificationsService/DeleteToken"
+	NotificationsService_ListNotifications_FullMethodName     = "/api.NotificationsService/ListNotifications"
+	NotificationsService_MarkNotificationsRead_FullMethodName = "/api.NotificationsService/MarkNotificationsRead"
+	NotificationsService_GetUnreadCount_FullMethodName        = "/api.NotificationsService/GetUnreadCount"
+	NotificationsService_HealthCheck_FullMethodName           = "/api.NotificationsService/HealthCheck"
+)
+
+// NotificationsServiceClient is the client API for NotificationsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NotificationsServiceClient interface {
+	GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*GetPreferencesResponse, error)
+	UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*UpdatePreferencesResponse, error)
+	RegisterToken(ctx context.Context, in *RegisterTokenRequest, opts ...grpc.CallOption) (*RegisterTokenResponse, error)
+	DeleteToken(ctx context.Context, in *DeleteTokenRequest, opts ...grpc.CallOption) (*DeleteTokenResponse, error)
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+	MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*MarkNotificationsReadResponse, error)
+	GetUnreadCount(ctx context.Context, in *GetUnreadCountRequest, opts ...grpc.CallOption) (*GetUnreadCountResponse, error)
+	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+}
+
+type notificationsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationsServiceClient(cc grpc.ClientConnInterface) NotificationsServiceClient {
+	return &notificationsServiceClient{cc}
+}
+
+func (c *notificationsServiceClient) GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*GetPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPreferencesResponse)
+	err := c.cc.Invoke(ctx, NotificationsService_GetPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationsServiceClient) UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*UpdatePreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePreferencesResponse)
+	err := c.cc.Invoke(ctx, NotificationsService_UpdatePreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationsServiceClient) RegisterToken(ctx context.Context, in *RegisterTokenRequest, opts ...grpc.CallOption) (*RegisterTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterTokenResponse)
+	err := c.cc.Invoke(ctx, NotificationsService_RegisterToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationsServiceClient) DeleteToken(ctx context.Context, in *DeleteTokenRequest, opts ...grpc.CallOption) (*DeleteTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTokenResponse)
+	err := c.cc.Invoke(ctx, NotificationsService_DeleteToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationsServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, NotificationsService_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationsServiceClient) MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*MarkNotificationsReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkNotificationsReadResponse)
+	err := c.cc.Invoke(ctx, NotificationsService_MarkNotificationsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationsServiceClient) GetUnreadCount(ctx context.Context, in *GetUnreadCountRequest, opts ...grpc.CallOption) (*GetUnreadCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUnreadCountResponse)
+	err := c.cc.Invoke(ctx, NotificationsService_GetUnreadCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationsServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, NotificationsService_HealthCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationsServiceServer is the server API for NotificationsService service.
+// All implementations must embed UnimplementedNotificationsServiceServer
+// for forward compatibility.
+type NotificationsServiceServer interface {
+	GetPreferences(context.Context, *GetPreferencesRequest) (*GetPreferencesResponse, error)
+	UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*UpdatePreferencesResponse, error)
+	RegisterToken(context.Context, *RegisterTokenRequest) (*RegisterTokenResponse, error)
+	DeleteToken(context.Context, *DeleteTokenRequest) (*DeleteTokenResponse, error)
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	MarkNotificationsRead(context.Context, *MarkNotificationsReadRequest) (*MarkNotificationsReadResponse, error)
+	GetUnreadCount(context.Context, *GetUnreadCountRequest) (*GetUnreadCountResponse, error)
+	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
+	mustEmbedUnimplementedNotificationsServiceServer()
+}
+
+// UnimplementedNotificationsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationsServiceServer struct{}
+
+func (UnimplementedNotificationsServiceServer) GetPreferences(context.Context, *GetPreferencesRequest) (*GetPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreferences not implemented")
+}
+func (UnimplementedNotificationsServiceServer) UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*UpdatePreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreferences not implemented")
+}
+func (UnimplementedNotificationsServiceServer) RegisterToken(context.Context, *RegisterTokenRequest) (*RegisterTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterToken not implemented")
+}
+func (UnimplementedNotificationsServiceServer) DeleteToken(context.Context, *DeleteTokenRequest) (*DeleteTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteToken not implemented")
+}
+func (UnimplementedNotificationsServiceServer) ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedNotificationsServiceServer) MarkNotificationsRead(context.Context, *MarkNotificationsReadRequest) (*MarkNotificationsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationsRead not implemented")
+}
+func (UnimplementedNotificationsServiceServer) GetUnreadCount(context.Context, *GetUnreadCountRequest) (*GetUnreadCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadCount not implemented")
+}
+func (UnimplementedNotificationsServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+}
+func (UnimplementedNotificationsServiceServer) mustEmbedUnimplementedNotificationsServiceServer() {}
+func (UnimplementedNotificationsServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeNotificationsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationsServiceServer will
+// result in compilation errors.
+type UnsafeNotificationsServiceServer interface {
+	mustEmbedUnimplementedNotificationsServiceServer()
+}
+
+func RegisterNotificationsServiceServer(s grpc.ServiceRegistrar, srv NotificationsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNotificationsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationsService_ServiceDesc, srv)
+}
+
+func _NotificationsService_GetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServiceServer).GetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationsService_GetPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServiceServer).GetPreferences(ctx, req.(*GetPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationsService_UpdatePreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServiceServer).UpdatePreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationsService_UpdatePreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServiceServer).UpdatePreferences(ctx, req.(*UpdatePreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationsService_RegisterToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServiceServer).RegisterToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationsService_RegisterToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServiceServer).RegisterToken(ctx, req.(*RegisterTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationsService_DeleteToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServiceServer).DeleteToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationsService_DeleteToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServiceServer).DeleteToken(ctx, req.(*DeleteTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationsService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServiceServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationsService_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServiceServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationsService_MarkNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServiceServer).MarkNotificationsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationsService_MarkNotificationsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServiceServer).MarkNotificationsRead(ctx, req.(*MarkNotificationsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationsService_GetUnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServiceServer).GetUnreadCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationsService_GetUnreadCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServiceServer).GetUnreadCount(ctx, req.(*GetUnreadCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationsService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServiceServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationsService_HealthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServiceServer).HealthCheck(ctx, req.(*HealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationsService_ServiceDesc is the grpc.ServiceDesc for NotificationsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.NotificationsService",
+	HandlerType: (*NotificationsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPreferences",
+			Handler:    _NotificationsService_GetPreferences_Handler,
+		},
+		{
+			MethodName: "UpdatePreferences",
+			Handler:    _NotificationsService_UpdatePreferences_Handler,
+		},
+		{
+			MethodName: "RegisterToken",
+			Handler:    _NotificationsService_RegisterToken_Handler,
+		},
+		{
+			MethodName: "DeleteToken",
+			Handler:    _NotificationsService_DeleteToken_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _NotificationsService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkNotificationsRead",
+			Handler:    _NotificationsService_MarkNotificationsRead_Handler,
+		},
+		{
+			MethodName: "GetUnreadCount",
+			Handler:    _NotificationsService_GetUnreadCount_Handler,
+		},
+		{
+			MethodName: "HealthCheck",
+			Handler:    _NotificationsService_HealthCheck_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/kdt.proto",
+}
+
+const (
+	PurchasesService_GetDonationReceipt_FullMethodName   = "/api.PurchasesService/GetDonationReceipt"
+	PurchasesService_GetDonationStatement_FullMethodName = "/api.PurchasesService/GetDonationStatement"
+	PurchasesService_HealthCheck_FullMethodName          = "/api.PurchasesService/HealthCheck"
+)
+
+// PurchasesServiceClient is the client API for PurchasesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PurchasesServiceClient interface {
+	GetDonationReceipt(ctx context.Context, in *GetDonationReceiptRequest, opts ...grpc.CallOption) (*GetDonationReceiptResponse, error)
+	GetDonationStatement(ctx context.Context, in *GetDonationStatementRequest, opts ...grpc.CallOption) (*GetDonationStatementResponse, error)
+	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+}
+
+type purchasesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPurchasesServiceClient(cc grpc.ClientConnInterface) PurchasesServiceClient {
+	return &purchasesServiceClient{cc}
+}
+
+func (c *purchasesServiceClient) GetDonationReceipt(ctx context.Context, in *GetDonationReceiptRequest, opts ...grpc.CallOption) (*GetDonationReceiptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDonationReceiptResponse)
+	err := c.cc.Invoke(ctx, PurchasesService_GetDonationReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *purchasesServiceClient) GetDonationStatement(ctx context.Context, in *GetDonationStatementRequest, opts ...grpc.CallOption) (*GetDonationStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDonationStatementResponse)
+	err := c.cc.Invoke(ctx, PurchasesService_GetDonationStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *purchasesServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, PurchasesService_HealthCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PurchasesServiceServer is the server API for PurchasesService service.
+// All implementations must embed UnimplementedPurchasesServiceServer
+// for forward compatibility.
+type PurchasesServiceServer interface {
+	GetDonationReceipt(context.Context, *GetDonationReceiptRequest) (*GetDonationReceiptResponse, error)
+	GetDonationStatement(context.Context, *GetDonationStatementRequest) (*GetDonationStatementResponse, error)
+	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
+	mustEmbedUnimplementedPurchasesServiceServer()
+}
+
+// UnimplementedPurchasesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPurchasesServiceServer struct{}
+
+func (UnimplementedPurchasesServiceServer) GetDonationReceipt(context.Context, *GetDonationReceiptRequest) (*GetDonationReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDonationReceipt not implemented")
+}
+func (UnimplementedPurchasesServiceServer) GetDonationStatement(context.Context, *GetDonationStatementRequest) (*GetDonationStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDonationStatement not implemented")
+}
+func (UnimplementedPurchasesServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+}
+func (UnimplementedPurchasesServiceServer) mustEmbedUnimplementedPurchasesServiceServer() {}
+func (UnimplementedPurchasesServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafePurchasesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PurchasesServiceServer will
+// result in compilation errors.
+type UnsafePurchasesServiceServer interface {
+	mustEmbedUnimplementedPurchasesServiceServer()
+}
+
+func RegisterPurchasesServiceServer(s grpc.ServiceRegistrar, srv PurchasesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPurchasesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PurchasesService_ServiceDesc, srv)
+}
+
+func _PurchasesService_GetDonationReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDonationReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PurchasesServiceServer).GetDonationReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PurchasesService_GetDonationReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PurchasesServiceServer).GetDonationReceipt(ctx, req.(*GetDonationReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PurchasesService_GetDonationStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDonationStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PurchasesServiceServer).GetDonationStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PurchasesService_GetDonationStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PurchasesServiceServer).GetDonationStatement(ctx, req.(*GetDonationStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PurchasesService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PurchasesServiceServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PurchasesService_HealthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PurchasesServiceServer).HealthCheck(ctx, req.(*HealthCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PurchasesService_ServiceDesc is the grpc.ServiceDesc for PurchasesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PurchasesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.PurchasesService",
+	HandlerType: (*PurchasesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDonationReceipt",
+			Handler:    _PurchasesService_GetDonationReceipt_Handler,
+		},
+		{
+			MethodName: "GetDonationStatement",
+			Handler:    _PurchasesService_GetDonationStatement_Handler,
+		},
+		{
+			MethodName: "HealthCheck",
+			Handler:    _PurchasesService_HealthCheck_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,69 @@
+// Package audit records the changes admins make to the data of a service. Every change is a row of an audit log table
+// with the columns actor, action, entity, entity_id, before and after, where before and after are the changed row
+// as JSON. Every service keeps its own table.
+package audit
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+)
+
+// Actions of the audit log.
+const (
+	Create = "create"
+	Update = "update"
+	Delete = "delete"
+)
+
+// Beginner starts transactions, like *pgxpool.Pool.
+type Beginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// Change runs change in a transaction of db and records in logTable which row of table actor changed and how it
+// looked before and after. id is the row being changed, zero when change creates it; change returns the row's ID.
+// It returns pgx.ErrNoRows when the row does not exist or was deleted.
+func Change(ctx context.Context, db Beginner, logTable string, actor string, action string, table string, id int, change func(tx pgx.Tx) (int, error)) error {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var before []byte
+	if id != 0 {
+		err := tx.QueryRow(ctx, `SELECT row_to_json(t) FROM `+pgx.Identifier{table}.Sanitize()+` t WHERE t.id = $1 AND t.deleted_at IS NULL FOR UPDATE`, id).Scan(&before)
+		if err != nil {
+			return err
+		}
+	}
+
+	id, err = change(tx)
+	if err != nil {
+		return err
+	}
+	if err := Write(ctx, tx, logTable, actor, action, table, id, before); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Write records in logTable the row of table as it is now within tx, after actor changed it from before.
+func Write(ctx context.Context, tx pgx.Tx, logTable string, actor string, action string, table string, id int, before []byte) error {
+	var after []byte
+	if err := tx.QueryRow(ctx, `SELECT row_to_json(t) FROM `+pgx.Identifier{table}.Sanitize()+` t WHERE t.id = $1`, id).Scan(&after); err != nil {
+		return fmt.Errorf("failed to read %s %d: %w", table, id, err)
+	}
+	_, err := tx.Exec(ctx, `
+		INSERT INTO `+pgx.Identifier{logTable}.Sanitize()+` (actor, action, entity, entity_id, before, after)
+		VALUES ($1, $2, $3, $4, $5, $6)`, actor, action, table, id, before, after)
+	if err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return nil
+}
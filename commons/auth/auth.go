@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UserIDKey is the gRPC metadata key the gateway uses to pass the authenticated user ID to services.
+const UserIDKey = "x-user-id"
+
+// RolesKey is the gRPC metadata key carrying the roles of the authenticated user, one value per role.
+const RolesKey = "x-user-roles"
+
+// RoleStaff is granted to venue staff who validate tickets at the entrance.
+const RoleStaff = "staff"
+
+// RoleAdmin is granted to editors who manage collections, places and votes through the admin API.
+const RoleAdmin = "admin"
+
+type userIDContextKey struct{}
+
+type rolesContextKey struct{}
+
+func ContextWithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDContextKey{}, userID)
+}
+
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	userID, ok := ctx.Value(userIDContextKey{}).(string)
+	return userID, ok && userID != ""
+}
+
+// RequireUserID returns the authenticated user ID or an Unauthenticated status error.
+func RequireUserID(ctx context.Context) (string, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return "", status.Errorf(codes.Unauthenticated, "Authentication required")
+	}
+	return userID, nil
+}
+
+func ContextWithRoles(ctx context.Context, roles []string) context.Context {
+	return context.WithValue(ctx, rolesContextKey{}, roles)
+}
+
+func RolesFromContext(ctx context.Context) []string {
+	roles, _ := ctx.Value(rolesContextKey{}).([]string)
+	return roles
+}
+
+func HasRole(ctx context.Context, role string) bool {
+	for _, r := range RolesFromContext(ctx) {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// RequireRole returns the authenticated user ID if the user has the role, an Unauthenticated status error if there
+// is no user and a PermissionDenied status error otherwise.
+func RequireRole(ctx context.Context, role string) (string, error) {
+	userID, err := RequireUserID(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !HasRole(ctx, role) {
+		return "", status.Errorf(codes.PermissionDenied, "Not enough permissions")
+	}
+	return userID, nil
+}
+
+// UnaryClientInterceptor copies the user ID and roles from the context into outgoing gRPC metadata.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if userID, ok := UserIDFromContext(ctx); ok {
+			ctx = metadata.AppendToOutgoingContext(ctx, UserIDKey, userID)
+			for _, role := range RolesFromContext(ctx) {
+				ctx = metadata.AppendToOutgoingContext(ctx, RolesKey, role)
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// UnaryServerInterceptor puts the user ID and roles from incoming gRPC metadata into the handler context.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(UserIDKey); len(values) > 0 && values[0] != "" {
+				ctx = ContextWithUserID(ctx, values[0])
+				if roles := md.Get(RolesKey); len(roles) > 0 {
+					ctx = ContextWithRoles(ctx, roles)
+				}
+			}
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// serveHealth starts a gRPC server with UnaryServerInterceptor over an in-memory listener. Every call stores its
+// handler context in got.
+func serveHealth(t *testing.T, got *context.Context) healthpb.HealthClient {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	capture := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		*got = ctx
+		return handler(ctx, req)
+	}
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(UnaryServerInterceptor(), capture))
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(UnaryClientInterceptor()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return healthpb.NewHealthClient(conn)
+}
+
+func TestInterceptorsPassUser(t *testing.T) {
+	tests := []struct {
+		name      string
+		userID    string
+		roles     []string
+		wantRoles []string
+	}{
+		{name: "anonymous"},
+		{name: "user without roles", userID: "user-1"},
+		{name: "user with roles", userID: "user-2", roles: []string{RoleStaff, RoleAdmin}, wantRoles: []string{RoleStaff, RoleAdmin}},
+		// Roles without a user are not sent, so they cannot be forged for anonymous requests.
+		{name: "roles without user", roles: []string{RoleAdmin}},
+	}
+
+	var got context.Context
+	client := serveHealth(t, &got)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.userID != "" {
+				ctx = ContextWithUserID(ctx, tt.userID)
+			}
+			if tt.roles != nil {
+				ctx = ContextWithRoles(ctx, tt.roles)
+			}
+
+			if _, err := client.Check(ctx, &healthpb.HealthCheckRequest{}); err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+
+			userID, ok := UserIDFromContext(got)
+			if ok != (tt.userID != "") || userID != tt.userID {
+				t.Errorf("user ID = %q, %v, want %q", userID, ok, tt.userID)
+			}
+			if roles := RolesFromContext(got); !reflect.DeepEqual(roles, tt.wantRoles) {
+				t.Errorf("roles = %v, want %v", roles, tt.wantRoles)
+			}
+		})
+	}
+}
+
+func TestRequireRole(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode codes.Code
+	}{
+		{name: "anonymous", ctx: context.Background(), wantCode: codes.Unauthenticated},
+		{name: "without role", ctx: ContextWithUserID(context.Background(), "user-1"), wantCode: codes.PermissionDenied},
+		{name: "with role", ctx: ContextWithRoles(ContextWithUserID(context.Background(), "user-1"), []string{RoleAdmin}), wantCode: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := RequireRole(tt.ctx, RoleAdmin)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("RequireRole() code = %v, want %v", code, tt.wantCode)
+			}
+		})
+	}
+}
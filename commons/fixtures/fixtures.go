@@ -0,0 +1,46 @@
+// Package fixtures reads the seed data of the services from YAML or JSON files.
+package fixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Read decodes the file at path into v, as JSON for .json files and as YAML otherwise.
+func Read(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return decode(path, data, v)
+}
+
+// ReadFS is Read for a file in fsys.
+func ReadFS(fsys fs.FS, name string, v interface{}) error {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return err
+	}
+	return decode(name, data, v)
+}
+
+func decode(name string, data []byte, v interface{}) error {
+	var err error
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".json":
+		err = json.Unmarshal(data, v)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, v)
+	default:
+		return fmt.Errorf("%s: unsupported fixture format, use .json, .yaml or .yml", name)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
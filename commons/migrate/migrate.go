@@ -0,0 +1,272 @@
+// Package migrate applies the versioned SQL migrations of a service. Migrations are read from files named
+// <version>_<name>.up.sql and <version>_<name>.down.sql, each one runs in a transaction together with its record in
+// the migrations table. A Postgres advisory lock keeps replicas starting at the same time from racing each other.
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"hash/fnv"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status is a migration together with the time it was applied, AppliedAt is nil for pending migrations.
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+type Migrator struct {
+	db         *pgxpool.Pool
+	table      string
+	lockKey    int64
+	migrations []Migration
+}
+
+// New returns a migrator for the migrations in fsys recording them in table. Services sharing a database must use
+// different tables, the advisory lock is derived from the table name.
+func New(db *pgxpool.Pool, fsys fs.FS, table string) (*Migrator, error) {
+	const op = "migrate.New"
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	hash := fnv.New64a()
+	hash.Write([]byte(table))
+	return &Migrator{db: db, table: table, lockKey: int64(hash.Sum64()), migrations: migrations}, nil
+}
+
+// Load reads the migrations in the root of fsys ordered by version. Every version needs both an up and a down file.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("unexpected migration file %q", entry.Name())
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid version in migration file %q", entry.Name())
+		}
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has files with different names", version)
+		}
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both up and down files", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Up applies all pending migrations in order and returns them.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	const op = "migrate.Up"
+	var done []Migration
+	err := m.locked(ctx, func(conn *pgxpool.Conn, applied map[int64]time.Time) error {
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+			record := "INSERT INTO " + m.identifier() + " (version, name) VALUES ($1, $2)"
+			if err := m.apply(ctx, conn, migration.Up, record, migration.Version, migration.Name); err != nil {
+				return fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	if err != nil {
+		return done, fmt.Errorf("%s: %w", op, err)
+	}
+	return done, nil
+}
+
+// UpWithLog applies the pending migrations with up, usually the Migrate method of a service's storage, and logs
+// every applied migration and the outcome. Services call it on start, replicas starting together wait for each other
+// on the migration lock.
+func UpWithLog(ctx context.Context, up func(ctx context.Context) ([]Migration, error), log *slog.Logger) error {
+	applied, err := up(ctx)
+	for _, migration := range applied {
+		log.Info("Migration applied", slog.Int64("version", migration.Version), slog.String("name", migration.Name))
+	}
+	if err != nil {
+		log.Error("Failed to migrate database", slog.String("error", err.Error()))
+		return err
+	}
+	log.Info("Database schema is up to date")
+	return nil
+}
+
+// Down reverts the last steps applied migrations, newest first, and returns them.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	const op = "migrate.Down"
+	var done []Migration
+	err := m.locked(ctx, func(conn *pgxpool.Conn, applied map[int64]time.Time) error {
+		// Migrations applied by a newer release have to be reverted by that release first.
+		for version := range applied {
+			if !m.known(version) {
+				return fmt.Errorf("migration %d is not known to this release", version)
+			}
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && len(done) < steps; i-- {
+			migration := m.migrations[i]
+			if _, ok := applied[migration.Version]; !ok {
+				continue
+			}
+			record := "DELETE FROM " + m.identifier() + " WHERE version = $1"
+			if err := m.apply(ctx, conn, migration.Down, record, migration.Version); err != nil {
+				return fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	if err != nil {
+		return done, fmt.Errorf("%s: %w", op, err)
+	}
+	return done, nil
+}
+
+// Status returns all known migrations with the time they were applied.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	const op = "migrate.Status"
+	var statuses []Status
+	err := m.locked(ctx, func(_ *pgxpool.Conn, applied map[int64]time.Time) error {
+		for _, migration := range m.migrations {
+			status := Status{Migration: migration}
+			if appliedAt, ok := applied[migration.Version]; ok {
+				status.AppliedAt = &appliedAt
+			}
+			statuses = append(statuses, status)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return statuses, nil
+}
+
+// locked runs fn holding the advisory lock of the migrator, with the migrations table created and the applied
+// versions read.
+func (m *Migrator) locked(ctx context.Context, fn func(conn *pgxpool.Conn, applied map[int64]time.Time) error) error {
+	conn, err := m.db.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	// The lock belongs to the session, so it is taken and released on the same connection.
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", m.lockKey); err != nil {
+		return fmt.Errorf("failed to take advisory lock: %w", err)
+	}
+	defer func() {
+		if _, err := conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", m.lockKey); err != nil {
+			// Closing the connection drops the lock with the session.
+			_ = conn.Conn().Close(context.Background())
+		}
+	}()
+
+	_, err = conn.Exec(ctx, `CREATE TABLE IF NOT EXISTS `+m.identifier()+` (
+		version BIGINT PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create migrations table: %w", err)
+	}
+
+	rows, err := conn.Query(ctx, "SELECT version, applied_at FROM "+m.identifier())
+	if err != nil {
+		return fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+	applied := make(map[int64]time.Time)
+	var version int64
+	var appliedAt time.Time
+	_, err = pgx.ForEachRow(rows, []interface{}{&version, &appliedAt}, func() error {
+		applied[version] = appliedAt
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+
+	return fn(conn, applied)
+}
+
+// apply runs the script of a migration and the query recording it in one transaction.
+func (m *Migrator) apply(ctx context.Context, conn *pgxpool.Conn, script string, record string, args ...interface{}) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, script); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, record, args...); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (m *Migrator) known(version int64) bool {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Migrator) identifier() string {
+	return pgx.Identifier{m.table}.Sanitize()
+}
@@ -0,0 +1,234 @@
+package migrate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/GP-Hacks/kdt2024-commons/pgtest"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func file(content string) *fstest.MapFile {
+	return &fstest.MapFile{Data: []byte(content)}
+}
+
+func versions(migrations []Migration) []int64 {
+	result := make([]int64, 0, len(migrations))
+	for _, migration := range migrations {
+		result = append(result, migration.Version)
+	}
+	return result
+}
+
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0010_tenth.up.sql":    file("up 10"),
+		"0010_tenth.down.sql":  file("down 10"),
+		"0002_second.up.sql":   file("up 2"),
+		"0002_second.down.sql": file("down 2"),
+		"0001_first.down.sql":  file("down 1"),
+		"0001_first.up.sql":    file("up 1"),
+		"testdata/ignored.txt": file("directories are skipped"),
+	}
+
+	migrations, err := Load(fsys)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	want := []Migration{
+		{Version: 1, Name: "first", Up: "up 1", Down: "down 1"},
+		{Version: 2, Name: "second", Up: "up 2", Down: "down 2"},
+		{Version: 10, Name: "tenth", Up: "up 10", Down: "down 10"},
+	}
+	if !reflect.DeepEqual(migrations, want) {
+		t.Errorf("Load() = %+v, want %+v", migrations, want)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+		want string
+	}{
+		{
+			name: "missing down",
+			fsys: fstest.MapFS{"0001_first.up.sql": file("up")},
+			want: "needs both up and down files",
+		},
+		{
+			name: "missing up",
+			fsys: fstest.MapFS{"0001_first.down.sql": file("down")},
+			want: "needs both up and down files",
+		},
+		{
+			name: "empty up",
+			fsys: fstest.MapFS{"0001_first.up.sql": file(""), "0001_first.down.sql": file("down")},
+			want: "needs both up and down files",
+		},
+		{
+			name: "different names",
+			fsys: fstest.MapFS{"0001_first.up.sql": file("up"), "0001_other.down.sql": file("down")},
+			want: "different names",
+		},
+		{
+			name: "unexpected file",
+			fsys: fstest.MapFS{"README.md": file("docs")},
+			want: "unexpected migration file",
+		},
+		{
+			name: "zero version",
+			fsys: fstest.MapFS{"0000_zero.up.sql": file("up"), "0000_zero.down.sql": file("down")},
+			want: "invalid version",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(tt.fsys)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Load() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpWithLog(t *testing.T) {
+	applied := []Migration{{Version: 1, Name: "first"}, {Version: 2, Name: "second"}}
+	failure := errors.New("migration 3_third: syntax error")
+
+	tests := []struct {
+		name    string
+		err     error
+		wantLog []string
+	}{
+		{name: "success", wantLog: []string{"version=1 name=first", "version=2 name=second", "Database schema is up to date"}},
+		{name: "failure", err: failure, wantLog: []string{"version=1 name=first", "version=2 name=second", "Failed to migrate database"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			log := slog.New(slog.NewTextHandler(&out, nil))
+			err := UpWithLog(context.Background(), func(context.Context) ([]Migration, error) {
+				return applied, tt.err
+			}, log)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("UpWithLog() error = %v, want %v", err, tt.err)
+			}
+			for _, want := range tt.wantLog {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("log %q does not contain %q", out.String(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestUpDown(t *testing.T) {
+	ctx := context.Background()
+	db := pgtest.Connect(t)
+	fsys := fstest.MapFS{
+		"0001_items.up.sql":    file("CREATE TABLE items (id INT)"),
+		"0001_items.down.sql":  file("DROP TABLE items"),
+		"0002_name.up.sql":     file("ALTER TABLE items ADD COLUMN name TEXT"),
+		"0002_name.down.sql":   file("ALTER TABLE items DROP COLUMN name"),
+		"0003_broken.up.sql":   file("ALTER TABLE items ADD COLUMN price INT; SELECT * FROM missing"),
+		"0003_broken.down.sql": file("ALTER TABLE items DROP COLUMN price"),
+	}
+	m, err := New(db, fsys, "test_schema_migrations")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	// The broken migration stops Up after the ones before it and is rolled back with its record.
+	done, err := m.Up(ctx)
+	if err == nil || !strings.Contains(err.Error(), "migration 3_broken") {
+		t.Fatalf("Up() error = %v, want the broken migration", err)
+	}
+	if got := versions(done); !reflect.DeepEqual(got, []int64{1, 2}) {
+		t.Errorf("Up() applied %v, want [1 2]", got)
+	}
+	if _, err := db.Exec(ctx, "SELECT id, name FROM items"); err != nil {
+		t.Errorf("items were not migrated: %v", err)
+	}
+	if _, err := db.Exec(ctx, "SELECT price FROM items"); err == nil {
+		t.Error("the broken migration was not rolled back")
+	}
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("Status() error = %v", err)
+	}
+	for _, status := range statuses {
+		if applied := status.AppliedAt != nil; applied != (status.Version < 3) {
+			t.Errorf("migration %d applied = %v", status.Version, applied)
+		}
+	}
+
+	// Without the broken migration, Up has nothing left to do.
+	delete(fsys, "0003_broken.up.sql")
+	delete(fsys, "0003_broken.down.sql")
+	if m, err = New(db, fsys, "test_schema_migrations"); err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if done, err := m.Up(ctx); err != nil || len(done) != 0 {
+		t.Fatalf("second Up() = %v, %v, want nothing to do", versions(done), err)
+	}
+
+	// Down reverts the newest migration first, each with its own down script.
+	done, err = m.Down(ctx, 1)
+	if err != nil {
+		t.Fatalf("Down(1) error = %v", err)
+	}
+	if got := versions(done); !reflect.DeepEqual(got, []int64{2}) {
+		t.Errorf("Down(1) reverted %v, want [2]", got)
+	}
+	if _, err := db.Exec(ctx, "SELECT name FROM items"); err == nil {
+		t.Error("Down(1) did not run the down script of migration 2")
+	}
+	if _, err := db.Exec(ctx, "SELECT id FROM items"); err != nil {
+		t.Errorf("Down(1) reverted migration 1: %v", err)
+	}
+
+	done, err = m.Down(ctx, 5)
+	if err != nil {
+		t.Fatalf("Down(5) error = %v", err)
+	}
+	if got := versions(done); !reflect.DeepEqual(got, []int64{1}) {
+		t.Errorf("Down(5) reverted %v, want [1]", got)
+	}
+	if _, err := db.Exec(ctx, "SELECT 1 FROM items"); err == nil {
+		t.Error("Down(5) did not drop items")
+	}
+}
+
+func TestDownRefusesUnknownMigrations(t *testing.T) {
+	ctx := context.Background()
+	db := pgtest.Connect(t)
+	newer := fstest.MapFS{
+		"0001_items.up.sql":   file("CREATE TABLE items (id INT)"),
+		"0001_items.down.sql": file("DROP TABLE items"),
+		"0002_name.up.sql":    file("ALTER TABLE items ADD COLUMN name TEXT"),
+		"0002_name.down.sql":  file("ALTER TABLE items DROP COLUMN name"),
+	}
+	m, err := New(db, newer, "test_schema_migrations")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+
+	// An older release does not know migration 2, so it must not revert migration 1 under it.
+	older := fstest.MapFS{"0001_items.up.sql": newer["0001_items.up.sql"], "0001_items.down.sql": newer["0001_items.down.sql"]}
+	if m, err = New(db, older, "test_schema_migrations"); err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, err := m.Down(ctx, 1); err == nil || !strings.Contains(err.Error(), "not known to this release") {
+		t.Fatalf("Down() error = %v, want the unknown migration", err)
+	}
+}
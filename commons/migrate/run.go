@@ -0,0 +1,72 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// ErrUsage is returned by Run for unknown commands.
+var ErrUsage = errors.New("usage: migrate up | down [steps] | status")
+
+// Run executes the migrate subcommand of a service binary given its arguments and writes the result to out.
+func Run(ctx context.Context, m *Migrator, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return ErrUsage
+	}
+
+	switch args[0] {
+	case "up":
+		if len(args) != 1 {
+			return ErrUsage
+		}
+		done, err := m.Up(ctx)
+		printDone(out, "Applied", done)
+		return err
+	case "down":
+		steps := 1
+		if len(args) == 2 {
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n <= 0 {
+				return ErrUsage
+			}
+			steps = n
+		} else if len(args) > 2 {
+			return ErrUsage
+		}
+		done, err := m.Down(ctx, steps)
+		printDone(out, "Reverted", done)
+		return err
+	case "status":
+		if len(args) != 1 {
+			return ErrUsage
+		}
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			state := "pending"
+			if status.AppliedAt != nil {
+				state = "applied " + status.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(out, "%04d_%s\t%s\n", status.Version, status.Name, state)
+		}
+		return nil
+	default:
+		return ErrUsage
+	}
+}
+
+func printDone(out io.Writer, action string, migrations []Migration) {
+	if len(migrations) == 0 {
+		fmt.Fprintln(out, "Nothing to do")
+		return
+	}
+	for _, migration := range migrations {
+		fmt.Fprintf(out, "%s %04d_%s\n", action, migration.Version, migration.Name)
+	}
+}
@@ -0,0 +1,89 @@
+// Package outbox implements the transactional outbox of the services. Messages are stored in an outbox table in the
+// same transaction as the change they describe, and a Relay publishes them to RabbitMQ after the commit. The table
+// has the columns id, queue, payload, created_at and published_at, every service keeps its own.
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"time"
+)
+
+type Message struct {
+	ID        int
+	Queue     string
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+// Insert stores messages in the outbox table within tx.
+func Insert(ctx context.Context, tx pgx.Tx, table string, messages []*Message) error {
+	query := `INSERT INTO ` + pgx.Identifier{table}.Sanitize() + ` (queue, payload) VALUES ($1, $2)`
+	for _, message := range messages {
+		if _, err := tx.Exec(ctx, query, message.Queue, message.Payload); err != nil {
+			return fmt.Errorf("failed to save outbox message: %w", err)
+		}
+	}
+	return nil
+}
+
+// Publish hands up to limit unpublished messages of the outbox table to publish in insertion order and marks the
+// ones that were published. Rows are locked with SKIP LOCKED so several replicas can drain the outbox at once.
+// It stops at the first publish error and keeps the messages published before it.
+func Publish(ctx context.Context, db *pgxpool.Pool, table string, limit int, publish func(*Message) error) (int, error) {
+	const op = "outbox.Publish"
+	ident := pgx.Identifier{table}.Sanitize()
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to begin transaction: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+		SELECT id, queue, payload, created_at FROM `+ident+`
+		WHERE published_at IS NULL
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED`, limit)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to query outbox: %w", op, err)
+	}
+	var messages []*Message
+	for rows.Next() {
+		message := &Message{}
+		if err := rows.Scan(&message.ID, &message.Queue, &message.Payload, &message.CreatedAt); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("%s: failed to scan outbox message: %w", op, err)
+		}
+		messages = append(messages, message)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("%s: error occurred while iterating over outbox: %w", op, err)
+	}
+
+	var published []int
+	var publishErr error
+	for _, message := range messages {
+		if publishErr = publish(message); publishErr != nil {
+			break
+		}
+		published = append(published, message.ID)
+	}
+
+	if len(published) > 0 {
+		if _, err := tx.Exec(ctx, `UPDATE `+ident+` SET published_at = NOW() WHERE id = ANY($1)`, published); err != nil {
+			return 0, fmt.Errorf("%s: failed to mark outbox messages as published: %w", op, err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: failed to commit transaction: %w", op, err)
+	}
+	if publishErr != nil {
+		return len(published), fmt.Errorf("%s: failed to publish outbox message: %w", op, publishErr)
+	}
+	return len(published), nil
+}
@@ -0,0 +1,103 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/streadway/amqp"
+)
+
+const batchSize = 100
+
+// Store is the storage of a service that publishes its outbox, usually with Publish on its own table.
+type Store interface {
+	PublishOutbox(ctx context.Context, limit int, publish func(*Message) error) (int, error)
+}
+
+// Relay publishes committed outbox messages to RabbitMQ. Delivery is at-least-once:
+// a message is marked as published only after the broker confirms it.
+type Relay struct {
+	store           Store
+	messageIDPrefix string
+	mqch            *amqp.Channel
+	confirms        chan amqp.Confirmation
+	logger          *slog.Logger
+	interval        time.Duration
+}
+
+// NewRelay puts mqch into confirm mode, so the channel must not be shared with other publishers. The messages are
+// published with IDs made of messageIDPrefix and the outbox ID, like "places-outbox-42", so consumers can drop
+// redeliveries.
+func NewRelay(store Store, messageIDPrefix string, mqch *amqp.Channel, logger *slog.Logger, interval time.Duration) (*Relay, error) {
+	if err := mqch.Confirm(false); err != nil {
+		return nil, fmt.Errorf("outbox.NewRelay: failed to enable publisher confirms: %w", err)
+	}
+	return &Relay{
+		store:           store,
+		messageIDPrefix: messageIDPrefix,
+		mqch:            mqch,
+		confirms:        mqch.NotifyPublish(make(chan amqp.Confirmation, 1)),
+		logger:          logger,
+		interval:        interval,
+	}, nil
+}
+
+func (r *Relay) Run(ctx context.Context) {
+	r.logger.Info("Outbox relay started", slog.Duration("interval", r.interval))
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Info("Outbox relay stopped")
+			return
+		case <-ticker.C:
+		}
+
+		for {
+			published, err := r.store.PublishOutbox(ctx, batchSize, r.publish)
+			if err != nil {
+				r.logger.Error("Failed to relay outbox messages", slog.String("error", err.Error()))
+				break
+			}
+			if published > 0 {
+				r.logger.Info("Outbox messages published", slog.Int("count", published))
+			}
+			if published < batchSize {
+				break
+			}
+		}
+	}
+}
+
+func (r *Relay) publish(message *Message) error {
+	err := r.mqch.Publish(
+		"",
+		message.Queue,
+		false,
+		false,
+		amqp.Publishing{
+			ContentType:  "application/json",
+			DeliveryMode: amqp.Persistent,
+			MessageId:    r.messageIDPrefix + strconv.Itoa(message.ID),
+			Timestamp:    message.CreatedAt,
+			Body:         message.Payload,
+		})
+	if err != nil {
+		return err
+	}
+
+	confirmation, ok := <-r.confirms
+	if !ok {
+		return errors.New("RabbitMQ channel closed before confirming the message")
+	}
+	if !confirmation.Ack {
+		return fmt.Errorf("RabbitMQ rejected message %d", message.ID)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+// Package pagination implements the keyset pagination of the list RPCs. A page token points after the last item of a
+// page by the value of its sort key and its ID, which breaks ties, so pages stay stable while items are added.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// DefaultPageSize is the size of pages when the request has none.
+	DefaultPageSize = 50
+	MaxPageSize     = 100
+)
+
+// ErrInvalidToken is returned for page tokens that were not returned by a list with the same sort.
+var ErrInvalidToken = errors.New("Invalid page token")
+
+// PageSize validates size, 0 is DefaultPageSize. The returned errors are meant for clients.
+func PageSize(size int32) (int, error) {
+	if size == 0 {
+		return DefaultPageSize, nil
+	}
+	if size < 0 || size > MaxPageSize {
+		return 0, fmt.Errorf("Page size must be between 1 and %d", MaxPageSize)
+	}
+	return int(size), nil
+}
+
+// Sort is a sort key of a list, in descending order when written with a leading minus like "-cost".
+type Sort struct {
+	Key  string
+	Desc bool
+}
+
+// ParseSort parses sort, which is def when empty, and checks its key is one of keys. The returned errors are meant for
+// clients.
+func ParseSort(sort string, def string, keys ...string) (Sort, error) {
+	if sort == "" {
+		sort = def
+	}
+	parsed := Sort{Key: strings.TrimPrefix(sort, "-"), Desc: strings.HasPrefix(sort, "-")}
+	for _, key := range keys {
+		if parsed.Key == key {
+			return parsed, nil
+		}
+	}
+	return Sort{}, fmt.Errorf("Unknown sort %q, expected one of %s with an optional leading minus", sort, strings.Join(keys, ", "))
+}
+
+func (s Sort) String() string {
+	if s.Desc {
+		return "-" + s.Key
+	}
+	return s.Key
+}
+
+// After returns the condition selecting the rows after the cursor of a page. key is the SQL expression of the sort
+// key, id the ID column, and keyArg and idArg the placeholders of the cursor.
+func (s Sort) After(key, id, keyArg, idArg string) string {
+	if s.Desc {
+		return "(" + key + ", " + id + ") < (" + keyArg + ", " + idArg + ")"
+	}
+	return "(" + key + ", " + id + ") > (" + keyArg + ", " + idArg + ")"
+}
+
+// OrderBy returns the ORDER BY list of the sort, key is the SQL expression of the sort key and id the ID column.
+func (s Sort) OrderBy(key, id string) string {
+	if s.Desc {
+		return key + " DESC, " + id + " DESC"
+	}
+	return key + ", " + id
+}
+
+type cursor struct {
+	Sort  string          `json:"s"`
+	Value json.RawMessage `json:"v"`
+	ID    int             `json:"id"`
+}
+
+// Encode returns the token of the page after the item with the sort key value and ID. Lists sorted by ID pass a nil
+// value.
+func Encode(sort Sort, value interface{}, id int) (string, error) {
+	encodedValue, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode page token: %w", err)
+	}
+	token, err := json.Marshal(cursor{Sort: sort.String(), Value: encodedValue, ID: id})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode page token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// Decode reads the sort key value of the token into value and returns the ID, 0 for an empty token, the first page.
+// It returns ErrInvalidToken when the token is malformed or was made for another sort.
+func Decode(token string, sort Sort, value interface{}) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidToken
+	}
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.Sort != sort.String() || c.ID <= 0 {
+		return 0, ErrInvalidToken
+	}
+	if err := json.Unmarshal(c.Value, value); err != nil {
+		return 0, ErrInvalidToken
+	}
+	return c.ID, nil
+}
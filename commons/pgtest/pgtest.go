@@ -0,0 +1,63 @@
+// Package pgtest connects tests and benchmarks to the PostgreSQL database at TEST_POSTGRES_ADDRESS. Each caller gets
+// a schema of its own, so services can migrate and fill it without touching other data of the database.
+package pgtest
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"os"
+	"testing"
+	"time"
+)
+
+// AddressEnv names the environment variable with the address of the test database. Tests using it are skipped when
+// it is unset.
+const AddressEnv = "TEST_POSTGRES_ADDRESS"
+
+// Connect returns a pool whose connections use a new empty schema, followed by public for the extensions installed
+// there. The schema is dropped and the pool closed when tb ends.
+func Connect(tb testing.TB) *pgxpool.Pool {
+	tb.Helper()
+	address := os.Getenv(AddressEnv)
+	if address == "" {
+		tb.Skipf("%s is not set", AddressEnv)
+	}
+	ctx := context.Background()
+
+	conn, err := pgx.Connect(ctx, address)
+	if err != nil {
+		tb.Fatalf("pgtest: failed to connect: %v", err)
+	}
+	schema := fmt.Sprintf("test_%d_%d", os.Getpid(), time.Now().UnixNano())
+	_, err = conn.Exec(ctx, "CREATE SCHEMA "+schema)
+	conn.Close(ctx)
+	if err != nil {
+		tb.Fatalf("pgtest: failed to create schema: %v", err)
+	}
+
+	config, err := pgxpool.ParseConfig(address)
+	if err != nil {
+		tb.Fatalf("pgtest: %v", err)
+	}
+	config.ConnConfig.RuntimeParams["search_path"] = schema + ", public"
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		tb.Fatalf("pgtest: failed to create pool: %v", err)
+	}
+
+	tb.Cleanup(func() {
+		pool.Close()
+		conn, err := pgx.Connect(ctx, address)
+		if err != nil {
+			tb.Errorf("pgtest: failed to connect: %v", err)
+			return
+		}
+		defer conn.Close(ctx)
+		if _, err := conn.Exec(ctx, "DROP SCHEMA "+schema+" CASCADE"); err != nil {
+			tb.Errorf("pgtest: failed to drop schema: %v", err)
+		}
+	})
+	return pool
+}
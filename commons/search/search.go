@@ -0,0 +1,55 @@
+// Package search holds what the full-text search of the services has in common: the limits of a search, the
+// ts_headline options and the conversion of headlines to HTML.
+package search
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// DefaultLimit is the number of results of a search that has no limit.
+	DefaultLimit = 20
+	MaxLimit     = 50
+	// MaxQueryLength is the maximum length of a query in characters.
+	MaxQueryLength = 200
+)
+
+// The matches in headlines are delimited by control characters, which never appear in the searched text, so the
+// headline can be escaped before they are turned into tags.
+const (
+	startSel = "\x02"
+	stopSel  = "\x03"
+)
+
+// HeadlineOptions are the ts_headline options of the searches, the headline is at most two fragments of the text.
+const HeadlineOptions = "StartSel=" + startSel + ", StopSel=" + stopSel +
+	`, MaxFragments=2, MaxWords=20, MinWords=8, FragmentDelimiter=" … "`
+
+// Query trims query and validates it and limit, a limit of 0 is DefaultLimit. The returned errors are meant for
+// clients.
+func Query(query string, limit int32) (string, int, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return "", 0, errors.New("Search query must not be empty")
+	}
+	if utf8.RuneCountInString(query) > MaxQueryLength {
+		return "", 0, fmt.Errorf("Search query must be at most %d characters", MaxQueryLength)
+	}
+	if limit == 0 {
+		return query, DefaultLimit, nil
+	}
+	if limit < 0 || limit > MaxLimit {
+		return "", 0, fmt.Errorf("Limit must be between 1 and %d", MaxLimit)
+	}
+	return query, int(limit), nil
+}
+
+// Highlight returns a headline built with HeadlineOptions as HTML, with the matches in <mark> tags.
+func Highlight(headline string) string {
+	headline = html.EscapeString(headline)
+	return strings.NewReplacer(startSel, "<mark>", stopSel, "</mark>").Replace(headline)
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/GP-Hacks/kdt2024-gateway/internal/http-server/middleware/jwtauth"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// devtoken signs an HS256 token with JWT_SECRET for local testing of the gateway.
+func main() {
+	userID := flag.String("user", "", "user ID to put into the sub claim")
+	ttl := flag.Duration("ttl", 24*time.Hour, "token lifetime")
+	roles := flag.String("roles", "", "comma-separated roles, e.g. staff,admin")
+	flag.Parse()
+
+	secret := os.Getenv("JWT_SECRET")
+	if secret == "" || *userID == "" {
+		fmt.Fprintln(os.Stderr, "usage: JWT_SECRET=... devtoken -user <id> [-ttl 24h] [-roles staff]")
+		os.Exit(2)
+	}
+
+	now := time.Now()
+	claims := jwtauth.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   *userID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(*ttl)),
+		},
+	}
+	if *roles != "" {
+		claims.Roles = strings.Split(*roles, ",")
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString([]byte(secret))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println(signed)
+}
@@ -2,18 +2,25 @@ package main
 
 import (
 	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
 	"github.com/GP-Hacks/kdt2024-commons/prettylogger"
 	"github.com/GP-Hacks/kdt2024-gateway/config"
 	charityclient "github.com/GP-Hacks/kdt2024-gateway/internal/grpc-clients/charity"
 	chatclient "github.com/GP-Hacks/kdt2024-gateway/internal/grpc-clients/chat"
+	notificationsclient "github.com/GP-Hacks/kdt2024-gateway/internal/grpc-clients/notifications"
 	placesclient "github.com/GP-Hacks/kdt2024-gateway/internal/grpc-clients/places"
+	purchasesclient "github.com/GP-Hacks/kdt2024-gateway/internal/grpc-clients/purchases"
 	votesclient "github.com/GP-Hacks/kdt2024-gateway/internal/grpc-clients/votes"
+	"github.com/GP-Hacks/kdt2024-gateway/internal/http-server/handlers/admin"
 	"github.com/GP-Hacks/kdt2024-gateway/internal/http-server/handlers/charity"
 	"github.com/GP-Hacks/kdt2024-gateway/internal/http-server/handlers/chat"
+	"github.com/GP-Hacks/kdt2024-gateway/internal/http-server/handlers/inbox"
 	"github.com/GP-Hacks/kdt2024-gateway/internal/http-server/handlers/places"
+	"github.com/GP-Hacks/kdt2024-gateway/internal/http-server/handlers/preferences"
+	"github.com/GP-Hacks/kdt2024-gateway/internal/http-server/handlers/search"
 	"github.com/GP-Hacks/kdt2024-gateway/internal/http-server/handlers/tokens"
 	"github.com/GP-Hacks/kdt2024-gateway/internal/http-server/handlers/votes"
-	"github.com/GP-Hacks/kdt2024-gateway/internal/storage"
+	"github.com/GP-Hacks/kdt2024-gateway/internal/http-server/middleware/jwtauth"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/prometheus/client_golang/prometheus"
@@ -73,11 +80,6 @@ func main() {
 
 	log.Info("Prometheus metrics registered")
 
-	if err := connectToMongoDB(cfg, log); err != nil {
-		log.Error("Failed to connect to MongoDB", slog.String("error", err.Error()))
-		os.Exit(1)
-	}
-
 	chatClient, err := setupChatClient(cfg, log)
 	if err != nil {
 		log.Error("Failed to setup ChatClient", slog.String("address", cfg.ChatAddress), slog.String("error", err.Error()))
@@ -102,18 +104,26 @@ func main() {
 		os.Exit(1)
 	}
 
-	router := setupRouter(cfg, log, chatClient, placesClient, charityClient, votesClient)
-	startServer(cfg, router, log)
-}
+	notificationsClient, err := setupNotificationsClient(cfg, log)
+	if err != nil {
+		log.Error("Failed to setup NotificationsClient", slog.String("address", cfg.NotificationsAddress), slog.String("error", err.Error()))
+		os.Exit(1)
+	}
 
-func connectToMongoDB(cfg *config.Config, log *slog.Logger) error {
-	log.Debug("Connecting to MongoDB", slog.String("path", cfg.MongoDBPath), slog.String("name", cfg.MongoDBName), slog.String("collection", cfg.MongoDBCollection))
-	err := storage.Connect(cfg.MongoDBPath, cfg.MongoDBName, cfg.MongoDBCollection)
+	purchasesClient, err := setupPurchasesClient(cfg, log)
 	if err != nil {
-		return err
+		log.Error("Failed to setup PurchasesClient", slog.String("address", cfg.PurchasesAddress), slog.String("error", err.Error()))
+		os.Exit(1)
 	}
-	log.Info("Connected to MongoDB", slog.String("path", cfg.MongoDBPath))
-	return nil
+
+	verifier, err := setupJWTVerifier(cfg, log)
+	if err != nil {
+		log.Error("Failed to setup JWT verifier", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	router := setupRouter(cfg, log, verifier, chatClient, placesClient, charityClient, votesClient, notificationsClient, purchasesClient)
+	startServer(cfg, router, log)
 }
 
 func setupChatClient(cfg *config.Config, log *slog.Logger) (proto.ChatServiceClient, error) {
@@ -156,13 +166,52 @@ func setupVotesClient(cfg *config.Config, log *slog.Logger) (proto.VotesServiceC
 	return client, nil
 }
 
-func setupRouter(cfg *config.Config, log *slog.Logger, chatClient proto.ChatServiceClient, placesClient proto.PlacesServiceClient, charityClient proto.CharityServiceClient, votesClient proto.VotesServiceClient) *chi.Mux {
+func setupNotificationsClient(cfg *config.Config, log *slog.Logger) (proto.NotificationsServiceClient, error) {
+	log.Debug("Setting up NotificationsClient", slog.String("address", cfg.NotificationsAddress))
+	client, err := notificationsclient.SetupNotificationsClient(cfg.NotificationsAddress, log)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("NotificationsClient setup successfully", slog.String("address", cfg.NotificationsAddress))
+	return client, nil
+}
+
+func setupPurchasesClient(cfg *config.Config, log *slog.Logger) (proto.PurchasesServiceClient, error) {
+	log.Debug("Setting up PurchasesClient", slog.String("address", cfg.PurchasesAddress))
+	client, err := purchasesclient.SetupPurchasesClient(cfg.PurchasesAddress, log)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("PurchasesClient setup successfully", slog.String("address", cfg.PurchasesAddress))
+	return client, nil
+}
+
+func setupJWTVerifier(cfg *config.Config, log *slog.Logger) (*jwtauth.Verifier, error) {
+	if cfg.JWTPublicKeyPath != "" {
+		log.Debug("Loading RSA public key for JWT verification", slog.String("path", cfg.JWTPublicKeyPath))
+		publicKey, err := os.ReadFile(cfg.JWTPublicKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		log.Info("JWT verification uses RS256")
+		return jwtauth.NewRSAVerifier(publicKey)
+	}
+
+	log.Info("JWT verification uses HS256")
+	return jwtauth.NewHMACVerifier([]byte(cfg.JWTSecret))
+}
+
+func setupRouter(cfg *config.Config, log *slog.Logger, verifier *jwtauth.Verifier, chatClient proto.ChatServiceClient, placesClient proto.PlacesServiceClient, charityClient proto.CharityServiceClient, votesClient proto.VotesServiceClient, notificationsClient proto.NotificationsServiceClient, purchasesClient proto.PurchasesServiceClient) *chi.Mux {
 	router := chi.NewRouter()
 	router.Use(middleware.RequestID)
 	router.Use(middleware.RealIP)
 	router.Use(middleware.Recoverer)
 	router.Use(middleware.URLFormat)
 	router.Use(prometheusMiddleware)
+	router.Use(jwtauth.New(log, verifier))
+
+	authorized := router.With(jwtauth.Required(log))
+	admins := authorized.With(jwtauth.RequireRole(log, auth.RoleAdmin))
 
 	router.Get("/swagger", func(w http.ResponseWriter, r *http.Request) {
 		yamlFile, err := os.ReadFile("/root/swagger.yaml")
@@ -179,24 +228,62 @@ func setupRouter(cfg *config.Config, log *slog.Logger, chatClient proto.ChatServ
 	),
 	)
 
-	router.Post("/api/chat/ask", chat.NewSendMessageHandler(log, chatClient))
-	router.Post("/api/user/token", tokens.NewAddTokenHandler(log))
+	authorized.Post("/api/chat/ask", chat.NewSendMessageHandler(log, chatClient))
+	authorized.Post("/api/user/token", tokens.NewAddTokenHandler(log, notificationsClient))
+	authorized.Delete("/api/user/token", tokens.NewDeleteTokenHandler(log, notificationsClient))
+	authorized.Get("/api/user/preferences", preferences.NewGetPreferencesHandler(log, notificationsClient))
+	authorized.Put("/api/user/preferences", preferences.NewUpdatePreferencesHandler(log, notificationsClient))
+	authorized.Get("/api/user/notifications", inbox.NewListNotificationsHandler(log, notificationsClient))
+	authorized.Get("/api/user/notifications/unread-count", inbox.NewUnreadCountHandler(log, notificationsClient))
+	authorized.Post("/api/user/notifications/read", inbox.NewMarkReadHandler(log, notificationsClient))
 
 	router.Post("/api/places", places.NewGetPlacesHandler(log, placesClient))
 	router.Get("/api/places/categories", places.NewGetCategoriesHandler(log, placesClient))
-	router.Get("/api/places/tickets", places.NewGetTicketsHandler(log, placesClient))
-	router.Post("/api/places/buy", places.NewBuyTicketHandler(log, placesClient))
+	router.Get("/api/places/slots", places.NewGetSlotsHandler(log, placesClient))
+	authorized.Get("/api/places/tickets", places.NewGetTicketsHandler(log, placesClient))
+	authorized.Post("/api/places/tickets/{id}/cancel", places.NewCancelTicketHandler(log, placesClient))
+	authorized.Get("/api/places/tickets/{id}/qr", places.NewGetTicketQRHandler(log, placesClient))
+	authorized.Post("/api/places/tickets/validate", places.NewValidateTicketHandler(log, placesClient))
+	authorized.Post("/api/places/buy", places.NewBuyTicketHandler(log, placesClient))
 
 	router.Get("/api/charity", charity.NewGetCollectionsHandler(log, charityClient))
 	router.Get("/api/charity/categories", charity.NewGetCategoriesHandler(log, charityClient))
-	router.Post("/api/charity/donate", charity.NewDonateHandler(log, charityClient))
+	authorized.Post("/api/charity/donate", charity.NewDonateHandler(log, charityClient))
+	authorized.Post("/api/charity/donations/{id}/refund", charity.NewRefundDonationHandler(log, charityClient))
+	authorized.Get("/api/charity/donations/{id}/receipt", charity.NewGetReceiptHandler(log, purchasesClient))
+	authorized.Get("/api/charity/statements/{year}", charity.NewGetStatementHandler(log, purchasesClient))
+	authorized.Post("/api/charity/subscriptions", charity.NewCreateSubscriptionHandler(log, charityClient))
+	authorized.Get("/api/charity/subscriptions", charity.NewGetSubscriptionsHandler(log, charityClient))
+	authorized.Post("/api/charity/subscriptions/{id}/pause", charity.NewPauseSubscriptionHandler(log, charityClient))
+	authorized.Post("/api/charity/subscriptions/{id}/resume", charity.NewResumeSubscriptionHandler(log, charityClient))
+	authorized.Post("/api/charity/subscriptions/{id}/cancel", charity.NewCancelSubscriptionHandler(log, charityClient))
 
 	router.Get("/api/votes", votes.NewGetVotesHandler(log, votesClient))
 	router.Get("/api/votes/categories", votes.NewGetCategoriesHandler(log, votesClient))
 	router.Get("/api/votes/info", votes.NewGetVoteInfoHandler(log, votesClient))
-	router.Post("/api/votes/rate", votes.NewVoteRateHandler(log, votesClient))
-	router.Post("/api/votes/petition", votes.NewVotePetitionHandler(log, votesClient))
-	router.Post("/api/votes/choice", votes.NewVoteChoiceHandler(log, votesClient))
+	router.Get("/api/votes/petitions", votes.NewGetPetitionsHandler(log, votesClient))
+	authorized.Post("/api/votes/rate", votes.NewVoteRateHandler(log, votesClient))
+	authorized.Post("/api/votes/petition", votes.NewVotePetitionHandler(log, votesClient))
+	authorized.Post("/api/votes/choice", votes.NewVoteChoiceHandler(log, votesClient))
+	authorized.Post("/api/votes/multiselect", votes.NewVoteMultiSelectHandler(log, votesClient))
+	authorized.Post("/api/votes/ranked", votes.NewVoteRankedHandler(log, votesClient))
+
+	router.Get("/api/search", search.NewSearchHandler(log, placesClient, charityClient, votesClient))
+
+	admins.Post("/api/admin/charity/collections", admin.NewCreateCollectionHandler(log, charityClient))
+	admins.Put("/api/admin/charity/collections/{id}", admin.NewUpdateCollectionHandler(log, charityClient))
+	admins.Delete("/api/admin/charity/collections/{id}", admin.NewDeleteCollectionHandler(log, charityClient))
+	admins.Post("/api/admin/places", admin.NewCreatePlaceHandler(log, placesClient))
+	admins.Put("/api/admin/places/{id}", admin.NewUpdatePlaceHandler(log, placesClient))
+	admins.Delete("/api/admin/places/{id}", admin.NewDeletePlaceHandler(log, placesClient))
+	admins.Post("/api/admin/places/{id}/photos", admin.NewAddPlacePhotoHandler(log, placesClient))
+	admins.Delete("/api/admin/places/{id}/photos/{photo_id}", admin.NewDeletePlacePhotoHandler(log, placesClient))
+	admins.Post("/api/admin/votes", admin.NewCreateVoteHandler(log, votesClient))
+	admins.Put("/api/admin/votes/{id}", admin.NewUpdateVoteHandler(log, votesClient))
+	admins.Delete("/api/admin/votes/{id}", admin.NewDeleteVoteHandler(log, votesClient))
+	admins.Post("/api/admin/votes/{id}/options", admin.NewAddVoteOptionHandler(log, votesClient))
+	admins.Delete("/api/admin/votes/{id}/options/{option_id}", admin.NewDeleteVoteOptionHandler(log, votesClient))
+	admins.Put("/api/admin/votes/{id}/petition-state", admin.NewUpdatePetitionStateHandler(log, votesClient))
 
 	router.Handle("/metrics", promhttp.Handler())
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"log/slog"
@@ -13,7 +14,10 @@ import (
 func SetupCharityClient(address string, log *slog.Logger) (proto.CharityServiceClient, error) {
 	log.Debug("Attempting to create gRPC connection", slog.String("address", address))
 
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(auth.UnaryClientInterceptor()),
+	)
 	if err != nil {
 		log.Error("Failed to create gRPC connection", slog.String("address", address), slog.String("error", err.Error()))
 		return nil, fmt.Errorf("failed to create gRPC connection with charity service: %w", err)
@@ -0,0 +1,52 @@
+package grpc_clients
+
+import (
+	"context"
+	"fmt"
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"log/slog"
+	"time"
+)
+
+func SetupPurchasesClient(address string, log *slog.Logger) (proto.PurchasesServiceClient, error) {
+	log.Debug("Attempting to create gRPC connection", slog.String("address", address))
+
+	conn, err := grpc.Dial(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(auth.UnaryClientInterceptor()),
+	)
+	if err != nil {
+		log.Error("Failed to create gRPC connection", slog.String("address", address), slog.String("error", err.Error()))
+		return nil, fmt.Errorf("failed to create gRPC connection with purchases service: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = conn.Close()
+			log.Info("Closed gRPC connection due to error", slog.String("address", address))
+		}
+	}()
+
+	purchasesClient := proto.NewPurchasesServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	log.Debug("Performing health check on purchases service", slog.String("address", address))
+	healthResponse, err := purchasesClient.HealthCheck(ctx, &proto.HealthCheckRequest{})
+	if err != nil {
+		log.Error("Health check failed", slog.String("address", address), slog.String("error", err.Error()))
+		return nil, fmt.Errorf("health check failed: %w", err)
+	}
+
+	if !healthResponse.IsHealthy {
+		err = fmt.Errorf("purchases service is not healthy")
+		log.Warn("Purchases service reported as unhealthy", slog.String("address", address))
+		return nil, err
+	}
+
+	log.Info("Successfully connected to purchases service", slog.String("address", address))
+	return purchasesClient, nil
+}
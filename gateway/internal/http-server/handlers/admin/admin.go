@@ -0,0 +1,111 @@
+// Package admin serves the admin API for managing collections, places and votes. The routes are only open to users
+// with the admin role, the services check the role again and record every change in their audit logs.
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// requestError is returned by calls for requests that cannot be passed on to the service.
+type requestError struct {
+	message string
+}
+
+func (e *requestError) Error() string {
+	return e.message
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return &requestError{message: fmt.Sprintf(format, args...)}
+}
+
+// newHandler builds a handler running call and writing what it returns with successStatus. Errors of the call are
+// mapped to the HTTP status matching their gRPC code.
+func newHandler(log *slog.Logger, op string, action string, successStatus int, call func(ctx context.Context, r *http.Request) (interface{}, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing request to " + action)
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		response, err := call(ctx, r)
+		if err != nil {
+			writeError(w, logger, action, err)
+			return
+		}
+
+		logger.Info("Request to " + action + " processed")
+		json.WriteJSON(w, successStatus, response)
+	}
+}
+
+func writeError(w http.ResponseWriter, logger *slog.Logger, action string, err error) {
+	var reqErr *requestError
+	if errors.As(err, &reqErr) {
+		logger.Warn("Invalid request", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, reqErr.message)
+		return
+	}
+
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		logger.Warn("Invalid request", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, status.Convert(err).Message())
+	case codes.NotFound:
+		logger.Warn("Not found", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusNotFound, status.Convert(err).Message())
+	case codes.FailedPrecondition:
+		logger.Warn("Change rejected", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusConflict, status.Convert(err).Message())
+	case codes.Unauthenticated:
+		logger.Warn("Request is not authenticated")
+		json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+	case codes.PermissionDenied:
+		logger.Warn("Not enough permissions")
+		json.WriteError(w, http.StatusForbidden, "Not enough permissions")
+	default:
+		logger.Error("Failed to "+action, slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusInternalServerError, "Could not "+action)
+	}
+}
+
+// pathID returns the positive ID in the URL parameter.
+func pathID(r *http.Request, param string) (int32, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, param), 10, 32)
+	if err != nil || id <= 0 {
+		return 0, badRequest("Invalid %s", param)
+	}
+	return int32(id), nil
+}
+
+func readJSON(r *http.Request, v interface{}) error {
+	if err := json.ReadJSON(r, v); err != nil {
+		return badRequest("Invalid JSON input")
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package admin
+
+import (
+	"context"
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+type CollectionRequest struct {
+	Category     string     `json:"category"`
+	Name         string     `json:"name"`
+	Description  string     `json:"description"`
+	Organization string     `json:"organization"`
+	Phone        string     `json:"phone"`
+	Website      string     `json:"website"`
+	Goal         int32      `json:"goal"`
+	Photo        string     `json:"photo"`
+	Deadline     *time.Time `json:"deadline"`
+}
+
+type Collection struct {
+	ID           int32      `json:"id"`
+	Category     string     `json:"category"`
+	Name         string     `json:"name"`
+	Description  string     `json:"description"`
+	Organization string     `json:"organization"`
+	Phone        string     `json:"phone"`
+	Website      string     `json:"website"`
+	Goal         int32      `json:"goal"`
+	Current      int32      `json:"current"`
+	Photo        string     `json:"photo"`
+	State        string     `json:"state"`
+	Deadline     *time.Time `json:"deadline,omitempty"`
+}
+
+func (c *CollectionRequest) toProto() *proto.CollectionInput {
+	input := &proto.CollectionInput{
+		Category:     c.Category,
+		Name:         c.Name,
+		Description:  c.Description,
+		Organization: c.Organization,
+		Phone:        c.Phone,
+		Website:      c.Website,
+		Goal:         c.Goal,
+		Photo:        c.Photo,
+	}
+	if c.Deadline != nil {
+		input.Deadline = timestamppb.New(*c.Deadline)
+	}
+	return input
+}
+
+func toCollection(collection *proto.Collection) Collection {
+	result := Collection{
+		ID:           collection.GetId(),
+		Category:     collection.GetCategory(),
+		Name:         collection.GetName(),
+		Description:  collection.GetDescription(),
+		Organization: collection.GetOrganization(),
+		Phone:        collection.GetPhone(),
+		Website:      collection.GetWebsite(),
+		Goal:         collection.GetGoal(),
+		Current:      collection.GetCurrent(),
+		Photo:        collection.GetPhoto(),
+		State:        collection.GetState(),
+	}
+	if collection.GetDeadline() != nil {
+		deadline := collection.GetDeadline().AsTime()
+		result.Deadline = &deadline
+	}
+	return result
+}
+
+func NewCreateCollectionHandler(log *slog.Logger, charityClient proto.CharityServiceClient) http.HandlerFunc {
+	return newHandler(log, "handler.admin.collections.Create", "create collection", http.StatusCreated, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		var request CollectionRequest
+		if err := readJSON(r, &request); err != nil {
+			return nil, err
+		}
+
+		resp, err := charityClient.CreateCollection(ctx, &proto.CreateCollectionRequest{Collection: request.toProto()})
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"response": resp.GetResponse(), "collection": toCollection(resp.GetCollection())}, nil
+	})
+}
+
+func NewUpdateCollectionHandler(log *slog.Logger, charityClient proto.CharityServiceClient) http.HandlerFunc {
+	return newHandler(log, "handler.admin.collections.Update", "update collection", http.StatusOK, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return nil, err
+		}
+		var request CollectionRequest
+		if err := readJSON(r, &request); err != nil {
+			return nil, err
+		}
+
+		resp, err := charityClient.UpdateCollection(ctx, &proto.UpdateCollectionRequest{Id: id, Collection: request.toProto()})
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"response": resp.GetResponse(), "collection": toCollection(resp.GetCollection())}, nil
+	})
+}
+
+func NewDeleteCollectionHandler(log *slog.Logger, charityClient proto.CharityServiceClient) http.HandlerFunc {
+	return newHandler(log, "handler.admin.collections.Delete", "delete collection", http.StatusOK, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := charityClient.DeleteCollection(ctx, &proto.DeleteCollectionRequest{Id: id})
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"response": resp.GetResponse()}, nil
+	})
+}
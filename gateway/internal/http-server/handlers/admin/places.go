@@ -0,0 +1,163 @@
+package admin
+
+import (
+	"context"
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"log/slog"
+	"net/http"
+)
+
+type PlaceRequest struct {
+	Category    string  `json:"category"`
+	Description string  `json:"description"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	Location    string  `json:"location"`
+	Name        string  `json:"name"`
+	Tel         string  `json:"tel"`
+	Website     string  `json:"website"`
+	Cost        int32   `json:"cost"`
+}
+
+type Place struct {
+	ID          int32   `json:"id"`
+	Category    string  `json:"category"`
+	Description string  `json:"description"`
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	Location    string  `json:"location"`
+	Name        string  `json:"name"`
+	Tel         string  `json:"tel"`
+	Website     string  `json:"website"`
+	Cost        int32   `json:"cost"`
+	Photos      []Photo `json:"photos"`
+}
+
+type Photo struct {
+	ID  int32  `json:"id"`
+	Url string `json:"url"`
+}
+
+func (p *PlaceRequest) toProto() *proto.PlaceInput {
+	return &proto.PlaceInput{
+		Category:    p.Category,
+		Description: p.Description,
+		Latitude:    p.Latitude,
+		Longitude:   p.Longitude,
+		Location:    p.Location,
+		Name:        p.Name,
+		Tel:         p.Tel,
+		Website:     p.Website,
+		Cost:        p.Cost,
+	}
+}
+
+func toPlace(place *proto.Place) Place {
+	result := Place{
+		ID:          place.GetId(),
+		Category:    place.GetCategory(),
+		Description: place.GetDescription(),
+		Latitude:    place.GetLatitude(),
+		Longitude:   place.GetLongitude(),
+		Location:    place.GetLocation(),
+		Name:        place.GetName(),
+		Tel:         place.GetTel(),
+		Website:     place.GetWebsite(),
+		Cost:        place.GetCost(),
+		Photos:      []Photo{},
+	}
+	for _, photo := range place.GetPhotos() {
+		result.Photos = append(result.Photos, Photo{ID: photo.GetId(), Url: photo.GetUrl()})
+	}
+	return result
+}
+
+func NewCreatePlaceHandler(log *slog.Logger, placesClient proto.PlacesServiceClient) http.HandlerFunc {
+	return newHandler(log, "handler.admin.places.Create", "create place", http.StatusCreated, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		var request PlaceRequest
+		if err := readJSON(r, &request); err != nil {
+			return nil, err
+		}
+
+		resp, err := placesClient.CreatePlace(ctx, &proto.CreatePlaceRequest{Place: request.toProto()})
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"response": resp.GetResponse(), "place": toPlace(resp.GetPlace())}, nil
+	})
+}
+
+func NewUpdatePlaceHandler(log *slog.Logger, placesClient proto.PlacesServiceClient) http.HandlerFunc {
+	return newHandler(log, "handler.admin.places.Update", "update place", http.StatusOK, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return nil, err
+		}
+		var request PlaceRequest
+		if err := readJSON(r, &request); err != nil {
+			return nil, err
+		}
+
+		resp, err := placesClient.UpdatePlace(ctx, &proto.UpdatePlaceRequest{Id: id, Place: request.toProto()})
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"response": resp.GetResponse(), "place": toPlace(resp.GetPlace())}, nil
+	})
+}
+
+func NewDeletePlaceHandler(log *slog.Logger, placesClient proto.PlacesServiceClient) http.HandlerFunc {
+	return newHandler(log, "handler.admin.places.Delete", "delete place", http.StatusOK, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := placesClient.DeletePlace(ctx, &proto.DeletePlaceRequest{Id: id})
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"response": resp.GetResponse()}, nil
+	})
+}
+
+func NewAddPlacePhotoHandler(log *slog.Logger, placesClient proto.PlacesServiceClient) http.HandlerFunc {
+	return newHandler(log, "handler.admin.places.AddPhoto", "add place photo", http.StatusCreated, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return nil, err
+		}
+		var request struct {
+			Url string `json:"url"`
+		}
+		if err := readJSON(r, &request); err != nil {
+			return nil, err
+		}
+
+		resp, err := placesClient.AddPlacePhoto(ctx, &proto.AddPlacePhotoRequest{PlaceId: id, Url: request.Url})
+		if err != nil {
+			return nil, err
+		}
+		photo := Photo{ID: resp.GetPhoto().GetId(), Url: resp.GetPhoto().GetUrl()}
+		return map[string]interface{}{"response": resp.GetResponse(), "photo": photo}, nil
+	})
+}
+
+func NewDeletePlacePhotoHandler(log *slog.Logger, placesClient proto.PlacesServiceClient) http.HandlerFunc {
+	return newHandler(log, "handler.admin.places.DeletePhoto", "delete place photo", http.StatusOK, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return nil, err
+		}
+		photoID, err := pathID(r, "photo_id")
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := placesClient.DeletePlacePhoto(ctx, &proto.DeletePlacePhotoRequest{PlaceId: id, PhotoId: photoID})
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"response": resp.GetResponse()}, nil
+	})
+}
@@ -0,0 +1,235 @@
+package admin
+
+import (
+	"context"
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+type VoteRequest struct {
+	Category     string    `json:"category"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	Organization string    `json:"organization"`
+	End          time.Time `json:"end"`
+	Photo        string    `json:"photo"`
+	Options      []string  `json:"options"`
+	// SignatureThreshold is required for petitions.
+	SignatureThreshold int32 `json:"signature_threshold"`
+	// MinSelections and MaxSelections are required for multiselect votes, an update keeps a zero limit.
+	MinSelections int32 `json:"min_selections"`
+	MaxSelections int32 `json:"max_selections"`
+}
+
+type Vote struct {
+	ID           int32        `json:"id"`
+	Category     string       `json:"category"`
+	Name         string       `json:"name"`
+	Description  string       `json:"description"`
+	Organization string       `json:"organization"`
+	End          time.Time    `json:"end"`
+	Photo        string       `json:"photo"`
+	Options      []VoteOption `json:"options"`
+	// SignatureThreshold and PetitionState are only set for petitions.
+	SignatureThreshold int32  `json:"signature_threshold,omitempty"`
+	PetitionState      string `json:"petition_state,omitempty"`
+	// MinSelections and MaxSelections are only set for multiselect votes.
+	MinSelections int32 `json:"min_selections,omitempty"`
+	MaxSelections int32 `json:"max_selections,omitempty"`
+}
+
+type PetitionStateRequest struct {
+	State            string `json:"state"`
+	OfficialResponse string `json:"official_response"`
+}
+
+type Petition struct {
+	ID                 int32      `json:"id"`
+	Name               string     `json:"name"`
+	Organization       string     `json:"organization"`
+	SignatureThreshold int32      `json:"signature_threshold"`
+	Signatures         int32      `json:"signatures"`
+	State              string     `json:"state"`
+	OfficialResponse   string     `json:"official_response"`
+	StateChangedAt     *time.Time `json:"state_changed_at,omitempty"`
+}
+
+type VoteOption struct {
+	ID     int32  `json:"id"`
+	Option string `json:"option"`
+}
+
+func (v *VoteRequest) toProto() *proto.VoteInput {
+	input := &proto.VoteInput{
+		Category:     v.Category,
+		Name:         v.Name,
+		Description:  v.Description,
+		Organization: v.Organization,
+		Photo:        v.Photo,
+		Options:      v.Options,
+
+		SignatureThreshold: v.SignatureThreshold,
+		MinSelections:      v.MinSelections,
+		MaxSelections:      v.MaxSelections,
+	}
+	if !v.End.IsZero() {
+		input.End = timestamppb.New(v.End)
+	}
+	return input
+}
+
+func toVote(resp *proto.AdminVoteResponse) Vote {
+	vote := resp.GetVote()
+	result := Vote{
+		ID:           vote.GetId(),
+		Category:     vote.GetCategory(),
+		Name:         vote.GetName(),
+		Description:  vote.GetDescription(),
+		Organization: vote.GetOrganization(),
+		End:          vote.GetEnd().AsTime(),
+		Photo:        vote.GetPhoto(),
+		Options:      []VoteOption{},
+
+		SignatureThreshold: vote.GetSignatureThreshold(),
+		PetitionState:      vote.GetPetitionState(),
+		MinSelections:      vote.GetMinSelections(),
+		MaxSelections:      vote.GetMaxSelections(),
+	}
+	for _, option := range resp.GetOptions() {
+		result.Options = append(result.Options, VoteOption{ID: option.GetId(), Option: option.GetOption()})
+	}
+	return result
+}
+
+func NewCreateVoteHandler(log *slog.Logger, votesClient proto.VotesServiceClient) http.HandlerFunc {
+	return newHandler(log, "handler.admin.votes.Create", "create vote", http.StatusCreated, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		var request VoteRequest
+		if err := readJSON(r, &request); err != nil {
+			return nil, err
+		}
+
+		resp, err := votesClient.CreateVote(ctx, &proto.CreateVoteRequest{Vote: request.toProto()})
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"response": resp.GetResponse(), "vote": toVote(resp)}, nil
+	})
+}
+
+func NewUpdateVoteHandler(log *slog.Logger, votesClient proto.VotesServiceClient) http.HandlerFunc {
+	return newHandler(log, "handler.admin.votes.Update", "update vote", http.StatusOK, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return nil, err
+		}
+		var request VoteRequest
+		if err := readJSON(r, &request); err != nil {
+			return nil, err
+		}
+
+		resp, err := votesClient.UpdateVote(ctx, &proto.UpdateVoteRequest{Id: id, Vote: request.toProto()})
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"response": resp.GetResponse(), "vote": toVote(resp)}, nil
+	})
+}
+
+func NewDeleteVoteHandler(log *slog.Logger, votesClient proto.VotesServiceClient) http.HandlerFunc {
+	return newHandler(log, "handler.admin.votes.Delete", "delete vote", http.StatusOK, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := votesClient.DeleteVote(ctx, &proto.DeleteVoteRequest{Id: id})
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"response": resp.GetResponse()}, nil
+	})
+}
+
+func NewAddVoteOptionHandler(log *slog.Logger, votesClient proto.VotesServiceClient) http.HandlerFunc {
+	return newHandler(log, "handler.admin.votes.AddOption", "add vote option", http.StatusCreated, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return nil, err
+		}
+		var request struct {
+			Option string `json:"option"`
+		}
+		if err := readJSON(r, &request); err != nil {
+			return nil, err
+		}
+
+		resp, err := votesClient.AddVoteOption(ctx, &proto.AddVoteOptionRequest{VoteId: id, Option: request.Option})
+		if err != nil {
+			return nil, err
+		}
+		option := VoteOption{ID: resp.GetOption().GetId(), Option: resp.GetOption().GetOption()}
+		return map[string]interface{}{"response": resp.GetResponse(), "option": option}, nil
+	})
+}
+
+func NewDeleteVoteOptionHandler(log *slog.Logger, votesClient proto.VotesServiceClient) http.HandlerFunc {
+	return newHandler(log, "handler.admin.votes.DeleteOption", "delete vote option", http.StatusOK, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return nil, err
+		}
+		optionID, err := pathID(r, "option_id")
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := votesClient.DeleteVoteOption(ctx, &proto.DeleteVoteOptionRequest{VoteId: id, OptionId: optionID})
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"response": resp.GetResponse()}, nil
+	})
+}
+
+func NewUpdatePetitionStateHandler(log *slog.Logger, votesClient proto.VotesServiceClient) http.HandlerFunc {
+	return newHandler(log, "handler.admin.votes.UpdatePetitionState", "update petition state", http.StatusOK, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := pathID(r, "id")
+		if err != nil {
+			return nil, err
+		}
+		var request PetitionStateRequest
+		if err := readJSON(r, &request); err != nil {
+			return nil, err
+		}
+		if request.State == "" {
+			return nil, badRequest("State is required")
+		}
+
+		resp, err := votesClient.UpdatePetitionState(ctx, &proto.UpdatePetitionStateRequest{
+			VoteId:           id,
+			State:            request.State,
+			OfficialResponse: request.OfficialResponse,
+		})
+		if err != nil {
+			return nil, err
+		}
+		info := resp.GetResponse()
+		petition := Petition{
+			ID:                 info.GetId(),
+			Name:               info.GetName(),
+			Organization:       info.GetOrganization(),
+			SignatureThreshold: info.GetSignatureThreshold(),
+			Signatures:         info.GetSignatures(),
+			State:              info.GetState(),
+			OfficialResponse:   info.GetOfficialResponse(),
+		}
+		if info.GetStateChangedAt() != nil {
+			changedAt := info.GetStateChangedAt().AsTime()
+			petition.StateChangedAt = &changedAt
+		}
+		return map[string]interface{}{"response": "Petition state updated", "petition": petition}, nil
+	})
+}
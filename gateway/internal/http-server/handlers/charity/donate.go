@@ -2,6 +2,7 @@ package charity
 
 import (
 	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
 	"github.com/GP-Hacks/kdt2024-commons/json"
 	"github.com/go-chi/chi/v5/middleware"
 	"google.golang.org/grpc/codes"
@@ -33,16 +34,16 @@ func NewDonateHandler(log *slog.Logger, charityClient proto.CharityServiceClient
 		default:
 		}
 
-		token := r.Header.Get("Authorization")
-		if token == "" {
-			logger.Warn("Authorization header missing")
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
 			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
 			return
 		}
 
 		var request struct {
-			CollectionId int `json:"collection_id"`
-			Amount       int `json:"amount"`
+			CollectionId int    `json:"collection_id"`
+			Amount       int    `json:"amount"`
+			PaymentToken string `json:"payment_token"`
 		}
 
 		if err := json.ReadJSON(r, &request); err != nil {
@@ -64,23 +65,38 @@ func NewDonateHandler(log *slog.Logger, charityClient proto.CharityServiceClient
 		}
 
 		protoRequest := &proto.DonateRequest{
-			Token:        token,
 			CollectionId: int32(request.CollectionId),
 			Amount:       int32(request.Amount),
+			PaymentToken: request.PaymentToken,
 		}
 
 		resp, err := charityClient.Donate(ctx, protoRequest)
 		if err != nil {
-			if status.Code(err) == codes.NotFound {
+			switch status.Code(err) {
+			case codes.NotFound:
 				logger.Warn("Collection not found for donation", slog.Int("collection_id", request.CollectionId))
 				json.WriteError(w, http.StatusNotFound, "Collection not found")
 				return
+			case codes.InvalidArgument:
+				logger.Warn("Invalid donation", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusBadRequest, status.Convert(err).Message())
+				return
+			case codes.FailedPrecondition:
+				logger.Warn("Collection is not accepting donations", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusConflict, status.Convert(err).Message())
+				return
 			}
 			logger.Error("Failed to process donation", slog.String("error", err.Error()))
 			json.WriteError(w, http.StatusInternalServerError, "Could not save your donation")
 			return
 		}
 
+		if resp.GetStatus() == "failed" {
+			logger.Warn("Donation payment declined", slog.Int("donation_id", int(resp.GetDonationId())))
+			json.WriteError(w, http.StatusPaymentRequired, resp.GetResponse())
+			return
+		}
+
 		logger.Info("Donation processed successfully", slog.Any("response", resp))
 		json.WriteJSON(w, http.StatusOK, resp)
 	}
@@ -3,32 +3,40 @@ package charity
 import (
 	"github.com/GP-Hacks/kdt2024-commons/api/proto"
 	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/GP-Hacks/kdt2024-gateway/internal/http-server/pagination"
 	"github.com/go-chi/chi/v5/middleware"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"log/slog"
 	"net/http"
+	"time"
 )
 
 type GetCollectionsResponseWithDefault struct {
 	Response []*CollectionWithDefault `json:"response"`
+	// NextCursor is the cursor of the next page, empty on the last page.
+	NextCursor string `json:"next_cursor"`
 }
 
 type CollectionWithDefault struct {
-	ID           int    `json:"id"`
-	Category     string `json:"category"`
-	Name         string `json:"name"`
-	Description  string `json:"description"`
-	Organization string `json:"organization"`
-	Phone        string `json:"phone"`
-	Website      string `json:"website"`
-	Goal         int    `json:"goal"`
-	Current      int    `json:"current"`
-	Photo        string `json:"photo"`
+	ID           int        `json:"id"`
+	Category     string     `json:"category"`
+	Name         string     `json:"name"`
+	Description  string     `json:"description"`
+	Organization string     `json:"organization"`
+	Phone        string     `json:"phone"`
+	Website      string     `json:"website"`
+	Goal         int        `json:"goal"`
+	Current      int        `json:"current"`
+	Photo        string     `json:"photo"`
+	State        string     `json:"state"`
+	Deadline     *time.Time `json:"deadline,omitempty"`
+	FundedAt     *time.Time `json:"funded_at,omitempty"`
+	ClosedAt     *time.Time `json:"closed_at,omitempty"`
 }
 
 func withDefaultValues(resp *proto.GetCollectionsResponse) *GetCollectionsResponseWithDefault {
-	def := &GetCollectionsResponseWithDefault{}
+	def := &GetCollectionsResponseWithDefault{Response: []*CollectionWithDefault{}, NextCursor: resp.GetNextPageToken()}
 	for _, collection := range resp.GetResponse() {
 		collectionDef := &CollectionWithDefault{
 			ID:           int(collection.Id),
@@ -41,6 +49,19 @@ func withDefaultValues(resp *proto.GetCollectionsResponse) *GetCollectionsRespon
 			Goal:         int(collection.Goal),
 			Current:      int(collection.Current),
 			Photo:        collection.Photo,
+			State:        collection.State,
+		}
+		if collection.Deadline != nil {
+			deadline := collection.Deadline.AsTime()
+			collectionDef.Deadline = &deadline
+		}
+		if collection.FundedAt != nil {
+			fundedAt := collection.FundedAt.AsTime()
+			collectionDef.FundedAt = &fundedAt
+		}
+		if collection.ClosedAt != nil {
+			closedAt := collection.ClosedAt.AsTime()
+			collectionDef.ClosedAt = &closedAt
 		}
 		def.Response = append(def.Response, collectionDef)
 	}
@@ -78,7 +99,20 @@ func NewGetCollectionsHandler(log *slog.Logger, charityClient proto.CharityServi
 			return
 		}
 
-		request := proto.GetCollectionsRequest{Category: category}
+		page, err := pagination.FromQuery(r.URL.Query())
+		if err != nil {
+			logger.Warn("Invalid page parameters", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		request := proto.GetCollectionsRequest{
+			Category:  category,
+			States:    r.URL.Query()["state"],
+			PageSize:  page.Limit,
+			PageToken: page.Cursor,
+			Sort:      page.Sort,
+		}
 
 		logger.Info("Fetching collections for category", slog.String("category", request.GetCategory()))
 
@@ -89,6 +123,11 @@ func NewGetCollectionsHandler(log *slog.Logger, charityClient proto.CharityServi
 				json.WriteError(w, http.StatusNotFound, "Collections not found")
 				return
 			}
+			if status.Code(err) == codes.InvalidArgument {
+				logger.Warn("Invalid collections request", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusBadRequest, status.Convert(err).Message())
+				return
+			}
 			logger.Error("Failed to fetch collections", slog.String("error", err.Error()))
 			json.WriteError(w, http.StatusInternalServerError, "Could not retrieve collections")
 			return
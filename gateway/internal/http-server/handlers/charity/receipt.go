@@ -0,0 +1,83 @@
+package charity
+
+import (
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+func NewGetReceiptHandler(log *slog.Logger, purchasesClient proto.PurchasesServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.charity.receipt.New"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing request to get donation receipt")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
+			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+			return
+		}
+
+		donationID, err := strconv.Atoi(chi.URLParam(r, "id"))
+		if err != nil || donationID <= 0 {
+			logger.Warn("Invalid donation id", slog.String("id", chi.URLParam(r, "id")))
+			json.WriteError(w, http.StatusBadRequest, "Invalid donation id")
+			return
+		}
+
+		format, err := responseFormat(r)
+		if err != nil {
+			logger.Warn("Invalid format parameter", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusBadRequest, "Invalid format parameter")
+			return
+		}
+
+		resp, err := purchasesClient.GetDonationReceipt(ctx, &proto.GetDonationReceiptRequest{
+			DonationId: int32(donationID),
+			Pdf:        format == formatPDF,
+		})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				logger.Warn("Receipt not found", slog.Int("donation_id", donationID))
+				json.WriteError(w, http.StatusNotFound, "Receipt not found")
+				return
+			}
+			logger.Error("Failed to retrieve receipt from gRPC service", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusInternalServerError, "Could not retrieve receipt")
+			return
+		}
+
+		logger.Debug("Receipt successfully retrieved", slog.Int("donation_id", donationID), slog.String("format", format))
+		if format == formatPDF {
+			writePDF(w, logger, "receipt-"+resp.GetReceipt().GetNumber()+".pdf", resp.GetPdf())
+			return
+		}
+		json.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"response": fromProtoReceipt(resp.GetReceipt()),
+		})
+	}
+}
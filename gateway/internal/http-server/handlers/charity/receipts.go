@@ -0,0 +1,91 @@
+package charity
+
+import (
+	"fmt"
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	formatJSON = "json"
+	formatPDF  = "pdf"
+)
+
+type Receipt struct {
+	Number       string    `json:"number"`
+	DonationID   int32     `json:"donation_id"`
+	Organization string    `json:"organization"`
+	Collection   string    `json:"collection"`
+	Amount       int32     `json:"amount"`
+	Timestamp    time.Time `json:"timestamp"`
+	Refunded     bool      `json:"refunded"`
+}
+
+type OrganizationDonations struct {
+	Organization string `json:"organization"`
+	Amount       int32  `json:"amount"`
+	Count        int32  `json:"count"`
+}
+
+type Statement struct {
+	Year          int32                   `json:"year"`
+	Total         int32                   `json:"total"`
+	Organizations []OrganizationDonations `json:"organizations"`
+	Receipts      []Receipt               `json:"receipts"`
+}
+
+// responseFormat returns the format requested with the format query parameter, JSON by default.
+func responseFormat(r *http.Request) (string, error) {
+	switch format := r.URL.Query().Get("format"); format {
+	case "", formatJSON:
+		return formatJSON, nil
+	case formatPDF:
+		return formatPDF, nil
+	default:
+		return "", fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+func writePDF(w http.ResponseWriter, logger *slog.Logger, filename string, pdf []byte) {
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(pdf); err != nil {
+		logger.Error("Failed to write PDF", slog.String("error", err.Error()))
+	}
+}
+
+func fromProtoReceipt(receipt *proto.DonationReceipt) Receipt {
+	return Receipt{
+		Number:       receipt.GetNumber(),
+		DonationID:   receipt.GetDonationId(),
+		Organization: receipt.GetOrganization(),
+		Collection:   receipt.GetCollection(),
+		Amount:       receipt.GetAmount(),
+		Timestamp:    receipt.GetTimestamp().AsTime(),
+		Refunded:     receipt.GetRefunded(),
+	}
+}
+
+func fromProtoStatement(statement *proto.DonationStatement) Statement {
+	response := Statement{
+		Year:          statement.GetYear(),
+		Total:         statement.GetTotal(),
+		Organizations: make([]OrganizationDonations, 0, len(statement.GetOrganizations())),
+		Receipts:      make([]Receipt, 0, len(statement.GetReceipts())),
+	}
+	for _, total := range statement.GetOrganizations() {
+		response.Organizations = append(response.Organizations, OrganizationDonations{
+			Organization: total.GetOrganization(),
+			Amount:       total.GetAmount(),
+			Count:        total.GetCount(),
+		})
+	}
+	for _, receipt := range statement.GetReceipts() {
+		response.Receipts = append(response.Receipts, fromProtoReceipt(receipt))
+	}
+	return response
+}
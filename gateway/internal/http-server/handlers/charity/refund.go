@@ -0,0 +1,77 @@
+package charity
+
+import (
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+func NewRefundDonationHandler(log *slog.Logger, charityClient proto.CharityServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.charity.refund.New"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing request to refund donation")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
+			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+			return
+		}
+
+		donationID, err := strconv.Atoi(chi.URLParam(r, "id"))
+		if err != nil || donationID <= 0 {
+			logger.Warn("Invalid donation id", slog.String("id", chi.URLParam(r, "id")))
+			json.WriteError(w, http.StatusBadRequest, "Invalid donation id")
+			return
+		}
+
+		resp, err := charityClient.RefundDonation(ctx, &proto.RefundDonationRequest{DonationId: int32(donationID)})
+		if err != nil {
+			switch status.Code(err) {
+			case codes.PermissionDenied:
+				logger.Warn("User is not allowed to refund donations")
+				json.WriteError(w, http.StatusForbidden, "Not enough permissions")
+			case codes.NotFound:
+				logger.Warn("Donation not found", slog.Int("donation_id", donationID))
+				json.WriteError(w, http.StatusNotFound, "Donation not found")
+			case codes.FailedPrecondition:
+				logger.Warn("Donation cannot be refunded", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusConflict, status.Convert(err).Message())
+			default:
+				logger.Error("Failed to refund donation", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusInternalServerError, "Could not refund donation")
+			}
+			return
+		}
+
+		logger.Debug("Donation successfully refunded", slog.Int("donation_id", donationID))
+		json.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"response": resp.GetResponse(),
+			"status":   resp.GetStatus(),
+		})
+	}
+}
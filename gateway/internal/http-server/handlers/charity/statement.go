@@ -0,0 +1,83 @@
+package charity
+
+import (
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+func NewGetStatementHandler(log *slog.Logger, purchasesClient proto.PurchasesServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.charity.statement.New"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing request to get donation statement")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
+			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+			return
+		}
+
+		year, err := strconv.Atoi(chi.URLParam(r, "year"))
+		if err != nil {
+			logger.Warn("Invalid year", slog.String("year", chi.URLParam(r, "year")))
+			json.WriteError(w, http.StatusBadRequest, "Invalid year")
+			return
+		}
+
+		format, err := responseFormat(r)
+		if err != nil {
+			logger.Warn("Invalid format parameter", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusBadRequest, "Invalid format parameter")
+			return
+		}
+
+		resp, err := purchasesClient.GetDonationStatement(ctx, &proto.GetDonationStatementRequest{
+			Year: int32(year),
+			Pdf:  format == formatPDF,
+		})
+		if err != nil {
+			if status.Code(err) == codes.InvalidArgument {
+				logger.Warn("Invalid statement request", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusBadRequest, status.Convert(err).Message())
+				return
+			}
+			logger.Error("Failed to retrieve statement from gRPC service", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusInternalServerError, "Could not retrieve statement")
+			return
+		}
+
+		logger.Debug("Statement successfully retrieved", slog.Int("year", year), slog.String("format", format))
+		if format == formatPDF {
+			writePDF(w, logger, "donations-"+strconv.Itoa(year)+".pdf", resp.GetPdf())
+			return
+		}
+		json.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"response": fromProtoStatement(resp.GetStatement()),
+		})
+	}
+}
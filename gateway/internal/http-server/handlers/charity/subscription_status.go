@@ -0,0 +1,89 @@
+package charity
+
+import (
+	"context"
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+type subscriptionAction func(ctx context.Context, in *proto.SubscriptionActionRequest, opts ...grpc.CallOption) (*proto.SubscriptionResponse, error)
+
+func NewPauseSubscriptionHandler(log *slog.Logger, charityClient proto.CharityServiceClient) http.HandlerFunc {
+	return newSubscriptionStatusHandler(log, "handler.charity.subscriptions.Pause", "pause", charityClient.PauseSubscription)
+}
+
+func NewResumeSubscriptionHandler(log *slog.Logger, charityClient proto.CharityServiceClient) http.HandlerFunc {
+	return newSubscriptionStatusHandler(log, "handler.charity.subscriptions.Resume", "resume", charityClient.ResumeSubscription)
+}
+
+func NewCancelSubscriptionHandler(log *slog.Logger, charityClient proto.CharityServiceClient) http.HandlerFunc {
+	return newSubscriptionStatusHandler(log, "handler.charity.subscriptions.Cancel", "cancel", charityClient.CancelSubscription)
+}
+
+func newSubscriptionStatusHandler(log *slog.Logger, op string, action string, call subscriptionAction) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing request to " + action + " subscription")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
+			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+			return
+		}
+
+		subscriptionID, err := strconv.Atoi(chi.URLParam(r, "id"))
+		if err != nil || subscriptionID <= 0 {
+			logger.Warn("Invalid subscription id", slog.String("id", chi.URLParam(r, "id")))
+			json.WriteError(w, http.StatusBadRequest, "Invalid subscription id")
+			return
+		}
+
+		resp, err := call(ctx, &proto.SubscriptionActionRequest{SubscriptionId: int32(subscriptionID)})
+		if err != nil {
+			switch status.Code(err) {
+			case codes.NotFound:
+				logger.Warn("Subscription not found", slog.Int("subscription_id", subscriptionID))
+				json.WriteError(w, http.StatusNotFound, "Subscription not found")
+			case codes.FailedPrecondition:
+				logger.Warn("Subscription status cannot be changed", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusConflict, status.Convert(err).Message())
+			default:
+				logger.Error("Failed to "+action+" subscription", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusInternalServerError, "Could not "+action+" subscription")
+			}
+			return
+		}
+
+		logger.Debug("Subscription status changed", slog.Int("subscription_id", subscriptionID), slog.String("status", resp.GetSubscription().GetStatus()))
+		json.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"response":     resp.GetResponse(),
+			"subscription": toSubscription(resp.GetSubscription()),
+		})
+	}
+}
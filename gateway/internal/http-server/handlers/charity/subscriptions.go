@@ -0,0 +1,176 @@
+package charity
+
+import (
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+type Subscription struct {
+	ID           int32      `json:"id"`
+	CollectionID int32      `json:"collection_id"`
+	Amount       int32      `json:"amount"`
+	Status       string     `json:"status"`
+	BillingDay   int32      `json:"billing_day"`
+	NextCharge   *time.Time `json:"next_charge,omitempty"`
+	LastCharged  *time.Time `json:"last_charged,omitempty"`
+	LastError    string     `json:"last_error,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+func toSubscription(subscription *proto.Subscription) Subscription {
+	result := Subscription{
+		ID:           subscription.GetId(),
+		CollectionID: subscription.GetCollectionId(),
+		Amount:       subscription.GetAmount(),
+		Status:       subscription.GetStatus(),
+		BillingDay:   subscription.GetBillingDay(),
+		LastError:    subscription.GetLastError(),
+		CreatedAt:    subscription.GetCreatedAt().AsTime(),
+	}
+	if subscription.GetNextCharge() != nil {
+		nextCharge := subscription.GetNextCharge().AsTime()
+		result.NextCharge = &nextCharge
+	}
+	if subscription.GetLastCharged() != nil {
+		lastCharged := subscription.GetLastCharged().AsTime()
+		result.LastCharged = &lastCharged
+	}
+	return result
+}
+
+func NewCreateSubscriptionHandler(log *slog.Logger, charityClient proto.CharityServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.charity.subscriptions.Create"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing request to create subscription")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client")
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
+			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+			return
+		}
+
+		var request struct {
+			CollectionId int    `json:"collection_id"`
+			Amount       int    `json:"amount"`
+			PaymentToken string `json:"payment_token"`
+		}
+
+		if err := json.ReadJSON(r, &request); err != nil {
+			logger.Error("Failed to parse JSON request", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusBadRequest, "Invalid JSON input")
+			return
+		}
+
+		if request.CollectionId <= 0 {
+			logger.Warn("Invalid collection_id field", slog.Int("collection_id", request.CollectionId))
+			json.WriteError(w, http.StatusBadRequest, "Invalid collection_id field")
+			return
+		}
+
+		if request.Amount <= 0 {
+			logger.Warn("Invalid amount field", slog.Int("amount", request.Amount))
+			json.WriteError(w, http.StatusBadRequest, "Invalid amount field")
+			return
+		}
+
+		resp, err := charityClient.CreateSubscription(ctx, &proto.CreateSubscriptionRequest{
+			CollectionId: int32(request.CollectionId),
+			Amount:       int32(request.Amount),
+			PaymentToken: request.PaymentToken,
+		})
+		if err != nil {
+			switch status.Code(err) {
+			case codes.NotFound:
+				logger.Warn("Collection not found for subscription", slog.Int("collection_id", request.CollectionId))
+				json.WriteError(w, http.StatusNotFound, "Collection not found")
+			case codes.InvalidArgument:
+				logger.Warn("Invalid subscription", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusBadRequest, status.Convert(err).Message())
+			case codes.FailedPrecondition:
+				logger.Warn("Collection is not accepting donations", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusConflict, status.Convert(err).Message())
+			default:
+				logger.Error("Failed to create subscription", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusInternalServerError, "Could not create subscription")
+			}
+			return
+		}
+
+		logger.Info("Subscription created", slog.Int("subscription_id", int(resp.GetSubscription().GetId())))
+		json.WriteJSON(w, http.StatusCreated, map[string]interface{}{
+			"response":     resp.GetResponse(),
+			"subscription": toSubscription(resp.GetSubscription()),
+		})
+	}
+}
+
+func NewGetSubscriptionsHandler(log *slog.Logger, charityClient proto.CharityServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.charity.subscriptions.Get"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing request to get subscriptions")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client")
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
+			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+			return
+		}
+
+		resp, err := charityClient.GetSubscriptions(ctx, &proto.GetSubscriptionsRequest{})
+		if err != nil {
+			logger.Error("Failed to get subscriptions", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusInternalServerError, "Could not get subscriptions")
+			return
+		}
+
+		subscriptions := make([]Subscription, 0, len(resp.GetResponse()))
+		for _, subscription := range resp.GetResponse() {
+			subscriptions = append(subscriptions, toSubscription(subscription))
+		}
+
+		logger.Debug("Subscriptions retrieved", slog.Int("count", len(subscriptions)))
+		json.WriteJSON(w, http.StatusOK, map[string]interface{}{"response": subscriptions})
+	}
+}
@@ -2,14 +2,15 @@ package chat
 
 import (
 	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
 	"github.com/GP-Hacks/kdt2024-commons/json"
 	"github.com/go-chi/chi/v5/middleware"
 	"log/slog"
 	"net/http"
 )
 
-func validateAuthorization(user string) (int, string) {
-	if user == "" {
+func validateAuthorization(authenticated bool) (int, string) {
+	if !authenticated {
 		return http.StatusUnauthorized, "Authorization token is required"
 	}
 	return http.StatusOK, ""
@@ -55,8 +56,8 @@ func NewSendMessageHandler(log *slog.Logger, chatClient proto.ChatServiceClient)
 		default:
 		}
 
-		user := r.Header.Get("Authorization")
-		statusCode, message := validateAuthorization(user)
+		_, authenticated := auth.UserIDFromContext(ctx)
+		statusCode, message := validateAuthorization(authenticated)
 		if statusCode != http.StatusOK {
 			logger.Warn("Authorization validation failed", slog.String("message", message))
 			json.WriteError(w, statusCode, message)
@@ -0,0 +1,12 @@
+package inbox
+
+import "time"
+
+type Notification struct {
+	ID        string    `json:"id"`
+	Header    string    `json:"header"`
+	Content   string    `json:"content"`
+	Category  string    `json:"category,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+	Read      bool      `json:"read"`
+}
@@ -0,0 +1,88 @@
+package inbox
+
+import (
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+func NewListNotificationsHandler(log *slog.Logger, notificationsClient proto.NotificationsServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.inbox.list.New"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing request to list notifications")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
+			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+			return
+		}
+
+		limit := 0
+		if value := r.URL.Query().Get("limit"); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil || parsed <= 0 {
+				logger.Warn("Invalid limit parameter", slog.String("limit", value))
+				json.WriteError(w, http.StatusBadRequest, "Invalid limit parameter")
+				return
+			}
+			limit = parsed
+		}
+
+		resp, err := notificationsClient.ListNotifications(ctx, &proto.ListNotificationsRequest{
+			Limit:  int32(limit),
+			Cursor: r.URL.Query().Get("cursor"),
+		})
+		if err != nil {
+			if status.Code(err) == codes.InvalidArgument {
+				logger.Warn("Invalid inbox request", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusBadRequest, status.Convert(err).Message())
+				return
+			}
+			logger.Error("Failed to retrieve notifications from gRPC service", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusInternalServerError, "Could not retrieve notifications")
+			return
+		}
+
+		notifications := make([]Notification, 0, len(resp.GetResponse()))
+		for _, n := range resp.GetResponse() {
+			notifications = append(notifications, Notification{
+				ID:        n.GetId(),
+				Header:    n.GetHeader(),
+				Content:   n.GetContent(),
+				Category:  n.GetCategory(),
+				Timestamp: n.GetTimestamp().AsTime(),
+				Read:      n.GetRead(),
+			})
+		}
+
+		logger.Debug("Notifications successfully retrieved", slog.Int("count", len(notifications)))
+		json.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"response":    notifications,
+			"next_cursor": resp.GetNextCursor(),
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package inbox
+
+import (
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+)
+
+type MarkReadRequest struct {
+	// IDs of the notifications to mark as read; all notifications when empty.
+	IDs []string `json:"ids"`
+}
+
+func NewMarkReadHandler(log *slog.Logger, notificationsClient proto.NotificationsServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.inbox.read.New"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing request to mark notifications as read")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
+			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+			return
+		}
+
+		var req MarkReadRequest
+		if err := json.ReadJSON(r, &req); err != nil {
+			logger.Error("Failed to parse JSON request", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusBadRequest, "Invalid JSON input")
+			return
+		}
+
+		resp, err := notificationsClient.MarkNotificationsRead(ctx, &proto.MarkNotificationsReadRequest{Ids: req.IDs})
+		if err != nil {
+			if status.Code(err) == codes.InvalidArgument {
+				logger.Warn("Invalid mark as read request", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusBadRequest, status.Convert(err).Message())
+				return
+			}
+			logger.Error("Failed to mark notifications as read", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusInternalServerError, "Could not mark notifications as read")
+			return
+		}
+
+		logger.Debug("Notifications marked as read", slog.Int("updated", int(resp.GetUpdated())))
+		json.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"response": resp.GetResponse(),
+			"updated":  resp.GetUpdated(),
+		})
+	}
+}
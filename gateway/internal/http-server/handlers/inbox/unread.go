@@ -0,0 +1,52 @@
+package inbox
+
+import (
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5/middleware"
+	"log/slog"
+	"net/http"
+)
+
+func NewUnreadCountHandler(log *slog.Logger, notificationsClient proto.NotificationsServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.inbox.unread.New"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing request to get unread notifications count")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
+			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+			return
+		}
+
+		resp, err := notificationsClient.GetUnreadCount(ctx, &proto.GetUnreadCountRequest{})
+		if err != nil {
+			logger.Error("Failed to retrieve unread count from gRPC service", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusInternalServerError, "Could not retrieve unread count")
+			return
+		}
+
+		json.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"count": resp.GetCount(),
+		})
+	}
+}
@@ -2,6 +2,7 @@ package places
 
 import (
 	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
 	"github.com/GP-Hacks/kdt2024-commons/json"
 	"github.com/go-chi/chi/v5/middleware"
 	"google.golang.org/grpc/codes"
@@ -35,9 +36,8 @@ func NewBuyTicketHandler(log *slog.Logger, placesClient proto.PlacesServiceClien
 		default:
 		}
 
-		token := r.Header.Get("Authorization")
-		if token == "" {
-			logger.Warn("Authorization header is missing or empty")
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
 			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
 			return
 		}
@@ -65,9 +65,9 @@ func NewBuyTicketHandler(log *slog.Logger, placesClient proto.PlacesServiceClien
 		}
 
 		protoRequest := &proto.BuyTicketRequest{
-			Token:     token,
-			PlaceId:   int32(request.PlaceId),
-			Timestamp: timestamppb.New(request.Timestamp),
+			PlaceId:        int32(request.PlaceId),
+			Timestamp:      timestamppb.New(request.Timestamp),
+			IdempotencyKey: r.Header.Get("Idempotency-Key"),
 		}
 
 		resp, err := placesClient.BuyTicket(ctx, protoRequest)
@@ -77,6 +77,16 @@ func NewBuyTicketHandler(log *slog.Logger, placesClient proto.PlacesServiceClien
 				json.WriteError(w, http.StatusNotFound, "Place not found")
 				return
 			}
+			if status.Code(err) == codes.FailedPrecondition {
+				logger.Warn("Requested slot is unavailable", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusConflict, status.Convert(err).Message())
+				return
+			}
+			if status.Code(err) == codes.InvalidArgument {
+				logger.Warn("Invalid buy ticket request", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusBadRequest, status.Convert(err).Message())
+				return
+			}
 			logger.Error("Failed to process buy ticket request", slog.String("error", err.Error()))
 			json.WriteError(w, http.StatusInternalServerError, "Could not save your order")
 			return
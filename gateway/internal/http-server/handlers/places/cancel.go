@@ -0,0 +1,74 @@
+package places
+
+import (
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+func NewCancelTicketHandler(log *slog.Logger, placesClient proto.PlacesServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.places.cancel.New"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing request to cancel ticket")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
+			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+			return
+		}
+
+		ticketID, err := strconv.Atoi(chi.URLParam(r, "id"))
+		if err != nil || ticketID <= 0 {
+			logger.Warn("Invalid ticket id", slog.String("id", chi.URLParam(r, "id")))
+			json.WriteError(w, http.StatusBadRequest, "Invalid ticket id")
+			return
+		}
+
+		resp, err := placesClient.CancelTicket(ctx, &proto.CancelTicketRequest{TicketId: int32(ticketID)})
+		if err != nil {
+			switch status.Code(err) {
+			case codes.NotFound:
+				logger.Warn("Ticket not found", slog.Int("ticket_id", ticketID))
+				json.WriteError(w, http.StatusNotFound, "Ticket not found")
+			case codes.FailedPrecondition:
+				logger.Warn("Ticket cannot be cancelled", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusConflict, status.Convert(err).Message())
+			default:
+				logger.Error("Failed to cancel ticket", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusInternalServerError, "Could not cancel ticket")
+			}
+			return
+		}
+
+		logger.Debug("Ticket successfully cancelled", slog.Int("ticket_id", ticketID), slog.Int("refund_amount", int(resp.GetRefundAmount())))
+		json.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"response":      resp.GetResponse(),
+			"refund_amount": resp.GetRefundAmount(),
+		})
+	}
+}
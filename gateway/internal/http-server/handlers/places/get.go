@@ -1,17 +1,22 @@
 package places
 
 import (
+	"errors"
 	"github.com/GP-Hacks/kdt2024-commons/api/proto"
 	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/GP-Hacks/kdt2024-gateway/internal/http-server/pagination"
 	"github.com/go-chi/chi/v5/middleware"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"io"
 	"log/slog"
 	"net/http"
 )
 
 type GetPlacesResponseWithDefault struct {
 	Response []*PlaceWithDefault `json:"response"`
+	// NextCursor is the cursor of the next page, empty on the last page.
+	NextCursor string `json:"next_cursor"`
 }
 
 type PlaceWithDefault struct {
@@ -27,10 +32,11 @@ type PlaceWithDefault struct {
 	Cost        int            `json:"cost"`
 	Times       []string       `json:"times"`
 	Photos      []*proto.Photo `json:"photos"`
+	Distance    float64        `json:"distance"`
 }
 
 func withDefaultValues(resp *proto.GetPlacesResponse) *GetPlacesResponseWithDefault {
-	def := &GetPlacesResponseWithDefault{}
+	def := &GetPlacesResponseWithDefault{Response: []*PlaceWithDefault{}, NextCursor: resp.GetNextPageToken()}
 	for _, place := range resp.GetResponse() {
 		placeDef := &PlaceWithDefault{
 			ID:          int(place.Id),
@@ -45,6 +51,7 @@ func withDefaultValues(resp *proto.GetPlacesResponse) *GetPlacesResponseWithDefa
 			Cost:        int(place.Cost),
 			Times:       place.Times,
 			Photos:      place.Photos,
+			Distance:    place.Distance,
 		}
 		if place.Photos == nil {
 			placeDef.Photos = []*proto.Photo{}
@@ -85,17 +92,35 @@ func NewGetPlacesHandler(log *slog.Logger, placesClient proto.PlacesServiceClien
 			return
 		}
 
-		request := proto.GetPlacesRequest{Category: category}
+		request, err := placesRequest(r)
+		if err != nil {
+			logger.Warn("Invalid places request body", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		request.Category = category
+
+		page, err := pagination.FromQuery(r.URL.Query())
+		if err != nil {
+			logger.Warn("Invalid page parameters", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		request.PageSize, request.PageToken, request.Sort = page.Limit, page.Cursor, page.Sort
 
-		resp, err := placesClient.GetPlaces(ctx, &request)
+		resp, err := placesClient.GetPlaces(ctx, request)
 		if err != nil {
-			if status.Code(err) == codes.NotFound {
+			switch status.Code(err) {
+			case codes.NotFound:
 				logger.Warn("No places found for the given criteria", slog.String("category", request.GetCategory()), slog.Float64("latitude", request.GetLatitude()), slog.Float64("longitude", request.GetLongitude()))
 				json.WriteError(w, http.StatusNotFound, "No places found for the given criteria")
-				return
+			case codes.InvalidArgument:
+				logger.Warn("Invalid places request", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusBadRequest, status.Convert(err).Message())
+			default:
+				logger.Error("Failed to retrieve places from gRPC service", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusInternalServerError, "Could not retrieve places")
 			}
-			logger.Error("Failed to retrieve places from gRPC service", slog.String("error", err.Error()))
-			json.WriteError(w, http.StatusInternalServerError, "Could not retrieve places")
 			return
 		}
 
@@ -104,3 +129,48 @@ func NewGetPlacesHandler(log *slog.Logger, placesClient proto.PlacesServiceClien
 		json.WriteJSON(w, http.StatusOK, response)
 	}
 }
+
+// GetPlacesRequest is the body of the request. Every field is optional, places are sorted by distance from the
+// location when it is given.
+type GetPlacesRequest struct {
+	Latitude    *float64     `json:"latitude"`
+	Longitude   *float64     `json:"longitude"`
+	Radius      float64      `json:"radius"`
+	BoundingBox *BoundingBox `json:"bbox"`
+}
+
+type BoundingBox struct {
+	MinLatitude  float64 `json:"min_latitude"`
+	MinLongitude float64 `json:"min_longitude"`
+	MaxLatitude  float64 `json:"max_latitude"`
+	MaxLongitude float64 `json:"max_longitude"`
+}
+
+// placesRequest reads the body of the request, which may be empty. Coordinate ranges and the page size are checked
+// by the places service.
+func placesRequest(r *http.Request) (*proto.GetPlacesRequest, error) {
+	var body GetPlacesRequest
+	if err := json.ReadJSON(r, &body); err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.New("Invalid JSON input")
+	}
+
+	request := &proto.GetPlacesRequest{Radius: body.Radius}
+	if (body.Latitude == nil) != (body.Longitude == nil) {
+		return nil, errors.New("Latitude and longitude fields must be given together")
+	}
+	if body.Latitude != nil {
+		request.Latitude, request.Longitude = *body.Latitude, *body.Longitude
+	} else if body.Radius != 0 {
+		return nil, errors.New("Radius field requires latitude and longitude")
+	}
+
+	if box := body.BoundingBox; box != nil {
+		request.BoundingBox = &proto.BoundingBox{
+			MinLatitude:  box.MinLatitude,
+			MinLongitude: box.MinLongitude,
+			MaxLatitude:  box.MaxLatitude,
+			MaxLongitude: box.MaxLongitude,
+		}
+	}
+	return request, nil
+}
@@ -0,0 +1,86 @@
+package places
+
+import (
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/skip2/go-qrcode"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+const qrCodeSize = 512
+
+func NewGetTicketQRHandler(log *slog.Logger, placesClient proto.PlacesServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.places.qr.New"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing request to get ticket QR code")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
+			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+			return
+		}
+
+		ticketID, err := strconv.Atoi(chi.URLParam(r, "id"))
+		if err != nil || ticketID <= 0 {
+			logger.Warn("Invalid ticket id", slog.String("id", chi.URLParam(r, "id")))
+			json.WriteError(w, http.StatusBadRequest, "Invalid ticket id")
+			return
+		}
+
+		resp, err := placesClient.GetTicketCode(ctx, &proto.GetTicketCodeRequest{TicketId: int32(ticketID)})
+		if err != nil {
+			switch status.Code(err) {
+			case codes.NotFound:
+				logger.Warn("Ticket not found", slog.Int("ticket_id", ticketID))
+				json.WriteError(w, http.StatusNotFound, "Ticket not found")
+			case codes.FailedPrecondition:
+				logger.Warn("Ticket code is unavailable", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusConflict, status.Convert(err).Message())
+			default:
+				logger.Error("Failed to retrieve ticket code from gRPC service", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusInternalServerError, "Could not retrieve ticket code")
+			}
+			return
+		}
+
+		png, err := qrcode.Encode(resp.GetCode(), qrcode.Medium, qrCodeSize)
+		if err != nil {
+			logger.Error("Failed to render QR code", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusInternalServerError, "Could not render QR code")
+			return
+		}
+
+		logger.Debug("Ticket QR code rendered", slog.Int("ticket_id", ticketID))
+		w.Header().Set("Content-Type", "image/png")
+		w.Header().Set("Cache-Control", "private, no-store")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(png); err != nil {
+			logger.Error("Failed to write QR code", slog.String("error", err.Error()))
+		}
+	}
+}
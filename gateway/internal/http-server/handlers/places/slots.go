@@ -0,0 +1,108 @@
+package places
+
+import (
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type Slot struct {
+	ID        int    `json:"id"`
+	PlaceID   int    `json:"place_id"`
+	Start     string `json:"start"`
+	Capacity  int    `json:"capacity"`
+	Available int    `json:"available"`
+	Cost      int    `json:"cost"`
+}
+
+func NewGetSlotsHandler(log *slog.Logger, placesClient proto.PlacesServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.places.slots.New"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing request to get available slots")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		placeID, err := strconv.Atoi(r.URL.Query().Get("place_id"))
+		if err != nil || placeID <= 0 {
+			logger.Warn("Invalid place_id parameter", slog.String("place_id", r.URL.Query().Get("place_id")))
+			json.WriteError(w, http.StatusBadRequest, "Invalid place_id parameter")
+			return
+		}
+
+		request := &proto.GetAvailableSlotsRequest{PlaceId: int32(placeID)}
+		if from := r.URL.Query().Get("from"); from != "" {
+			fromTime, err := time.Parse(time.RFC3339, from)
+			if err != nil {
+				logger.Warn("Invalid from parameter", slog.String("from", from))
+				json.WriteError(w, http.StatusBadRequest, "Invalid from parameter, RFC3339 expected")
+				return
+			}
+			request.From = timestamppb.New(fromTime)
+		}
+		if to := r.URL.Query().Get("to"); to != "" {
+			toTime, err := time.Parse(time.RFC3339, to)
+			if err != nil {
+				logger.Warn("Invalid to parameter", slog.String("to", to))
+				json.WriteError(w, http.StatusBadRequest, "Invalid to parameter, RFC3339 expected")
+				return
+			}
+			request.To = timestamppb.New(toTime)
+		}
+
+		resp, err := placesClient.GetAvailableSlots(ctx, request)
+		if err != nil {
+			switch status.Code(err) {
+			case codes.NotFound:
+				logger.Warn("Place not found", slog.Int("place_id", placeID))
+				json.WriteError(w, http.StatusNotFound, "Place not found")
+			case codes.InvalidArgument:
+				logger.Warn("Invalid slots request", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusBadRequest, status.Convert(err).Message())
+			default:
+				logger.Error("Failed to retrieve slots from gRPC service", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusInternalServerError, "Could not retrieve slots")
+			}
+			return
+		}
+
+		response := []Slot{}
+		for _, slot := range resp.GetResponse() {
+			response = append(response, Slot{
+				ID:        int(slot.Id),
+				PlaceID:   int(slot.PlaceId),
+				Start:     slot.Start.AsTime().Format(time.RFC3339),
+				Capacity:  int(slot.Capacity),
+				Available: int(slot.Available),
+				Cost:      int(slot.Cost),
+			})
+		}
+
+		logger.Debug("Slots successfully retrieved", slog.Int("count", len(response)))
+		json.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"response": response,
+		})
+	}
+}
@@ -2,7 +2,9 @@ package places
 
 import (
 	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
 	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/GP-Hacks/kdt2024-gateway/internal/http-server/pagination"
 	"github.com/go-chi/chi/v5/middleware"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -11,10 +13,12 @@ import (
 )
 
 type Ticket struct {
-	ID        int    `json:"id"`
-	Name      string `json:"name"`
-	Location  string `json:"location"`
-	EventTime string `json:"event_time"`
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Location     string `json:"location"`
+	EventTime    string `json:"event_time"`
+	Cancelled    bool   `json:"cancelled"`
+	RefundAmount int    `json:"refund_amount"`
 }
 
 func NewGetTicketsHandler(log *slog.Logger, placesClient proto.PlacesServiceClient) http.HandlerFunc {
@@ -40,14 +44,20 @@ func NewGetTicketsHandler(log *slog.Logger, placesClient proto.PlacesServiceClie
 		default:
 		}
 
-		token := r.Header.Get("Authorization")
-		if token == "" {
-			logger.Warn("Authorization header is missing or empty")
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
 			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
 			return
 		}
 
-		request := proto.GetTicketsRequest{Token: token}
+		page, err := pagination.FromQuery(r.URL.Query())
+		if err != nil {
+			logger.Warn("Invalid page parameters", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		request := proto.GetTicketsRequest{PageSize: page.Limit, PageToken: page.Cursor, Sort: page.Sort}
 
 		resp, err := placesClient.GetTickets(ctx, &request)
 		if err != nil {
@@ -56,6 +66,11 @@ func NewGetTicketsHandler(log *slog.Logger, placesClient proto.PlacesServiceClie
 				json.WriteError(w, http.StatusNotFound, "No tickets found")
 				return
 			}
+			if status.Code(err) == codes.InvalidArgument {
+				logger.Warn("Invalid tickets request", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusBadRequest, status.Convert(err).Message())
+				return
+			}
 			logger.Error("Failed to retrieve tickets from gRPC service", slog.String("error", err.Error()))
 			json.WriteError(w, http.StatusInternalServerError, "Could not retrieve tickets")
 			return
@@ -63,17 +78,20 @@ func NewGetTicketsHandler(log *slog.Logger, placesClient proto.PlacesServiceClie
 		var response []Ticket
 		for _, ticket := range resp.GetResponse() {
 			respTicket := Ticket{
-				ID:        int(ticket.Id),
-				Name:      ticket.Name,
-				Location:  ticket.Location,
-				EventTime: ticket.Timestamp.AsTime().Format("2006-01-02 15:04:05"),
+				ID:           int(ticket.Id),
+				Name:         ticket.Name,
+				Location:     ticket.Location,
+				EventTime:    ticket.Timestamp.AsTime().Format("2006-01-02 15:04:05"),
+				Cancelled:    ticket.Cancelled,
+				RefundAmount: int(ticket.RefundAmount),
 			}
 			response = append(response, respTicket)
 		}
 
 		logger.Debug("Places successfully retrieved", slog.Any("response", response))
 		json.WriteJSON(w, http.StatusOK, map[string]interface{}{
-			"response": response,
+			"response":    response,
+			"next_cursor": resp.GetNextPageToken(),
 		})
 	}
 }
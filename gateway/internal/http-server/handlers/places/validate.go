@@ -0,0 +1,98 @@
+package places
+
+import (
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+)
+
+func NewValidateTicketHandler(log *slog.Logger, placesClient proto.PlacesServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.places.validate.New"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing validate ticket request")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
+			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+			return
+		}
+
+		var request struct {
+			Code    string `json:"code"`
+			PlaceId int    `json:"place_id"`
+		}
+		if err := json.ReadJSON(r, &request); err != nil {
+			logger.Error("Failed to parse JSON input", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusBadRequest, "Invalid JSON input")
+			return
+		}
+
+		if request.Code == "" {
+			logger.Warn("Missing code field")
+			json.WriteError(w, http.StatusBadRequest, "Missing code field")
+			return
+		}
+
+		if request.PlaceId <= 0 {
+			logger.Warn("Invalid place_id field", slog.Int("place_id", request.PlaceId))
+			json.WriteError(w, http.StatusBadRequest, "Invalid place_id field")
+			return
+		}
+
+		resp, err := placesClient.ValidateTicket(ctx, &proto.ValidateTicketRequest{
+			Code:    request.Code,
+			PlaceId: int32(request.PlaceId),
+		})
+		if err != nil {
+			switch status.Code(err) {
+			case codes.PermissionDenied:
+				logger.Warn("User is not allowed to validate tickets")
+				json.WriteError(w, http.StatusForbidden, "Not enough permissions")
+			case codes.InvalidArgument:
+				logger.Warn("Invalid ticket code", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusBadRequest, status.Convert(err).Message())
+			case codes.NotFound:
+				logger.Warn("Ticket not found")
+				json.WriteError(w, http.StatusNotFound, "Ticket not found")
+			case codes.FailedPrecondition:
+				logger.Warn("Ticket rejected", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusConflict, status.Convert(err).Message())
+			default:
+				logger.Error("Failed to validate ticket", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusInternalServerError, "Could not validate ticket")
+			}
+			return
+		}
+
+		logger.Debug("Ticket successfully validated", slog.Int("ticket_id", int(resp.GetTicketId())))
+		json.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"response":   resp.GetResponse(),
+			"ticket_id":  resp.GetTicketId(),
+			"name":       resp.GetName(),
+			"event_time": resp.GetTimestamp().AsTime().Format("2006-01-02 15:04:05"),
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package preferences
+
+import (
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5/middleware"
+	"log/slog"
+	"net/http"
+)
+
+func NewGetPreferencesHandler(log *slog.Logger, notificationsClient proto.NotificationsServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.preferences.get.New"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing request to get notification preferences")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
+			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+			return
+		}
+
+		resp, err := notificationsClient.GetPreferences(ctx, &proto.GetPreferencesRequest{})
+		if err != nil {
+			logger.Error("Failed to retrieve preferences from gRPC service", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusInternalServerError, "Could not retrieve preferences")
+			return
+		}
+
+		logger.Debug("Preferences successfully retrieved")
+		json.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"response": fromProto(resp.GetPreferences()),
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package preferences
+
+import "github.com/GP-Hacks/kdt2024-commons/api/proto"
+
+type Preferences struct {
+	Tickets         bool     `json:"tickets"`
+	Donations       bool     `json:"donations"`
+	Votes           bool     `json:"votes"`
+	QuietHoursStart string   `json:"quiet_hours_start"`
+	QuietHoursEnd   string   `json:"quiet_hours_end"`
+	Timezone        string   `json:"timezone"`
+	Language        string   `json:"language"`
+	Channels        []string `json:"channels"`
+	Email           string   `json:"email"`
+}
+
+func fromProto(preferences *proto.NotificationPreferences) Preferences {
+	channels := preferences.GetChannels()
+	if channels == nil {
+		channels = []string{}
+	}
+	return Preferences{
+		Tickets:         preferences.GetTickets(),
+		Donations:       preferences.GetDonations(),
+		Votes:           preferences.GetVotes(),
+		QuietHoursStart: preferences.GetQuietHoursStart(),
+		QuietHoursEnd:   preferences.GetQuietHoursEnd(),
+		Timezone:        preferences.GetTimezone(),
+		Language:        preferences.GetLanguage(),
+		Channels:        channels,
+		Email:           preferences.GetEmail(),
+	}
+}
+
+func (p Preferences) toProto() *proto.NotificationPreferences {
+	return &proto.NotificationPreferences{
+		Tickets:         p.Tickets,
+		Donations:       p.Donations,
+		Votes:           p.Votes,
+		QuietHoursStart: p.QuietHoursStart,
+		QuietHoursEnd:   p.QuietHoursEnd,
+		Timezone:        p.Timezone,
+		Language:        p.Language,
+		Channels:        p.Channels,
+		Email:           p.Email,
+	}
+}
@@ -0,0 +1,68 @@
+package preferences
+
+import (
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+)
+
+func NewUpdatePreferencesHandler(log *slog.Logger, notificationsClient proto.NotificationsServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.preferences.update.New"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing request to update notification preferences")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
+			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+			return
+		}
+
+		var request Preferences
+		if err := json.ReadJSON(r, &request); err != nil {
+			logger.Error("Failed to parse JSON input", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusBadRequest, "Invalid JSON input")
+			return
+		}
+
+		resp, err := notificationsClient.UpdatePreferences(ctx, &proto.UpdatePreferencesRequest{Preferences: request.toProto()})
+		if err != nil {
+			if status.Code(err) == codes.InvalidArgument {
+				logger.Warn("Invalid preferences", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusBadRequest, status.Convert(err).Message())
+				return
+			}
+			logger.Error("Failed to update preferences", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusInternalServerError, "Could not update preferences")
+			return
+		}
+
+		logger.Debug("Preferences successfully updated")
+		json.WriteJSON(w, http.StatusOK, map[string]interface{}{
+			"response":    resp.GetResponse(),
+			"preferences": fromProto(resp.GetPreferences()),
+		})
+	}
+}
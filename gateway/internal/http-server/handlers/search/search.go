@@ -0,0 +1,137 @@
+package search
+
+import (
+	"context"
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+type Result struct {
+	ID       int     `json:"id"`
+	Category string  `json:"category"`
+	Name     string  `json:"name"`
+	Photo    string  `json:"photo"`
+	Headline string  `json:"headline"`
+	Rank     float64 `json:"rank"`
+}
+
+type Response struct {
+	Places      []Result `json:"places"`
+	Collections []Result `json:"collections"`
+	Votes       []Result `json:"votes"`
+	// Unavailable lists the sections whose service failed, they are empty.
+	Unavailable []string `json:"unavailable"`
+}
+
+type searchFunc func(ctx context.Context, in *proto.SearchRequest, opts ...grpc.CallOption) (*proto.SearchResponse, error)
+
+// section is the search of one service.
+type section struct {
+	name    string
+	search  searchFunc
+	results *[]Result
+	err     error
+}
+
+// NewSearchHandler searches places, collections and votes at once. A service that fails only leaves its section
+// empty, the request fails when every service does or the query is invalid.
+func NewSearchHandler(log *slog.Logger, placesClient proto.PlacesServiceClient, charityClient proto.CharityServiceClient, votesClient proto.VotesServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.search.New"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing search request")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		request := &proto.SearchRequest{Query: r.URL.Query().Get("q")}
+		if request.Query == "" {
+			logger.Warn("Missing q parameter")
+			json.WriteError(w, http.StatusBadRequest, "Invalid q parameter")
+			return
+		}
+		if limit := r.URL.Query().Get("limit"); limit != "" {
+			value, err := strconv.ParseInt(limit, 10, 32)
+			if err != nil || value <= 0 {
+				logger.Warn("Invalid limit parameter", slog.String("limit", limit))
+				json.WriteError(w, http.StatusBadRequest, "Invalid limit parameter")
+				return
+			}
+			request.Limit = int32(value)
+		}
+
+		response := &Response{Places: []Result{}, Collections: []Result{}, Votes: []Result{}, Unavailable: []string{}}
+		sections := []*section{
+			{name: "places", search: placesClient.Search, results: &response.Places},
+			{name: "collections", search: charityClient.Search, results: &response.Collections},
+			{name: "votes", search: votesClient.Search, results: &response.Votes},
+		}
+
+		var wg sync.WaitGroup
+		for _, s := range sections {
+			wg.Add(1)
+			go func(s *section) {
+				defer wg.Done()
+				resp, err := s.search(ctx, request)
+				if err != nil {
+					s.err = err
+					return
+				}
+				for _, result := range resp.GetResults() {
+					*s.results = append(*s.results, Result{
+						ID:       int(result.GetId()),
+						Category: result.GetCategory(),
+						Name:     result.GetName(),
+						Photo:    result.GetPhoto(),
+						Headline: result.GetHeadline(),
+						Rank:     result.GetRank(),
+					})
+				}
+			}(s)
+		}
+		wg.Wait()
+
+		for _, s := range sections {
+			if s.err == nil {
+				continue
+			}
+			// The services validate queries alike, so an invalid query fails every section.
+			if status.Code(s.err) == codes.InvalidArgument {
+				logger.Warn("Invalid search request", slog.String("error", s.err.Error()))
+				json.WriteError(w, http.StatusBadRequest, status.Convert(s.err).Message())
+				return
+			}
+			logger.Error("Failed to search "+s.name, slog.String("error", s.err.Error()))
+			response.Unavailable = append(response.Unavailable, s.name)
+		}
+		if len(response.Unavailable) == len(sections) {
+			json.WriteError(w, http.StatusInternalServerError, "Could not search")
+			return
+		}
+
+		logger.Debug("Search completed", slog.Int("places", len(response.Places)), slog.Int("collections", len(response.Collections)), slog.Int("votes", len(response.Votes)))
+		json.WriteJSON(w, http.StatusOK, response)
+	}
+}
@@ -1,18 +1,23 @@
 package tokens
 
 import (
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
 	"github.com/GP-Hacks/kdt2024-commons/json"
-	"github.com/GP-Hacks/kdt2024-gateway/internal/storage"
 	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"log/slog"
 	"net/http"
 )
 
 type TokenRequest struct {
-	Token string `json:"token"`
+	Token      string `json:"token"`
+	Platform   string `json:"platform,omitempty"`
+	AppVersion string `json:"app_version,omitempty"`
 }
 
-func NewAddTokenHandler(log *slog.Logger) http.HandlerFunc {
+func NewAddTokenHandler(log *slog.Logger, notificationsClient proto.NotificationsServiceClient) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		const op = "handler.tokens.add.New"
 		ctx := r.Context()
@@ -34,9 +39,9 @@ func NewAddTokenHandler(log *slog.Logger) http.HandlerFunc {
 		default:
 		}
 
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" {
-			logger.Warn("Authorization header is missing")
+		userID, ok := auth.UserIDFromContext(ctx)
+		if !ok {
+			logger.Warn("Request is not authenticated")
 			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
 			return
 		}
@@ -54,10 +59,18 @@ func NewAddTokenHandler(log *slog.Logger) http.HandlerFunc {
 			return
 		}
 
-		userID := authHeader
-		err := storage.AddUserToken(userID, tokenReq.Token)
+		_, err := notificationsClient.RegisterToken(ctx, &proto.RegisterTokenRequest{
+			Token:      tokenReq.Token,
+			Platform:   tokenReq.Platform,
+			AppVersion: tokenReq.AppVersion,
+		})
 		if err != nil {
-			logger.Error("Failed to add token to storage", slog.String("error", err.Error()), slog.String("user_id", userID))
+			if status.Code(err) == codes.InvalidArgument {
+				logger.Warn("Invalid token", slog.String("error", err.Error()), slog.String("user_id", userID))
+				json.WriteError(w, http.StatusBadRequest, status.Convert(err).Message())
+				return
+			}
+			logger.Error("Failed to register token", slog.String("error", err.Error()), slog.String("user_id", userID))
 			json.WriteError(w, http.StatusInternalServerError, "Failed to save token")
 			return
 		}
@@ -0,0 +1,76 @@
+package tokens
+
+import (
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+)
+
+func NewDeleteTokenHandler(log *slog.Logger, notificationsClient proto.NotificationsServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.tokens.delete.New"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing request to delete token")
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		userID, ok := auth.UserIDFromContext(ctx)
+		if !ok {
+			logger.Warn("Request is not authenticated")
+			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+			return
+		}
+
+		var tokenReq TokenRequest
+		if err := json.ReadJSON(r, &tokenReq); err != nil {
+			logger.Error("Failed to parse JSON request", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusBadRequest, "Invalid JSON input")
+			return
+		}
+
+		if tokenReq.Token == "" {
+			logger.Warn("Token field is missing in the request")
+			json.WriteError(w, http.StatusBadRequest, "Invalid token field")
+			return
+		}
+
+		_, err := notificationsClient.DeleteToken(ctx, &proto.DeleteTokenRequest{Token: tokenReq.Token})
+		if err != nil {
+			switch status.Code(err) {
+			case codes.NotFound:
+				logger.Warn("Token not found", slog.String("user_id", userID))
+				json.WriteError(w, http.StatusNotFound, "Token not found")
+			case codes.InvalidArgument:
+				logger.Warn("Invalid token", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusBadRequest, status.Convert(err).Message())
+			default:
+				logger.Error("Failed to delete token", slog.String("error", err.Error()), slog.String("user_id", userID))
+				json.WriteError(w, http.StatusInternalServerError, "Failed to delete token")
+			}
+			return
+		}
+
+		response := map[string]string{"response": "Token deleted successfully"}
+		logger.Info("Token deleted successfully", slog.String("user_id", userID))
+		json.WriteJSON(w, http.StatusOK, response)
+	}
+}
@@ -0,0 +1,28 @@
+package votes
+
+import (
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+)
+
+// writeBallotError writes the error of casting a ballot. The messages of ballots the votes service rejected, like an
+// unknown option or an ended vote, are shown to the user.
+func writeBallotError(w http.ResponseWriter, logger *slog.Logger, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		logger.Warn("Invalid ballot", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusBadRequest, status.Convert(err).Message())
+	case codes.NotFound:
+		logger.Warn("Vote not found", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusNotFound, "Vote not found")
+	case codes.FailedPrecondition:
+		logger.Warn("Ballot rejected", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusConflict, status.Convert(err).Message())
+	default:
+		logger.Error("Failed to record vote", slog.String("error", err.Error()))
+		json.WriteError(w, http.StatusInternalServerError, "Could not record vote")
+	}
+}
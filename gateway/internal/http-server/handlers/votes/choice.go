@@ -3,10 +3,9 @@ package votes
 import (
 	"fmt"
 	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
 	"github.com/GP-Hacks/kdt2024-commons/json"
 	"github.com/go-chi/chi/v5/middleware"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"log/slog"
 	"net/http"
 	"time"
@@ -74,9 +73,8 @@ func NewVoteChoiceHandler(log *slog.Logger, votesClient proto.VotesServiceClient
 		default:
 		}
 
-		token := r.Header.Get("Authorization")
-		if token == "" {
-			logger.Warn("Missing Authorization header")
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
 			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
 			return
 		}
@@ -89,28 +87,20 @@ func NewVoteChoiceHandler(log *slog.Logger, votesClient proto.VotesServiceClient
 		}
 
 		if request.GetVoteId() == 0 {
-			logger.Warn("Invalid vote_id field in request", slog.String("request_payload", fmt.Sprintf("%+v", request)))
+			logger.Warn("Invalid vote_id field in request", slog.String("request_payload", fmt.Sprintf("%+v", &request)))
 			json.WriteError(w, http.StatusBadRequest, "Invalid vote_id field")
 			return
 		}
 
 		if request.GetChoice() == "" {
-			logger.Warn("Invalid choice field in request", slog.String("request_payload", fmt.Sprintf("%+v", request)))
+			logger.Warn("Invalid choice field in request", slog.String("request_payload", fmt.Sprintf("%+v", &request)))
 			json.WriteError(w, http.StatusBadRequest, "Invalid choice field")
 			return
 		}
 
-		request.Token = token
-
 		_, err := votesClient.VoteChoice(ctx, &request)
 		if err != nil {
-			if status.Code(err) == codes.NotFound {
-				logger.Warn("Vote choice not found", slog.String("error", err.Error()), slog.Any("request", request))
-				json.WriteError(w, http.StatusNotFound, "Choice not found")
-				return
-			}
-			logger.Error("Failed to record vote", slog.String("error", err.Error()), slog.Any("request", request))
-			json.WriteError(w, http.StatusInternalServerError, "Could not record vote")
+			writeBallotError(w, logger.With(slog.Any("request", &request)), err)
 			return
 		}
 
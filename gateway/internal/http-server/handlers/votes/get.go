@@ -1,8 +1,10 @@
 package votes
 
 import (
+	"context"
 	"github.com/GP-Hacks/kdt2024-commons/api/proto"
 	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/GP-Hacks/kdt2024-gateway/internal/http-server/pagination"
 	"github.com/go-chi/chi/v5/middleware"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,6 +16,8 @@ import (
 
 type GetVotesResponseWithDefault struct {
 	Response []*VoteWithDefault `json:"response"`
+	// NextCursor is the cursor of the next page, empty on the last page.
+	NextCursor string `json:"next_cursor"`
 }
 
 type VoteWithDefault struct {
@@ -32,7 +36,7 @@ type VoteResponse struct {
 }
 
 func withDefaultVoteValues(resp *proto.GetVotesResponse) *GetVotesResponseWithDefault {
-	def := &GetVotesResponseWithDefault{}
+	def := &GetVotesResponseWithDefault{Response: []*VoteWithDefault{}, NextCursor: resp.GetNextPageToken()}
 	for _, vote := range resp.GetResponse() {
 		voteDef := &VoteWithDefault{
 			ID:           int(vote.Id),
@@ -83,8 +87,20 @@ func NewGetVotesHandler(log *slog.Logger, votesClient proto.VotesServiceClient)
 			return
 		}
 
-		resp, err := votesClient.GetVotes(ctx, &proto.GetVotesRequest{Category: category})
+		page, err := pagination.FromQuery(r.URL.Query())
 		if err != nil {
+			logger.Warn("Invalid page parameters", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		resp, err := votesClient.GetVotes(ctx, &proto.GetVotesRequest{Category: category, PageSize: page.Limit, PageToken: page.Cursor, Sort: page.Sort})
+		if err != nil {
+			if status.Code(err) == codes.InvalidArgument {
+				logger.Warn("Invalid votes request", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusBadRequest, status.Convert(err).Message())
+				return
+			}
 			logger.Error("Failed to retrieve votes", slog.String("error", err.Error()))
 			json.WriteError(w, http.StatusInternalServerError, "Failed to retrieve votes")
 			return
@@ -96,6 +112,26 @@ func NewGetVotesHandler(log *slog.Logger, votesClient proto.VotesServiceClient)
 	}
 }
 
+// findVote looks for the vote in every page of votes, nil when there is no such vote.
+func findVote(ctx context.Context, votesClient proto.VotesServiceClient, voteID int32) (*proto.Vote, error) {
+	request := &proto.GetVotesRequest{Category: "all", PageSize: 100}
+	for {
+		resp, err := votesClient.GetVotes(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, vote := range resp.GetResponse() {
+			if vote.Id == voteID {
+				return vote, nil
+			}
+		}
+		if resp.GetNextPageToken() == "" {
+			return nil, nil
+		}
+		request.PageToken = resp.GetNextPageToken()
+	}
+}
+
 func NewGetVoteInfoHandler(log *slog.Logger, votesClient proto.VotesServiceClient) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		const op = "handler.votes.getVoteInfo.New"
@@ -141,21 +177,12 @@ func NewGetVoteInfoHandler(log *slog.Logger, votesClient proto.VotesServiceClien
 			return
 		}
 
-		votesResp, err := votesClient.GetVotes(ctx, &proto.GetVotesRequest{Category: "all"})
+		voteResp, err := findVote(ctx, votesClient, int32(voteId))
 		if err != nil {
 			logger.Error("Failed to retrieve votes", slog.String("error", err.Error()))
 			json.WriteError(w, http.StatusInternalServerError, "Failed to retrieve votes")
 			return
 		}
-		var voteResp *proto.Vote
-		for _, vote := range votesResp.GetResponse() {
-			if vote.Id == int32(voteId) {
-				voteResp = vote
-				break
-			}
-		}
-
-		token := r.Header.Get("Authorization")
 
 		if voteResp == nil {
 			logger.Warn("Vote not found", slog.Int("vote_id", int(voteId)))
@@ -166,7 +193,7 @@ func NewGetVoteInfoHandler(log *slog.Logger, votesClient proto.VotesServiceClien
 		var detailedResp interface{}
 		switch voteResp.Category {
 		case "choice":
-			choiceResp, err := votesClient.GetChoiceInfo(ctx, &proto.GetVoteInfoRequest{VoteId: int32(voteId), Token: token})
+			choiceResp, err := votesClient.GetChoiceInfo(ctx, &proto.GetVoteInfoRequest{VoteId: int32(voteId)})
 			if err != nil {
 				logger.Error("Failed to retrieve choice info", slog.String("error", err.Error()))
 				json.WriteError(w, http.StatusInternalServerError, "Failed to retrieve choice info")
@@ -174,15 +201,31 @@ func NewGetVoteInfoHandler(log *slog.Logger, votesClient proto.VotesServiceClien
 			}
 			detailedResp = withDefaultChoiceInfo(choiceResp)
 		case "petition":
-			petitionResp, err := votesClient.GetPetitionInfo(ctx, &proto.GetVoteInfoRequest{VoteId: int32(voteId), Token: token})
+			petitionResp, err := votesClient.GetPetitionInfo(ctx, &proto.GetVoteInfoRequest{VoteId: int32(voteId)})
 			if err != nil {
 				logger.Error("Failed to retrieve petition info", slog.String("error", err.Error()))
 				json.WriteError(w, http.StatusInternalServerError, "Failed to retrieve petition info")
 				return
 			}
 			detailedResp = withDefaultPetitionInfo(petitionResp)
+		case "multiselect":
+			multiSelectResp, err := votesClient.GetMultiSelectInfo(ctx, &proto.GetVoteInfoRequest{VoteId: int32(voteId)})
+			if err != nil {
+				logger.Error("Failed to retrieve multiselect info", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusInternalServerError, "Failed to retrieve multiselect info")
+				return
+			}
+			detailedResp = withDefaultMultiSelectInfo(multiSelectResp)
+		case "ranked":
+			rankedResp, err := votesClient.GetRankedInfo(ctx, &proto.GetVoteInfoRequest{VoteId: int32(voteId)})
+			if err != nil {
+				logger.Error("Failed to retrieve ranked info", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusInternalServerError, "Failed to retrieve ranked info")
+				return
+			}
+			detailedResp = withDefaultRankedInfo(rankedResp)
 		case "rate":
-			rateResp, err := votesClient.GetRateInfo(ctx, &proto.GetVoteInfoRequest{VoteId: int32(voteId), Token: token})
+			rateResp, err := votesClient.GetRateInfo(ctx, &proto.GetVoteInfoRequest{VoteId: int32(voteId)})
 			if err != nil {
 				logger.Error("Failed to retrieve rate info", slog.String("error", err.Error()))
 				json.WriteError(w, http.StatusInternalServerError, "Failed to retrieve rate info")
@@ -0,0 +1,121 @@
+package votes
+
+import (
+	"fmt"
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5/middleware"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+type GetMultiSelectInfoResponseWithDefault struct {
+	Response *MultiSelectInfoWithDefault `json:"response"`
+}
+
+type MultiSelectInfoWithDefault struct {
+	ID            int            `json:"id"`
+	Category      string         `json:"category"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	Organization  string         `json:"organization"`
+	End           string         `json:"end"`
+	Photo         string         `json:"photo"`
+	Options       []string       `json:"options"`
+	MinSelections int            `json:"min_selections"`
+	MaxSelections int            `json:"max_selections"`
+	Stats         map[string]int `json:"stats"`
+	Voters        int            `json:"voters"`
+	Selected      []string       `json:"selected"`
+}
+
+func withDefaultMultiSelectInfo(resp *proto.GetMultiSelectInfoResponse) *GetMultiSelectInfoResponseWithDefault {
+	stats := make(map[string]int)
+	for _, opt := range resp.GetResponse().Options {
+		stats[opt] = int(resp.GetResponse().Stats[opt])
+	}
+	selected := resp.GetResponse().Selected
+	if selected == nil {
+		selected = []string{}
+	}
+
+	return &GetMultiSelectInfoResponseWithDefault{
+		Response: &MultiSelectInfoWithDefault{
+			ID:            int(resp.GetResponse().Id),
+			Category:      resp.GetResponse().Category,
+			Name:          resp.GetResponse().Name,
+			Description:   resp.GetResponse().Description,
+			Organization:  resp.GetResponse().Organization,
+			End:           resp.GetResponse().End.AsTime().Format(time.RFC3339),
+			Photo:         resp.GetResponse().Photo,
+			Options:       resp.GetResponse().Options,
+			MinSelections: int(resp.GetResponse().MinSelections),
+			MaxSelections: int(resp.GetResponse().MaxSelections),
+			Stats:         stats,
+			Voters:        int(resp.GetResponse().Voters),
+			Selected:      selected,
+		},
+	}
+}
+
+func NewVoteMultiSelectHandler(log *slog.Logger, votesClient proto.VotesServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.votes.voteMultiSelect.New"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing vote multiselect request")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
+			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+			return
+		}
+
+		var request proto.VoteMultiSelectRequest
+		if err := json.ReadJSON(r, &request); err != nil {
+			logger.Error("Failed to parse JSON input", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusBadRequest, "Invalid JSON input")
+			return
+		}
+
+		if request.GetVoteId() == 0 {
+			logger.Warn("Invalid vote_id field in request", slog.String("request_payload", fmt.Sprintf("%+v", &request)))
+			json.WriteError(w, http.StatusBadRequest, "Invalid vote_id field")
+			return
+		}
+
+		if len(request.GetOptions()) == 0 {
+			logger.Warn("Invalid options field in request", slog.String("request_payload", fmt.Sprintf("%+v", &request)))
+			json.WriteError(w, http.StatusBadRequest, "Invalid options field")
+			return
+		}
+
+		_, err := votesClient.VoteMultiSelect(ctx, &request)
+		if err != nil {
+			writeBallotError(w, logger.With(slog.Any("request", &request)), err)
+			return
+		}
+
+		response := map[string]string{"response": "Vote recorded successfully"}
+		logger.Info("Vote recorded successfully", slog.Any("response", response))
+		json.WriteJSON(w, http.StatusOK, response)
+	}
+}
@@ -2,8 +2,12 @@ package votes
 
 import (
 	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
 	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/GP-Hacks/kdt2024-gateway/internal/http-server/pagination"
 	"github.com/go-chi/chi/v5/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"log/slog"
 	"net/http"
 	"time"
@@ -24,27 +28,103 @@ type PetitionInfoWithDefault struct {
 	Options      []string       `json:"options"`
 	Stats        map[string]int `json:"stats"`
 	Support      string         `json:"support,omitempty"`
+
+	SignatureThreshold int    `json:"signature_threshold"`
+	Signatures         int    `json:"signatures"`
+	State              string `json:"state"`
+	OfficialResponse   string `json:"official_response"`
+	StateChangedAt     string `json:"state_changed_at,omitempty"`
+}
+
+type GetPetitionsResponseWithDefault struct {
+	Response []*PetitionInfoWithDefault `json:"response"`
+	// NextCursor is the cursor of the next page, empty on the last page.
+	NextCursor string `json:"next_cursor"`
 }
 
 func withDefaultPetitionInfo(resp *proto.GetPetitionInfoResponse) *GetPetitionInfoResponseWithDefault {
+	return &GetPetitionInfoResponseWithDefault{Response: toPetitionInfo(resp.GetResponse())}
+}
+
+func toPetitionInfo(petition *proto.PetitionInfo) *PetitionInfoWithDefault {
 	stats := make(map[string]int)
-	for k, v := range resp.GetResponse().Stats {
+	for k, v := range petition.Stats {
 		stats[k] = int(v)
 	}
 
-	return &GetPetitionInfoResponseWithDefault{
-		Response: &PetitionInfoWithDefault{
-			ID:           int(resp.GetResponse().Id),
-			Category:     resp.GetResponse().Category,
-			Name:         resp.GetResponse().Name,
-			Description:  resp.GetResponse().Description,
-			Organization: resp.GetResponse().Organization,
-			End:          resp.GetResponse().End.AsTime().Format(time.RFC3339),
-			Photo:        resp.GetResponse().Photo,
-			Options:      resp.GetResponse().Options,
-			Stats:        stats,
-			Support:      resp.GetResponse().Support,
-		},
+	info := &PetitionInfoWithDefault{
+		ID:                 int(petition.Id),
+		Category:           petition.Category,
+		Name:               petition.Name,
+		Description:        petition.Description,
+		Organization:       petition.Organization,
+		End:                petition.End.AsTime().Format(time.RFC3339),
+		Photo:              petition.Photo,
+		Options:            petition.Options,
+		Stats:              stats,
+		Support:            petition.Support,
+		SignatureThreshold: int(petition.SignatureThreshold),
+		Signatures:         int(petition.Signatures),
+		State:              petition.State,
+		OfficialResponse:   petition.OfficialResponse,
+	}
+	if info.Options == nil {
+		info.Options = []string{}
+	}
+	if petition.StateChangedAt != nil {
+		info.StateChangedAt = petition.StateChangedAt.AsTime().Format(time.RFC3339)
+	}
+	return info
+}
+
+func NewGetPetitionsHandler(log *slog.Logger, votesClient proto.VotesServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.votes.getPetitions.New"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Received request to get petitions")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		page, err := pagination.FromQuery(r.URL.Query())
+		if err != nil {
+			logger.Warn("Invalid page parameters", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		resp, err := votesClient.GetPetitions(ctx, &proto.GetPetitionsRequest{State: r.URL.Query().Get("state"), PageSize: page.Limit, PageToken: page.Cursor})
+		if err != nil {
+			if status.Code(err) == codes.InvalidArgument {
+				logger.Warn("Invalid petitions request", slog.String("error", err.Error()))
+				json.WriteError(w, http.StatusBadRequest, status.Convert(err).Message())
+				return
+			}
+			logger.Error("Failed to retrieve petitions", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusInternalServerError, "Failed to retrieve petitions")
+			return
+		}
+
+		response := &GetPetitionsResponseWithDefault{Response: []*PetitionInfoWithDefault{}, NextCursor: resp.GetNextPageToken()}
+		for _, petition := range resp.GetResponse() {
+			response.Response = append(response.Response, toPetitionInfo(petition))
+		}
+		json.WriteJSON(w, http.StatusOK, response)
+		logger.Debug("Petitions retrieved successfully", slog.Int("count", len(response.Response)))
 	}
 }
 
@@ -71,9 +151,8 @@ func NewVotePetitionHandler(log *slog.Logger, votesClient proto.VotesServiceClie
 		default:
 		}
 
-		token := r.Header.Get("Authorization")
-		if token == "" {
-			logger.Warn("Missing authorization token")
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
 			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
 			return
 		}
@@ -86,23 +165,20 @@ func NewVotePetitionHandler(log *slog.Logger, votesClient proto.VotesServiceClie
 		}
 
 		if request.GetVoteId() == 0 {
-			logger.Warn("Invalid or missing vote_id field", slog.Any("request", request))
+			logger.Warn("Invalid or missing vote_id field", slog.Any("request", &request))
 			json.WriteError(w, http.StatusBadRequest, "Invalid vote_id field")
 			return
 		}
 
 		if request.GetSupport() == "" {
-			logger.Warn("Invalid or missing support field", slog.Any("request", request))
+			logger.Warn("Invalid or missing support field", slog.Any("request", &request))
 			json.WriteError(w, http.StatusBadRequest, "Invalid support field")
 			return
 		}
 
-		request.Token = token
-
 		resp, err := votesClient.VotePetition(ctx, &request)
 		if err != nil {
-			logger.Error("Failed to record vote", slog.String("error", err.Error()))
-			json.WriteError(w, http.StatusInternalServerError, "Could not record vote")
+			writeBallotError(w, logger, err)
 			return
 		}
 
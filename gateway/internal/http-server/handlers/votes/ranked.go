@@ -0,0 +1,134 @@
+package votes
+
+import (
+	"fmt"
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5/middleware"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+type GetRankedInfoResponseWithDefault struct {
+	Response *RankedInfoWithDefault `json:"response"`
+}
+
+type RankedInfoWithDefault struct {
+	ID           int                  `json:"id"`
+	Category     string               `json:"category"`
+	Name         string               `json:"name"`
+	Description  string               `json:"description"`
+	Organization string               `json:"organization"`
+	End          string               `json:"end"`
+	Photo        string               `json:"photo"`
+	Options      []string             `json:"options"`
+	Ballots      int                  `json:"ballots"`
+	Rounds       []*RankedRoundResult `json:"rounds"`
+	Winner       string               `json:"winner"`
+	Ranking      []string             `json:"ranking"`
+}
+
+// RankedRoundResult is a round of the instant-runoff count of a ranked vote.
+type RankedRoundResult struct {
+	Counts     map[string]int `json:"counts"`
+	Eliminated string         `json:"eliminated,omitempty"`
+	Exhausted  int            `json:"exhausted"`
+}
+
+func withDefaultRankedInfo(resp *proto.GetRankedInfoResponse) *GetRankedInfoResponseWithDefault {
+	rounds := make([]*RankedRoundResult, 0, len(resp.GetResponse().Rounds))
+	for _, round := range resp.GetResponse().Rounds {
+		counts := make(map[string]int, len(round.Counts))
+		for opt, count := range round.Counts {
+			counts[opt] = int(count)
+		}
+		rounds = append(rounds, &RankedRoundResult{
+			Counts:     counts,
+			Eliminated: round.Eliminated,
+			Exhausted:  int(round.Exhausted),
+		})
+	}
+	ranking := resp.GetResponse().Ranking
+	if ranking == nil {
+		ranking = []string{}
+	}
+
+	return &GetRankedInfoResponseWithDefault{
+		Response: &RankedInfoWithDefault{
+			ID:           int(resp.GetResponse().Id),
+			Category:     resp.GetResponse().Category,
+			Name:         resp.GetResponse().Name,
+			Description:  resp.GetResponse().Description,
+			Organization: resp.GetResponse().Organization,
+			End:          resp.GetResponse().End.AsTime().Format(time.RFC3339),
+			Photo:        resp.GetResponse().Photo,
+			Options:      resp.GetResponse().Options,
+			Ballots:      int(resp.GetResponse().Ballots),
+			Rounds:       rounds,
+			Winner:       resp.GetResponse().Winner,
+			Ranking:      ranking,
+		},
+	}
+}
+
+func NewVoteRankedHandler(log *slog.Logger, votesClient proto.VotesServiceClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		const op = "handler.votes.voteRanked.New"
+		ctx := r.Context()
+		reqID := middleware.GetReqID(ctx)
+		logger := log.With(
+			slog.String("operation", op),
+			slog.String("request_id", reqID),
+			slog.String("client_ip", r.RemoteAddr),
+			slog.String("method", r.Method),
+			slog.String("url", r.URL.String()),
+		)
+
+		logger.Info("Processing vote ranked request")
+
+		select {
+		case <-ctx.Done():
+			logger.Warn("Request was cancelled by the client", slog.String("reason", ctx.Err().Error()))
+			http.Error(w, "Request was cancelled", http.StatusRequestTimeout)
+			return
+		default:
+		}
+
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
+			json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+			return
+		}
+
+		var request proto.VoteRankedRequest
+		if err := json.ReadJSON(r, &request); err != nil {
+			logger.Error("Failed to parse JSON input", slog.String("error", err.Error()))
+			json.WriteError(w, http.StatusBadRequest, "Invalid JSON input")
+			return
+		}
+
+		if request.GetVoteId() == 0 {
+			logger.Warn("Invalid vote_id field in request", slog.String("request_payload", fmt.Sprintf("%+v", &request)))
+			json.WriteError(w, http.StatusBadRequest, "Invalid vote_id field")
+			return
+		}
+
+		if len(request.GetRanking()) == 0 {
+			logger.Warn("Invalid ranking field in request", slog.String("request_payload", fmt.Sprintf("%+v", &request)))
+			json.WriteError(w, http.StatusBadRequest, "Invalid ranking field")
+			return
+		}
+
+		_, err := votesClient.VoteRanked(ctx, &request)
+		if err != nil {
+			writeBallotError(w, logger.With(slog.Any("request", &request)), err)
+			return
+		}
+
+		response := map[string]string{"response": "Vote recorded successfully"}
+		logger.Info("Vote recorded successfully", slog.Any("response", response))
+		json.WriteJSON(w, http.StatusOK, response)
+	}
+}
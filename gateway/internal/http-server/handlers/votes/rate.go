@@ -2,6 +2,7 @@ package votes
 
 import (
 	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
 	"github.com/GP-Hacks/kdt2024-commons/json"
 	"github.com/go-chi/chi/v5/middleware"
 	"log/slog"
@@ -66,9 +67,8 @@ func NewVoteRateHandler(log *slog.Logger, votesClient proto.VotesServiceClient)
 		default:
 		}
 
-		token := r.Header.Get("Authorization")
-		if token == "" {
-			logger.Warn("Authorization token is missing")
+		if _, ok := auth.UserIDFromContext(ctx); !ok {
+			logger.Warn("Request is not authenticated")
 			json.WriteError(w, http.StatusUnauthorized, "Authorization token is required")
 			return
 		}
@@ -81,23 +81,20 @@ func NewVoteRateHandler(log *slog.Logger, votesClient proto.VotesServiceClient)
 		}
 
 		if request.GetVoteId() == 0 {
-			logger.Warn("Invalid or missing vote_id", slog.Any("request", request))
+			logger.Warn("Invalid or missing vote_id", slog.Any("request", &request))
 			json.WriteError(w, http.StatusBadRequest, "Invalid vote_id field")
 			return
 		}
 
 		if request.GetRating() == 0 {
-			logger.Warn("Invalid or missing rating", slog.Any("request", request))
+			logger.Warn("Invalid or missing rating", slog.Any("request", &request))
 			json.WriteError(w, http.StatusBadRequest, "Invalid rating field")
 			return
 		}
 
-		request.Token = token
-
 		resp, err := votesClient.VoteRate(ctx, &request)
 		if err != nil {
-			logger.Error("Failed to record vote", slog.String("error", err.Error()))
-			json.WriteError(w, http.StatusInternalServerError, "Failed to record vote")
+			writeBallotError(w, logger, err)
 			return
 		}
 
@@ -0,0 +1,130 @@
+package jwtauth
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/json"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var ErrMissingSubject = errors.New("token has no subject")
+
+// Verifier checks signed JWTs and extracts the user ID from the "sub" claim and the user roles from the "roles" claim.
+type Verifier struct {
+	key    interface{}
+	method string
+}
+
+func NewHMACVerifier(secret []byte) (*Verifier, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("jwtauth: empty HMAC secret")
+	}
+	return &Verifier{key: secret, method: jwt.SigningMethodHS256.Alg()}, nil
+}
+
+func NewRSAVerifier(publicKeyPEM []byte) (*Verifier, error) {
+	key, err := jwt.ParseRSAPublicKeyFromPEM(publicKeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("jwtauth: failed to parse RSA public key: %w", err)
+	}
+	return &Verifier{key: key, method: jwt.SigningMethodRS256.Alg()}, nil
+}
+
+// Claims are the JWT claims the gateway understands. Roles is optional.
+type Claims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles,omitempty"`
+}
+
+func (v *Verifier) Verify(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(*jwt.Token) (interface{}, error) {
+		return v.key, nil
+	}, jwt.WithValidMethods([]string{v.method}), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, err
+	}
+	if claims.Subject == "" {
+		return nil, ErrMissingSubject
+	}
+	return claims, nil
+}
+
+// New verifies the bearer token when one is present and stores the user ID and roles in the request context.
+// Requests without the Authorization header pass through anonymously.
+func New(log *slog.Logger, verifier *Verifier) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			const op = "middleware.jwtauth.New"
+			header := r.Header.Get("Authorization")
+			if header == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			tokenString := strings.TrimSpace(strings.TrimPrefix(header, "Bearer "))
+			claims, err := verifier.Verify(tokenString)
+			if err != nil {
+				log.Warn("Rejected invalid authorization token",
+					slog.String("operation", op),
+					slog.String("request_id", middleware.GetReqID(r.Context())),
+					slog.String("client_ip", r.RemoteAddr),
+					slog.String("error", err.Error()),
+				)
+				json.WriteError(w, http.StatusUnauthorized, "Invalid authorization token")
+				return
+			}
+
+			ctx := auth.ContextWithUserID(r.Context(), claims.Subject)
+			ctx = auth.ContextWithRoles(ctx, claims.Roles)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// Required rejects requests that were not authenticated by New.
+func Required(log *slog.Logger) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			const op = "middleware.jwtauth.Required"
+			if _, ok := auth.UserIDFromContext(r.Context()); !ok {
+				log.Warn("Authorization required",
+					slog.String("operation", op),
+					slog.String("request_id", middleware.GetReqID(r.Context())),
+					slog.String("client_ip", r.RemoteAddr),
+					slog.String("url", r.URL.String()),
+				)
+				json.WriteError(w, http.StatusUnauthorized, "Authorization required")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequireRole rejects requests whose user does not have the role. Use it after Required.
+func RequireRole(log *slog.Logger, role string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			const op = "middleware.jwtauth.RequireRole"
+			if !auth.HasRole(r.Context(), role) {
+				log.Warn("Not enough permissions",
+					slog.String("operation", op),
+					slog.String("request_id", middleware.GetReqID(r.Context())),
+					slog.String("client_ip", r.RemoteAddr),
+					slog.String("url", r.URL.String()),
+					slog.String("role", role),
+				)
+				json.WriteError(w, http.StatusForbidden, "Not enough permissions")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
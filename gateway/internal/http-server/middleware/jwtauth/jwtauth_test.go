@@ -0,0 +1,225 @@
+package jwtauth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var testSecret = []byte("test-secret")
+
+func testRSAKey(t *testing.T) (*rsa.PrivateKey, []byte) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate RSA key: %v", err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("failed to marshal RSA public key: %v", err)
+	}
+	return key, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, key interface{}, claims jwt.Claims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return token
+}
+
+func claimsFor(subject string, expiresIn time.Duration, roles ...string) *Claims {
+	return &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiresIn)),
+		},
+		Roles: roles,
+	}
+}
+
+func TestVerify(t *testing.T) {
+	rsaKey, publicPEM := testRSAKey(t)
+	hmacVerifier, err := NewHMACVerifier(testSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaVerifier, err := NewRSAVerifier(publicPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		verifier *Verifier
+		token    string
+		subject  string
+		roles    []string
+		wantErr  error
+	}{
+		{
+			name:     "valid HS256",
+			verifier: hmacVerifier,
+			token:    sign(t, jwt.SigningMethodHS256, testSecret, claimsFor("user-1", time.Hour, auth.RoleAdmin)),
+			subject:  "user-1",
+			roles:    []string{auth.RoleAdmin},
+		},
+		{
+			name:     "valid RS256",
+			verifier: rsaVerifier,
+			token:    sign(t, jwt.SigningMethodRS256, rsaKey, claimsFor("user-2", time.Hour)),
+			subject:  "user-2",
+		},
+		{
+			name:     "expired",
+			verifier: hmacVerifier,
+			token:    sign(t, jwt.SigningMethodHS256, testSecret, claimsFor("user-1", -time.Minute)),
+			wantErr:  jwt.ErrTokenExpired,
+		},
+		{
+			name:     "without expiration",
+			verifier: hmacVerifier,
+			token:    sign(t, jwt.SigningMethodHS256, testSecret, &Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: "user-1"}}),
+			wantErr:  jwt.ErrTokenRequiredClaimMissing,
+		},
+		{
+			name:     "wrong secret",
+			verifier: hmacVerifier,
+			token:    sign(t, jwt.SigningMethodHS256, []byte("other-secret"), claimsFor("user-1", time.Hour)),
+			wantErr:  jwt.ErrTokenSignatureInvalid,
+		},
+		{
+			name:     "RS256 token for HS256 verifier",
+			verifier: hmacVerifier,
+			token:    sign(t, jwt.SigningMethodRS256, rsaKey, claimsFor("user-1", time.Hour)),
+			wantErr:  jwt.ErrTokenSignatureInvalid,
+		},
+		{
+			// The classic algorithm confusion: an HS256 token keyed with the public key the verifier trusts.
+			name:     "HS256 token signed with the RSA public key",
+			verifier: rsaVerifier,
+			token:    sign(t, jwt.SigningMethodHS256, publicPEM, claimsFor("user-1", time.Hour)),
+			wantErr:  jwt.ErrTokenSignatureInvalid,
+		},
+		{
+			name:     "unsigned",
+			verifier: hmacVerifier,
+			token:    sign(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, claimsFor("user-1", time.Hour)),
+			wantErr:  jwt.ErrTokenSignatureInvalid,
+		},
+		{
+			name:     "missing subject",
+			verifier: hmacVerifier,
+			token:    sign(t, jwt.SigningMethodHS256, testSecret, claimsFor("", time.Hour)),
+			wantErr:  ErrMissingSubject,
+		},
+		{
+			name:     "malformed",
+			verifier: hmacVerifier,
+			token:    "not-a-token",
+			wantErr:  jwt.ErrTokenMalformed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := tt.verifier.Verify(tt.token)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if claims.Subject != tt.subject {
+				t.Errorf("Subject = %q, want %q", claims.Subject, tt.subject)
+			}
+			if !reflect.DeepEqual(claims.Roles, tt.roles) {
+				t.Errorf("Roles = %v, want %v", claims.Roles, tt.roles)
+			}
+		})
+	}
+}
+
+func TestNewVerifierErrors(t *testing.T) {
+	if _, err := NewHMACVerifier(nil); err == nil {
+		t.Error("NewHMACVerifier(nil) succeeded")
+	}
+	if _, err := NewRSAVerifier([]byte("not a key")); err == nil {
+		t.Error("NewRSAVerifier() succeeded with an invalid key")
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	verifier, err := NewHMACVerifier(testSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	valid := sign(t, jwt.SigningMethodHS256, testSecret, claimsFor("user-1", time.Hour, auth.RoleStaff))
+	expired := sign(t, jwt.SigningMethodHS256, testSecret, claimsFor("user-1", -time.Minute))
+
+	tests := []struct {
+		name          string
+		authorization string
+		required      bool
+		wantStatus    int
+		wantUserID    string
+		wantRoles     []string
+	}{
+		{name: "anonymous passes through", wantStatus: http.StatusOK},
+		{name: "valid token", authorization: "Bearer " + valid, wantStatus: http.StatusOK, wantUserID: "user-1", wantRoles: []string{auth.RoleStaff}},
+		{name: "invalid token", authorization: "Bearer " + expired, wantStatus: http.StatusUnauthorized},
+		{name: "required without token", required: true, wantStatus: http.StatusUnauthorized},
+		{name: "required with token", authorization: "Bearer " + valid, required: true, wantStatus: http.StatusOK, wantUserID: "user-1", wantRoles: []string{auth.RoleStaff}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotUserID string
+			var gotRoles []string
+			var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotUserID, _ = auth.UserIDFromContext(r.Context())
+				gotRoles = auth.RolesFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			})
+			if tt.required {
+				handler = Required(log)(handler)
+			}
+			handler = New(log, verifier)(handler)
+
+			r := httptest.NewRequest(http.MethodGet, "/api/tickets", nil)
+			if tt.authorization != "" {
+				r.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if gotUserID != tt.wantUserID {
+				t.Errorf("user ID = %q, want %q", gotUserID, tt.wantUserID)
+			}
+			if !reflect.DeepEqual(gotRoles, tt.wantRoles) {
+				t.Errorf("roles = %v, want %v", gotRoles, tt.wantRoles)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+// Package pagination reads the page parameters of the list routes: limit, cursor and sort. The cursor is the
+// next_cursor of the previous page.
+package pagination
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+)
+
+type Page struct {
+	Limit  int32
+	Cursor string
+	Sort   string
+}
+
+// FromQuery reads the page parameters of the query. The ranges of limit and the sort keys are checked by the
+// services.
+func FromQuery(query url.Values) (*Page, error) {
+	page := &Page{Cursor: query.Get("cursor"), Sort: query.Get("sort")}
+	if limit := query.Get("limit"); limit != "" {
+		value, err := strconv.ParseInt(limit, 10, 32)
+		if err != nil || value <= 0 {
+			return nil, errors.New("Invalid limit parameter")
+		}
+		page.Limit = int32(value)
+	}
+	return page, nil
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"time"
 )
 
 type Config struct {
@@ -12,12 +13,30 @@ type Config struct {
 	MongoDBName               string
 	MongoDBCollection         string
 	MongoDBPath               string
+	MongoDBScheduled          string
+	MongoDBDeviceTokens       string
+	MongoDBInbox              string
+	DeviceTokenTTL            time.Duration
+	SchedulerInterval         time.Duration
+	SchedulerBatchSize        int
+	SchedulerLease            time.Duration
+	SchedulerMaxAttempts      int
+	SchedulerRetryDelay       time.Duration
 	FirebaseProjectId         string
 	FirebasePrivateKeyId      string
 	FirebasePrivateKey        string
 	FirebaseClientEmail       string
 	FirebaseClientId          string
 	FirebaseClientX509CertUrl string
+	SMTPAddress               string
+	SMTPUsername              string
+	SMTPPassword              string
+	SMTPFrom                  string
+	WebhookURL                string
+	WebhookTimeout            time.Duration
+	LocalSenderPath           string
+	DefaultChannels           []string
+	Location                  *time.Location
 }
 
 var Cfg Config
@@ -31,12 +50,30 @@ func MustLoad() *Config {
 		MongoDBName:               os.Getenv("MONGODB_NAME"),
 		MongoDBCollection:         os.Getenv("MONGODB_COLLECTION"),
 		MongoDBPath:               os.Getenv("MONGODB_PATH"),
+		MongoDBScheduled:          "scheduled_notifications",
+		MongoDBDeviceTokens:       "device_tokens",
+		MongoDBInbox:              "inbox",
+		DeviceTokenTTL:            60 * 24 * time.Hour,
+		SchedulerInterval:         time.Second,
+		SchedulerBatchSize:        100,
+		SchedulerLease:            time.Minute,
+		SchedulerMaxAttempts:      5,
+		SchedulerRetryDelay:       30 * time.Second,
 		FirebaseProjectId:         os.Getenv("FIREBASE_PROJECT_ID"),
 		FirebasePrivateKeyId:      os.Getenv("FIREBASE_PRIVATE_KEY_ID"),
 		FirebasePrivateKey:        os.Getenv("FIREBASE_PRIVATE_KEY"),
 		FirebaseClientEmail:       os.Getenv("FIREBASE_CLIENT_EMAIL"),
 		FirebaseClientId:          os.Getenv("FIREBASE_CLIENT_ID"),
 		FirebaseClientX509CertUrl: os.Getenv("FIREBASE_CLIENT_X509_CERT_URL"),
+		SMTPAddress:               os.Getenv("SMTP_ADDRESS"),
+		SMTPUsername:              os.Getenv("SMTP_USERNAME"),
+		SMTPPassword:              os.Getenv("SMTP_PASSWORD"),
+		SMTPFrom:                  os.Getenv("SMTP_FROM"),
+		WebhookURL:                os.Getenv("NOTIFICATIONS_WEBHOOK_URL"),
+		WebhookTimeout:            10 * time.Second,
+		LocalSenderPath:           os.Getenv("LOCAL_SENDER_PATH"),
+		DefaultChannels:           []string{"push"},
+		Location:                  time.FixedZone("MSK", 3*60*60),
 	}
 
 	return &Cfg
@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	notification_service "github.com/GP-Hacks/kdt2024-notifications/internal/services/notifications_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (c *NotificationsController) ListNotifications(ctx context.Context, request *proto.ListNotificationsRequest) (*proto.ListNotificationsResponse, error) {
+	userId, err := auth.RequireUserID(ctx)
+	if err != nil {
+		c.logger.Warn("ListNotifications request is not authenticated")
+		return nil, err
+	}
+
+	items, next, err := c.notificationsService.ListInbox(ctx, userId, request.GetCursor(), int(request.GetLimit()))
+	if errors.Is(err, notification_service.ErrInvalidInboxRequest) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+	if err != nil {
+		c.logger.Error("Failed to list inbox", slog.String("user_id", userId), slog.String("error", err.Error()))
+		return nil, status.Errorf(codes.Internal, "An internal error occurred, please try again later")
+	}
+
+	response := make([]*proto.InboxNotification, 0, len(items))
+	for _, item := range items {
+		response = append(response, &proto.InboxNotification{
+			Id:        item.Id.Hex(),
+			Header:    item.Header,
+			Content:   item.Content,
+			Category:  item.Category,
+			Timestamp: timestamppb.New(item.CreatedAt),
+			Read:      item.ReadAt != nil,
+		})
+	}
+
+	return &proto.ListNotificationsResponse{Response: response, NextCursor: next}, nil
+}
+
+func (c *NotificationsController) MarkNotificationsRead(ctx context.Context, request *proto.MarkNotificationsReadRequest) (*proto.MarkNotificationsReadResponse, error) {
+	userId, err := auth.RequireUserID(ctx)
+	if err != nil {
+		c.logger.Warn("MarkNotificationsRead request is not authenticated")
+		return nil, err
+	}
+
+	updated, err := c.notificationsService.MarkInboxRead(ctx, userId, request.GetIds())
+	if errors.Is(err, notification_service.ErrInvalidInboxRequest) {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+	if err != nil {
+		c.logger.Error("Failed to mark notifications as read", slog.String("user_id", userId), slog.String("error", err.Error()))
+		return nil, status.Errorf(codes.Internal, "An internal error occurred, please try again later")
+	}
+
+	return &proto.MarkNotificationsReadResponse{Response: "Notifications marked as read", Updated: int32(updated)}, nil
+}
+
+func (c *NotificationsController) GetUnreadCount(ctx context.Context, request *proto.GetUnreadCountRequest) (*proto.GetUnreadCountResponse, error) {
+	userId, err := auth.RequireUserID(ctx)
+	if err != nil {
+		c.logger.Warn("GetUnreadCount request is not authenticated")
+		return nil, err
+	}
+
+	count, err := c.notificationsService.CountUnread(ctx, userId)
+	if err != nil {
+		c.logger.Error("Failed to count unread notifications", slog.String("user_id", userId), slog.String("error", err.Error()))
+		return nil, status.Errorf(codes.Internal, "An internal error occurred, please try again later")
+	}
+
+	return &proto.GetUnreadCountResponse{Count: int32(count)}, nil
+}
@@ -0,0 +1,26 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	notification_service "github.com/GP-Hacks/kdt2024-notifications/internal/services/notifications_service"
+)
+
+type NotificationsController struct {
+	proto.UnimplementedNotificationsServiceServer
+	notificationsService *notification_service.NotificationsService
+	logger               *slog.Logger
+}
+
+func NewNotificationsController(service *notification_service.NotificationsService, logger *slog.Logger) *NotificationsController {
+	return &NotificationsController{
+		notificationsService: service,
+		logger:               logger,
+	}
+}
+
+func (c *NotificationsController) HealthCheck(ctx context.Context, request *proto.HealthCheckRequest) (*proto.HealthCheckResponse, error) {
+	return &proto.HealthCheckResponse{IsHealthy: true}, nil
+}
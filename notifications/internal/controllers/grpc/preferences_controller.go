@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+	notification_service "github.com/GP-Hacks/kdt2024-notifications/internal/services/notifications_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (c *NotificationsController) GetPreferences(ctx context.Context, request *proto.GetPreferencesRequest) (*proto.GetPreferencesResponse, error) {
+	userId, err := auth.RequireUserID(ctx)
+	if err != nil {
+		c.logger.Warn("GetPreferences request is not authenticated")
+		return nil, err
+	}
+
+	recipient, err := c.notificationsService.GetRecipient(ctx, userId)
+	if err != nil {
+		c.logger.Error("Failed to get preferences", slog.String("user_id", userId), slog.String("error", err.Error()))
+		return nil, status.Errorf(codes.Internal, "An internal error occurred, please try again later")
+	}
+
+	return &proto.GetPreferencesResponse{Preferences: toProtoPreferences(recipient)}, nil
+}
+
+func (c *NotificationsController) UpdatePreferences(ctx context.Context, request *proto.UpdatePreferencesRequest) (*proto.UpdatePreferencesResponse, error) {
+	userId, err := auth.RequireUserID(ctx)
+	if err != nil {
+		c.logger.Warn("UpdatePreferences request is not authenticated")
+		return nil, err
+	}
+
+	preferences := request.GetPreferences()
+	if preferences == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Preferences are required")
+	}
+
+	recipient := &models.Recipient{
+		UserId:   userId,
+		Email:    preferences.GetEmail(),
+		Channels: preferences.GetChannels(),
+		Preferences: models.Preferences{
+			Categories: map[string]bool{
+				models.CategoryTickets:   preferences.GetTickets(),
+				models.CategoryDonations: preferences.GetDonations(),
+				models.CategoryVotes:     preferences.GetVotes(),
+			},
+			QuietHoursStart: preferences.GetQuietHoursStart(),
+			QuietHoursEnd:   preferences.GetQuietHoursEnd(),
+			Timezone:        preferences.GetTimezone(),
+			Language:        preferences.GetLanguage(),
+		},
+	}
+
+	err = c.notificationsService.UpdatePreferences(ctx, userId, recipient.Preferences, recipient.Channels, recipient.Email)
+	if errors.Is(err, notification_service.ErrInvalidPreferences) {
+		c.logger.Warn("Invalid preferences", slog.String("user_id", userId), slog.String("error", err.Error()))
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+	if err != nil {
+		c.logger.Error("Failed to update preferences", slog.String("user_id", userId), slog.String("error", err.Error()))
+		return nil, status.Errorf(codes.Internal, "An internal error occurred, please try again later")
+	}
+
+	return &proto.UpdatePreferencesResponse{
+		Response:    "Preferences updated successfully",
+		Preferences: toProtoPreferences(recipient),
+	}, nil
+}
+
+func toProtoPreferences(recipient *models.Recipient) *proto.NotificationPreferences {
+	return &proto.NotificationPreferences{
+		Tickets:         recipient.Preferences.Allows(models.CategoryTickets),
+		Donations:       recipient.Preferences.Allows(models.CategoryDonations),
+		Votes:           recipient.Preferences.Allows(models.CategoryVotes),
+		QuietHoursStart: recipient.Preferences.QuietHoursStart,
+		QuietHoursEnd:   recipient.Preferences.QuietHoursEnd,
+		Timezone:        recipient.Preferences.Timezone,
+		Language:        recipient.Preferences.Language,
+		Channels:        recipient.Channels,
+		Email:           recipient.Email,
+	}
+}
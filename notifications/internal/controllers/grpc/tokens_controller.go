@@ -1 +1,56 @@
 package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	notification_service "github.com/GP-Hacks/kdt2024-notifications/internal/services/notifications_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (c *NotificationsController) RegisterToken(ctx context.Context, request *proto.RegisterTokenRequest) (*proto.RegisterTokenResponse, error) {
+	userId, err := auth.RequireUserID(ctx)
+	if err != nil {
+		c.logger.Warn("RegisterToken request is not authenticated")
+		return nil, err
+	}
+
+	err = c.notificationsService.RegisterToken(ctx, userId, request.GetToken(), request.GetPlatform(), request.GetAppVersion())
+	if errors.Is(err, notification_service.ErrInvalidToken) {
+		c.logger.Warn("Invalid device token", slog.String("user_id", userId), slog.String("error", err.Error()))
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+	if err != nil {
+		c.logger.Error("Failed to register device token", slog.String("user_id", userId), slog.String("error", err.Error()))
+		return nil, status.Errorf(codes.Internal, "An internal error occurred, please try again later")
+	}
+
+	return &proto.RegisterTokenResponse{Response: "Token registered successfully"}, nil
+}
+
+func (c *NotificationsController) DeleteToken(ctx context.Context, request *proto.DeleteTokenRequest) (*proto.DeleteTokenResponse, error) {
+	userId, err := auth.RequireUserID(ctx)
+	if err != nil {
+		c.logger.Warn("DeleteToken request is not authenticated")
+		return nil, err
+	}
+
+	if request.GetToken() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Token is required")
+	}
+
+	err = c.notificationsService.DeleteToken(ctx, userId, request.GetToken())
+	if errors.Is(err, notification_service.ErrTokenNotFound) {
+		return nil, status.Errorf(codes.NotFound, "Token not found")
+	}
+	if err != nil {
+		c.logger.Error("Failed to delete device token", slog.String("user_id", userId), slog.String("error", err.Error()))
+		return nil, status.Errorf(codes.Internal, "An internal error occurred, please try again later")
+	}
+
+	return &proto.DeleteTokenResponse{Response: "Token deleted successfully"}, nil
+}
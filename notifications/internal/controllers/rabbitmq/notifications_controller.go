@@ -2,7 +2,11 @@ package rabbitmq
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"log/slog"
 
 	"github.com/GP-Hacks/kdt2024-notifications/config"
 	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
@@ -13,25 +17,30 @@ import (
 type NotificationsController struct {
 	connection           *amqp.Connection
 	notificationsService *notification_service.NotificationsService
+	logger               *slog.Logger
 }
 
-func NewNotificationsController(conn *amqp.Connection, service *notification_service.NotificationsService) *NotificationsController {
+func NewNotificationsController(conn *amqp.Connection, service *notification_service.NotificationsService, logger *slog.Logger) *NotificationsController {
 	return &NotificationsController{
 		connection:           conn,
 		notificationsService: service,
+		logger:               logger,
 	}
 }
 
-func (c *NotificationsController) StartConsumer() error {
+// Consume reads notification messages until ctx is done or the channel is closed. A message is acked only after
+// the notification is stored, so nothing is lost if the service stops before it is due.
+func (c *NotificationsController) Consume(ctx context.Context) error {
 	ch, err := c.connection.Channel()
 	if err != nil {
 		return err
 	}
+	defer ch.Close()
 
 	msgs, err := ch.Consume(
 		config.Cfg.QueueName,
 		"",
-		true,
+		false,
 		false,
 		false,
 		false,
@@ -40,26 +49,72 @@ func (c *NotificationsController) StartConsumer() error {
 	if err != nil {
 		return err
 	}
+	c.logger.Info("RabbitMQ consumer registered successfully", slog.String("queue", config.Cfg.QueueName))
 
-	go func() {
-		for {
-			msg := <-msgs
-
-			var notification models.Notification
-			if err := json.Unmarshal(msg.Body, &notification); err != nil {
-				continue
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-msgs:
+			if !ok {
+				return errors.New("rabbitmq channel closed")
 			}
+			c.handle(ctx, msg)
+		}
+	}
+}
 
-			if notification.Header == "" || notification.Content == "" || notification.UserId == "" {
-				continue
-			}
+func (c *NotificationsController) handle(ctx context.Context, msg amqp.Delivery) {
+	var notification models.Notification
+	if err := json.Unmarshal(msg.Body, &notification); err != nil {
+		c.logger.Error("Failed to unmarshal RabbitMQ message", slog.String("error", err.Error()), slog.String("body", string(msg.Body)))
+		c.reject(msg)
+		return
+	}
+
+	var err error
+	if notification.Action == models.ActionCancel {
+		if notification.Key == "" {
+			c.logger.Warn("Cancel message without notification key", slog.String("user_id", notification.UserId))
+			c.reject(msg)
+			return
+		}
+		err = c.notificationsService.CancelNotification(ctx, notification.Key)
+	} else {
+		if notification.Header == "" || notification.Content == "" || notification.UserId == "" {
+			c.logger.Warn("Invalid notification message", slog.String("body", string(msg.Body)))
+			c.reject(msg)
+			return
+		}
+		err = c.notificationsService.ScheduleNotification(ctx, dedupKey(msg), &notification)
+	}
 
-			c.notificationsService.SendNotifications(
-				context.Background(),
-				&notification,
-			)
+	if err != nil {
+		c.logger.Error("Failed to process notification message, requeueing", slog.String("error", err.Error()))
+		if err := msg.Nack(false, true); err != nil {
+			c.logger.Error("Failed to nack message", slog.String("error", err.Error()))
 		}
-	}()
+		return
+	}
+
+	if err := msg.Ack(false); err != nil {
+		c.logger.Error("Failed to ack message", slog.String("error", err.Error()))
+	}
+}
 
-	return nil
+// reject drops a message that can never be processed.
+func (c *NotificationsController) reject(msg amqp.Delivery) {
+	if err := msg.Nack(false, false); err != nil {
+		c.logger.Error("Failed to nack message", slog.String("error", err.Error()))
+	}
+}
+
+// dedupKey identifies the source message across redeliveries: the publisher's message ID when it is set,
+// the hash of the body otherwise.
+func dedupKey(msg amqp.Delivery) string {
+	if msg.MessageId != "" {
+		return msg.MessageId
+	}
+	sum := sha256.Sum256(msg.Body)
+	return "sha256:" + hex.EncodeToString(sum[:])
 }
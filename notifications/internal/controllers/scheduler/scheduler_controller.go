@@ -0,0 +1,54 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	notification_service "github.com/GP-Hacks/kdt2024-notifications/internal/services/notifications_service"
+)
+
+// SchedulerController polls MongoDB for due notifications and delivers them. Several instances can run at once:
+// each notification is leased to a single worker.
+type SchedulerController struct {
+	notificationsService *notification_service.NotificationsService
+	logger               *slog.Logger
+	owner                string
+	interval             time.Duration
+	batchSize            int
+}
+
+func NewSchedulerController(service *notification_service.NotificationsService, logger *slog.Logger, owner string, interval time.Duration, batchSize int) *SchedulerController {
+	return &SchedulerController{
+		notificationsService: service,
+		logger:               logger,
+		owner:                owner,
+		interval:             interval,
+		batchSize:            batchSize,
+	}
+}
+
+func (c *SchedulerController) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		processed, err := c.notificationsService.DeliverDue(ctx, c.owner, c.batchSize)
+		if err != nil {
+			c.logger.Error("Failed to deliver due notifications", slog.String("error", err.Error()))
+		} else if processed > 0 {
+			c.logger.Info("Due notifications processed", slog.Int("count", processed))
+		}
+
+		// Keep draining without waiting while there is a backlog.
+		if err == nil && processed == c.batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
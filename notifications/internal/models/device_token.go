@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+const (
+	PlatformAndroid = "android"
+	PlatformIOS     = "ios"
+	PlatformWeb     = "web"
+)
+
+// DeviceToken is a push token registered by one of the user's devices. LastSeenAt is refreshed every time the app
+// registers the token again; tokens not seen for a while expire.
+type DeviceToken struct {
+	Token      string    `bson:"token"`
+	UserId     string    `bson:"user_id"`
+	Platform   string    `bson:"platform,omitempty"`
+	AppVersion string    `bson:"app_version,omitempty"`
+	CreatedAt  time.Time `bson:"created_at"`
+	LastSeenAt time.Time `bson:"last_seen_at"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// InboxItem is a notification kept in the user's in-app inbox. NotificationId points to the scheduled notification
+// it was created from so repeated delivery attempts do not add it twice.
+type InboxItem struct {
+	Id             primitive.ObjectID `bson:"_id,omitempty"`
+	UserId         string             `bson:"user_id"`
+	NotificationId primitive.ObjectID `bson:"notification_id"`
+	Key            string             `bson:"key,omitempty"`
+	Category       string             `bson:"category,omitempty"`
+	Header         string             `bson:"header"`
+	Content        string             `bson:"content"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	ReadAt         *time.Time         `bson:"read_at,omitempty"`
+}
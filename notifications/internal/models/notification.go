@@ -2,9 +2,33 @@ package models
 
 import "time"
 
+// ActionCancel drops the scheduled notification with the message's key instead of scheduling a new one.
+const ActionCancel = "cancel"
+
+// Delivery channels.
+const (
+	ChannelPush    = "push"
+	ChannelEmail   = "email"
+	ChannelWebhook = "webhook"
+)
+
 type Notification struct {
 	Header  string    `json:"header,omitempty"`
 	Content string    `json:"content,omitempty"`
 	Time    time.Time `json:"time,omitempty"`
 	UserId  string    `json:"user_id,omitempty"`
+	Key     string    `json:"key,omitempty"`
+	Action  string    `json:"action,omitempty"`
+	// Channels overrides the channels preferred by the user.
+	Channels []string `json:"channels,omitempty"`
+	Category string   `json:"category,omitempty"`
+	// ExpiresAt is when the notification becomes useless, e.g. the start of the event it reminds about.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Translations holds the header and content in other languages, keyed by language code.
+	Translations map[string]Translation `json:"translations,omitempty"`
+}
+
+type Translation struct {
+	Header  string `json:"header" bson:"header"`
+	Content string `json:"content" bson:"content"`
 }
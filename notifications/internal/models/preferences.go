@@ -0,0 +1,88 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Notification categories users can opt out of.
+const (
+	CategoryTickets   = "tickets"
+	CategoryDonations = "donations"
+	CategoryVotes     = "votes"
+)
+
+// Languages notifications can be delivered in.
+const (
+	LanguageRussian = "ru"
+	LanguageEnglish = "en"
+)
+
+// Preferences are the notification settings of a user. Categories missing from the map are enabled.
+type Preferences struct {
+	Categories      map[string]bool `bson:"categories,omitempty"`
+	QuietHoursStart string          `bson:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   string          `bson:"quiet_hours_end,omitempty"`
+	Timezone        string          `bson:"timezone,omitempty"`
+	Language        string          `bson:"language,omitempty"`
+}
+
+func (p *Preferences) Allows(category string) bool {
+	enabled, ok := p.Categories[category]
+	return !ok || enabled
+}
+
+// Location returns the user's timezone, or fallback when it is not set or unknown.
+func (p *Preferences) Location(fallback *time.Location) *time.Location {
+	if p.Timezone == "" {
+		return fallback
+	}
+	location, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return fallback
+	}
+	return location
+}
+
+// QuietUntil reports whether now falls into the user's quiet hours and, if so, when they end.
+// Quiet hours may span midnight, e.g. 22:00-08:00.
+func (p *Preferences) QuietUntil(now time.Time, location *time.Location) (time.Time, bool) {
+	if p.QuietHoursStart == "" || p.QuietHoursEnd == "" {
+		return time.Time{}, false
+	}
+	start, err := ParseClock(p.QuietHoursStart)
+	if err != nil {
+		return time.Time{}, false
+	}
+	end, err := ParseClock(p.QuietHoursEnd)
+	if err != nil || start == end {
+		return time.Time{}, false
+	}
+
+	local := now.In(location)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, location)
+	current := local.Sub(midnight)
+
+	if start < end {
+		if current >= start && current < end {
+			return midnight.Add(end), true
+		}
+		return time.Time{}, false
+	}
+	if current >= start {
+		return midnight.AddDate(0, 0, 1).Add(end), true
+	}
+	if current < end {
+		return midnight.Add(end), true
+	}
+	return time.Time{}, false
+}
+
+// ParseClock parses "HH:MM" into the offset from midnight.
+func ParseClock(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, HH:MM expected", value)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
@@ -0,0 +1,27 @@
+package models
+
+// Recipient holds the addresses of a user for every delivery channel and the user's preferences. Channels are the
+// channels the user prefers; when empty, the service defaults are used.
+type Recipient struct {
+	UserId string `bson:"user_id"`
+	// Tokens are loaded from the device tokens collection.
+	Tokens      []string    `bson:"-"`
+	Email       string      `bson:"email,omitempty"`
+	Channels    []string    `bson:"channels,omitempty"`
+	Preferences Preferences `bson:"preferences"`
+}
+
+// Addresses returns where the channel should deliver to.
+func (r *Recipient) Addresses(channel string) []string {
+	switch channel {
+	case ChannelPush:
+		return r.Tokens
+	case ChannelEmail:
+		if r.Email != "" {
+			return []string{r.Email}
+		}
+	case ChannelWebhook:
+		return []string{r.UserId}
+	}
+	return nil
+}
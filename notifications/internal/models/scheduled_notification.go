@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	ScheduledStatusPending    = "pending"
+	ScheduledStatusProcessing = "processing"
+	ScheduledStatusSent       = "sent"
+	ScheduledStatusCancelled  = "cancelled"
+	ScheduledStatusFailed     = "failed"
+	// ScheduledStatusSkipped is set when the user's preferences rule the notification out.
+	ScheduledStatusSkipped = "skipped"
+)
+
+// ScheduledNotification is a notification stored until it is due. DedupKey identifies the source message, so a
+// redelivered message does not schedule the notification twice, and SentTargets ("<channel>:<address>") lets a
+// retried delivery skip the recipients that already got it.
+type ScheduledNotification struct {
+	Id           primitive.ObjectID     `bson:"_id,omitempty"`
+	DedupKey     string                 `bson:"dedup_key"`
+	Key          string                 `bson:"key,omitempty"`
+	UserId       string                 `bson:"user_id"`
+	Header       string                 `bson:"header"`
+	Content      string                 `bson:"content"`
+	Channels     []string               `bson:"channels,omitempty"`
+	Category     string                 `bson:"category,omitempty"`
+	Translations map[string]Translation `bson:"translations,omitempty"`
+	DueAt        time.Time              `bson:"due_at"`
+	ExpiresAt    time.Time              `bson:"expires_at,omitempty"`
+	Status       string                 `bson:"status"`
+	Attempts     int                    `bson:"attempts"`
+	LeaseOwner   string                 `bson:"lease_owner,omitempty"`
+	LeaseUntil   time.Time              `bson:"lease_until,omitempty"`
+	SentTargets  []string               `bson:"sent_targets,omitempty"`
+	LastError    string                 `bson:"last_error,omitempty"`
+	CreatedAt    time.Time              `bson:"created_at"`
+	UpdatedAt    time.Time              `bson:"updated_at"`
+}
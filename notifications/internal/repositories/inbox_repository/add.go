@@ -0,0 +1,23 @@
+package inbox_repository
+
+import (
+	"context"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Add stores the item unless the notification it comes from is already in the inbox.
+func (r *InboxRepository) Add(ctx context.Context, item *models.InboxItem) error {
+	filter := bson.M{"notification_id": item.NotificationId}
+	update := bson.M{"$setOnInsert": item}
+	opts := options.Update().SetUpsert(true)
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,11 @@
+package inbox_repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (r *InboxRepository) CountUnread(ctx context.Context, userId string) (int64, error) {
+	return r.collection.CountDocuments(ctx, bson.M{"user_id": userId, "read_at": nil})
+}
@@ -0,0 +1,13 @@
+package inbox_repository
+
+import "go.mongodb.org/mongo-driver/mongo"
+
+type InboxRepository struct {
+	collection *mongo.Collection
+}
+
+func NewInboxRepository(collection *mongo.Collection) *InboxRepository {
+	return &InboxRepository{
+		collection: collection,
+	}
+}
@@ -0,0 +1,32 @@
+package inbox_repository
+
+import (
+	"context"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// List returns up to limit of the user's items, newest first, that are older than before. A zero before starts
+// from the newest item.
+func (r *InboxRepository) List(ctx context.Context, userId string, before primitive.ObjectID, limit int) ([]*models.InboxItem, error) {
+	filter := bson.M{"user_id": userId}
+	if !before.IsZero() {
+		filter["_id"] = bson.M{"$lt": before}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: -1}}).SetLimit(int64(limit))
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*models.InboxItem, 0, limit)
+	if err := cursor.All(ctx, &items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,26 @@
+package inbox_repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MarkRead marks the user's unread items as read. With no ids every unread item is marked. It returns the number
+// of items that changed.
+func (r *InboxRepository) MarkRead(ctx context.Context, userId string, ids []primitive.ObjectID) (int64, error) {
+	filter := bson.M{"user_id": userId, "read_at": nil}
+	if len(ids) > 0 {
+		filter["_id"] = bson.M{"$in": ids}
+	}
+	update := bson.M{"$set": bson.M{"read_at": time.Now()}}
+
+	res, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.ModifiedCount, nil
+}
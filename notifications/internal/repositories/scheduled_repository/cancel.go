@@ -0,0 +1,23 @@
+package scheduled_repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Cancel marks pending notifications with the key as cancelled and returns how many were cancelled.
+// Notifications that are being delivered right now are not affected.
+func (r *ScheduledRepository) Cancel(ctx context.Context, key string) (int64, error) {
+	filter := bson.M{"key": key, "status": models.ScheduledStatusPending}
+	update := bson.M{"$set": bson.M{"status": models.ScheduledStatusCancelled, "updated_at": time.Now()}}
+
+	res, err := r.collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.ModifiedCount, nil
+}
@@ -0,0 +1,45 @@
+package scheduled_repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ClaimDue leases the oldest due notification to owner for the lease duration. Notifications whose lease expired,
+// because the worker holding them died, can be claimed again. It returns nil when nothing is due.
+func (r *ScheduledRepository) ClaimDue(ctx context.Context, owner string, lease time.Duration) (*models.ScheduledNotification, error) {
+	now := time.Now()
+	filter := bson.M{"$or": bson.A{
+		bson.M{"status": models.ScheduledStatusPending, "due_at": bson.M{"$lte": now}},
+		bson.M{"status": models.ScheduledStatusProcessing, "lease_until": bson.M{"$lte": now}},
+	}}
+	update := bson.M{
+		"$set": bson.M{
+			"status":      models.ScheduledStatusProcessing,
+			"lease_owner": owner,
+			"lease_until": now.Add(lease),
+			"updated_at":  now,
+		},
+		"$inc": bson.M{"attempts": 1},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "due_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var notification models.ScheduledNotification
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&notification)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &notification, nil
+}
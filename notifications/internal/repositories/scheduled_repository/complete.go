@@ -0,0 +1,64 @@
+package scheduled_repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AddSentTarget records a recipient that got the notification, so a retry does not send it there again.
+func (r *ScheduledRepository) AddSentTarget(ctx context.Context, id primitive.ObjectID, owner string, target string) error {
+	filter := bson.M{"_id": id, "lease_owner": owner}
+	update := bson.M{"$addToSet": bson.M{"sent_targets": target}}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+// MarkSent finishes the delivery. It does nothing if the lease was lost to another worker.
+func (r *ScheduledRepository) MarkSent(ctx context.Context, id primitive.ObjectID, owner string) error {
+	return r.finish(ctx, id, owner, bson.M{"status": models.ScheduledStatusSent})
+}
+
+// Retry returns the notification to the queue to be delivered again at dueAt.
+func (r *ScheduledRepository) Retry(ctx context.Context, id primitive.ObjectID, owner string, dueAt time.Time, reason string) error {
+	return r.finish(ctx, id, owner, bson.M{"status": models.ScheduledStatusPending, "due_at": dueAt, "last_error": reason})
+}
+
+// MarkFailed gives up on the notification.
+func (r *ScheduledRepository) MarkFailed(ctx context.Context, id primitive.ObjectID, owner string, reason string) error {
+	return r.finish(ctx, id, owner, bson.M{"status": models.ScheduledStatusFailed, "last_error": reason})
+}
+
+func (r *ScheduledRepository) finish(ctx context.Context, id primitive.ObjectID, owner string, set bson.M) error {
+	set["updated_at"] = time.Now()
+	filter := bson.M{"_id": id, "status": models.ScheduledStatusProcessing, "lease_owner": owner}
+	update := bson.M{
+		"$set":   set,
+		"$unset": bson.M{"lease_owner": "", "lease_until": ""},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
+
+// MarkSkipped finishes the notification without delivering it.
+func (r *ScheduledRepository) MarkSkipped(ctx context.Context, id primitive.ObjectID, owner string, reason string) error {
+	return r.finish(ctx, id, owner, bson.M{"status": models.ScheduledStatusSkipped, "last_error": reason})
+}
+
+// Defer postpones the delivery until dueAt without counting the claim as an attempt.
+func (r *ScheduledRepository) Defer(ctx context.Context, id primitive.ObjectID, owner string, dueAt time.Time) error {
+	filter := bson.M{"_id": id, "status": models.ScheduledStatusProcessing, "lease_owner": owner}
+	update := bson.M{
+		"$set":   bson.M{"status": models.ScheduledStatusPending, "due_at": dueAt, "updated_at": time.Now()},
+		"$unset": bson.M{"lease_owner": "", "lease_until": ""},
+		"$inc":   bson.M{"attempts": -1},
+	}
+
+	_, err := r.collection.UpdateOne(ctx, filter, update)
+	return err
+}
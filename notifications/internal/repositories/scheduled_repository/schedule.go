@@ -0,0 +1,34 @@
+package scheduled_repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Schedule stores the notification unless one with the same dedup key already exists.
+// It reports whether a new notification was stored.
+func (r *ScheduledRepository) Schedule(ctx context.Context, notification *models.ScheduledNotification) (bool, error) {
+	now := time.Now()
+	notification.Status = models.ScheduledStatusPending
+	notification.CreatedAt = now
+	notification.UpdatedAt = now
+
+	filter := bson.M{"dedup_key": notification.DedupKey}
+	update := bson.M{"$setOnInsert": notification}
+	opts := options.Update().SetUpsert(true)
+
+	res, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return res.UpsertedCount > 0, nil
+}
@@ -0,0 +1,13 @@
+package scheduled_repository
+
+import "go.mongodb.org/mongo-driver/mongo"
+
+type ScheduledRepository struct {
+	collection *mongo.Collection
+}
+
+func NewScheduledRepository(collection *mongo.Collection) *ScheduledRepository {
+	return &ScheduledRepository{
+		collection: collection,
+	}
+}
@@ -0,0 +1,31 @@
+package tokens_repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// DeleteUserToken removes the user's device token and reports whether it existed.
+func (r *TokensRepository) DeleteUserToken(ctx context.Context, userId, token string) (bool, error) {
+	res, err := r.devices.DeleteOne(ctx, bson.M{"token": token, "user_id": userId})
+	if err != nil {
+		return false, err
+	}
+
+	return res.DeletedCount > 0, nil
+}
+
+// DeleteTokens removes the tokens regardless of their owner, e.g. after the push provider rejected them.
+func (r *TokensRepository) DeleteTokens(ctx context.Context, tokens []string) (int64, error) {
+	if len(tokens) == 0 {
+		return 0, nil
+	}
+
+	res, err := r.devices.DeleteMany(ctx, bson.M{"token": bson.M{"$in": tokens}})
+	if err != nil {
+		return 0, err
+	}
+
+	return res.DeletedCount, nil
+}
@@ -0,0 +1,34 @@
+package tokens_repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// GetRecipient returns the user's settings together with the device tokens. It returns mongo.ErrNoDocuments only
+// when the user has neither settings nor tokens.
+func (r *TokensRepository) GetRecipient(ctx context.Context, userId string) (*models.Recipient, error) {
+	filter := bson.M{"user_id": userId}
+
+	recipient := models.Recipient{UserId: userId}
+	err := r.collection.FindOne(ctx, filter).Decode(&recipient)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+	found := err == nil
+
+	tokens, err := r.GetTokensByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if !found && len(tokens) == 0 {
+		return nil, mongo.ErrNoDocuments
+	}
+	recipient.Tokens = tokens
+
+	return &recipient, nil
+}
@@ -4,18 +4,29 @@ import (
 	"context"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 func (r *TokensRepository) GetTokensByUserId(ctx context.Context, userId string) ([]string, error) {
 	filter := bson.M{"user_id": userId}
-	var userTokens struct {
-		Tokens []string `bson:"tokens"`
-	}
+	opts := options.Find().SetProjection(bson.M{"token": 1})
 
-	err := r.collection.FindOne(context.Background(), filter).Decode(&userTokens)
+	cursor, err := r.devices.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	return userTokens.Tokens, nil
+	var devices []struct {
+		Token string `bson:"token"`
+	}
+	if err := cursor.All(ctx, &devices); err != nil {
+		return nil, err
+	}
+
+	tokens := make([]string, 0, len(devices))
+	for _, device := range devices {
+		tokens = append(tokens, device.Token)
+	}
+
+	return tokens, nil
 }
@@ -0,0 +1,56 @@
+package tokens_repository
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MigrateLegacyTokens moves the token arrays kept on user documents into the device tokens collection. Migrated
+// tokens count as seen now so they are not expired right away. It is safe to run on every start.
+func (r *TokensRepository) MigrateLegacyTokens(ctx context.Context) (int, error) {
+	filter := bson.M{"tokens": bson.M{"$exists": true}}
+	opts := options.Find().SetProjection(bson.M{"user_id": 1, "tokens": 1})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	migrated := 0
+	for cursor.Next(ctx) {
+		var user struct {
+			UserId string   `bson:"user_id"`
+			Tokens []string `bson:"tokens"`
+		}
+		if err := cursor.Decode(&user); err != nil {
+			return migrated, err
+		}
+
+		now := time.Now()
+		for _, token := range user.Tokens {
+			if token == "" {
+				continue
+			}
+			// Tokens already registered through the new API keep their owner and metadata.
+			update := bson.M{"$setOnInsert": bson.M{
+				"user_id":      user.UserId,
+				"created_at":   now,
+				"last_seen_at": now,
+			}}
+			if _, err := r.devices.UpdateOne(ctx, bson.M{"token": token}, update, options.Update().SetUpsert(true)); err != nil {
+				return migrated, err
+			}
+			migrated++
+		}
+
+		if _, err := r.collection.UpdateOne(ctx, bson.M{"user_id": user.UserId}, bson.M{"$unset": bson.M{"tokens": ""}}); err != nil {
+			return migrated, err
+		}
+	}
+
+	return migrated, cursor.Err()
+}
@@ -4,10 +4,13 @@ import "go.mongodb.org/mongo-driver/mongo"
 
 type TokensRepository struct {
 	collection *mongo.Collection
+	// devices holds one document per device token.
+	devices *mongo.Collection
 }
 
-func NewTokensRepository(collection *mongo.Collection) *TokensRepository {
+func NewTokensRepository(collection *mongo.Collection, devices *mongo.Collection) *TokensRepository {
 	return &TokensRepository{
 		collection: collection,
+		devices:    devices,
 	}
 }
@@ -0,0 +1,24 @@
+package tokens_repository
+
+import (
+	"context"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// UpdatePreferences replaces the user's preferences, preferred channels and email.
+func (r *TokensRepository) UpdatePreferences(ctx context.Context, userId string, preferences models.Preferences, channels []string, email string) error {
+	filter := bson.M{"user_id": userId}
+	update := bson.M{"$set": bson.M{
+		"preferences": preferences,
+		"channels":    channels,
+		"email":       email,
+	}}
+
+	opts := options.Update().SetUpsert(true)
+
+	_, err := r.collection.UpdateOne(ctx, filter, update, opts)
+	return err
+}
@@ -0,0 +1,28 @@
+package tokens_repository
+
+import (
+	"context"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// UpsertToken registers the device token or refreshes it. A token registered again by another user moves to that user.
+func (r *TokensRepository) UpsertToken(ctx context.Context, token *models.DeviceToken) error {
+	filter := bson.M{"token": token.Token}
+	update := bson.M{
+		"$set": bson.M{
+			"user_id":      token.UserId,
+			"platform":     token.Platform,
+			"app_version":  token.AppVersion,
+			"last_seen_at": token.LastSeenAt,
+		},
+		"$setOnInsert": bson.M{"created_at": token.LastSeenAt},
+	}
+
+	opts := options.Update().SetUpsert(true)
+
+	_, err := r.devices.UpdateOne(ctx, filter, update, opts)
+	return err
+}
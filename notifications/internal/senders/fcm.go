@@ -0,0 +1,38 @@
+package senders
+
+import (
+	"context"
+	"fmt"
+
+	"firebase.google.com/go/messaging"
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+)
+
+// FCMSender sends push notifications through Firebase Cloud Messaging.
+type FCMSender struct {
+	client *messaging.Client
+}
+
+func NewFCMSender(client *messaging.Client) *FCMSender {
+	return &FCMSender{
+		client: client,
+	}
+}
+
+func (s *FCMSender) Send(ctx context.Context, token string, notification *models.Notification) error {
+	message := &messaging.Message{
+		Token: token,
+		Data: map[string]string{
+			"title":   notification.Header,
+			"content": notification.Content,
+		},
+	}
+
+	_, err := s.client.Send(ctx, message)
+	// Only an unregistered token is a dead address. FCM also answers INVALID_ARGUMENT for problems of the message
+	// itself, like an oversized payload, and pruning the token for those would drop healthy devices.
+	if messaging.IsRegistrationTokenNotRegistered(err) {
+		return fmt.Errorf("%w: %w", ErrInvalidAddress, err)
+	}
+	return err
+}
@@ -0,0 +1,87 @@
+package senders
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+)
+
+// Delivery is a notification accepted by LocalSender.
+type Delivery struct {
+	Channel string    `json:"channel"`
+	Address string    `json:"address"`
+	Header  string    `json:"header"`
+	Content string    `json:"content"`
+	SentAt  time.Time `json:"sent_at"`
+}
+
+// maxLocalDeliveries is the number of the latest deliveries LocalSender keeps in memory.
+const maxLocalDeliveries = 100
+
+// LocalSender stands in for real channels during local development. It keeps the last maxLocalDeliveries deliveries
+// in memory and, when a path is set, also appends every delivery to that file as JSON lines.
+type LocalSender struct {
+	channel string
+	path    string
+
+	mu sync.Mutex
+	// deliveries is a ring buffer, once full next is the oldest delivery and the one overwritten by the next Send.
+	deliveries []Delivery
+	next       int
+}
+
+func NewLocalSender(channel, path string) *LocalSender {
+	return &LocalSender{
+		channel: channel,
+		path:    path,
+	}
+}
+
+func (s *LocalSender) Send(ctx context.Context, address string, notification *models.Notification) error {
+	delivery := Delivery{
+		Channel: s.channel,
+		Address: address,
+		Header:  notification.Header,
+		Content: notification.Content,
+		SentAt:  time.Now(),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.deliveries) < maxLocalDeliveries {
+		s.deliveries = append(s.deliveries, delivery)
+	} else {
+		s.deliveries[s.next] = delivery
+		s.next = (s.next + 1) % maxLocalDeliveries
+	}
+
+	if s.path == "" {
+		return nil
+	}
+	line, err := json.Marshal(delivery)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// Deliveries returns a copy of the last maxLocalDeliveries deliveries, oldest first.
+func (s *LocalSender) Deliveries() []Delivery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := make([]Delivery, 0, len(s.deliveries))
+	result = append(result, s.deliveries[s.next:]...)
+	return append(result, s.deliveries[:s.next]...)
+}
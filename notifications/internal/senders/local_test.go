@@ -0,0 +1,30 @@
+package senders
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+)
+
+func TestLocalSenderKeepsLastDeliveries(t *testing.T) {
+	sender := NewLocalSender(models.ChannelPush, "")
+	total := maxLocalDeliveries + 25
+	for i := 0; i < total; i++ {
+		notification := &models.Notification{Header: fmt.Sprint(i)}
+		if err := sender.Send(context.Background(), "device", notification); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+	}
+
+	deliveries := sender.Deliveries()
+	if len(deliveries) != maxLocalDeliveries {
+		t.Fatalf("got %d deliveries, want %d", len(deliveries), maxLocalDeliveries)
+	}
+	for i, delivery := range deliveries {
+		if want := fmt.Sprint(total - maxLocalDeliveries + i); delivery.Header != want {
+			t.Fatalf("delivery %d has header %q, want %q", i, delivery.Header, want)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package senders
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+)
+
+// ErrInvalidAddress is wrapped by senders when the address will never accept deliveries, e.g. an unregistered
+// device token. Such addresses must be removed instead of retried.
+var ErrInvalidAddress = errors.New("invalid address")
+
+// Sender delivers notifications over one channel. The address format depends on the channel:
+// a device token for push, an email address for email and the user ID for webhook.
+type Sender interface {
+	Send(ctx context.Context, address string, notification *models.Notification) error
+}
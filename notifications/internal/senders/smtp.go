@@ -0,0 +1,61 @@
+package senders
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+)
+
+// SMTPSender sends notifications as plain text emails.
+type SMTPSender struct {
+	address string
+	from    string
+	auth    smtp.Auth
+}
+
+// NewSMTPSender creates a sender for the server at address ("host:port"). Authentication is skipped when the
+// username is empty.
+func NewSMTPSender(address, username, password, from string) (*SMTPSender, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, fmt.Errorf("senders: invalid SMTP address: %w", err)
+	}
+
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &SMTPSender{
+		address: address,
+		from:    from,
+		auth:    auth,
+	}, nil
+}
+
+func (s *SMTPSender) Send(ctx context.Context, email string, notification *models.Notification) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if strings.ContainsAny(email, "\r\n") {
+		return fmt.Errorf("senders: invalid email address %q", email)
+	}
+
+	var msg strings.Builder
+	msg.WriteString("From: " + s.from + "\r\n")
+	msg.WriteString("To: " + email + "\r\n")
+	msg.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", notification.Header) + "\r\n")
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(notification.Content)
+	msg.WriteString("\r\n")
+
+	return smtp.SendMail(s.address, s.auth, s.from, []string{email}, []byte(msg.String()))
+}
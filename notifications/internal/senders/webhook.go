@@ -0,0 +1,68 @@
+package senders
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+)
+
+// HTTPClient is the part of *http.Client used by WebhookSender.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// WebhookSender posts notifications as JSON to an external integration, e.g. a messenger bot.
+type WebhookSender struct {
+	url    string
+	client HTTPClient
+}
+
+type webhookPayload struct {
+	UserId  string    `json:"user_id"`
+	Header  string    `json:"header"`
+	Content string    `json:"content"`
+	Time    time.Time `json:"time"`
+}
+
+func NewWebhookSender(url string, client HTTPClient) *WebhookSender {
+	return &WebhookSender{
+		url:    url,
+		client: client,
+	}
+}
+
+func (s *WebhookSender) Send(ctx context.Context, userId string, notification *models.Notification) error {
+	body, err := json.Marshal(webhookPayload{
+		UserId:  userId,
+		Header:  notification.Header,
+		Content: notification.Content,
+		Time:    notification.Time,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("senders: webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -1,11 +1,43 @@
 package service_provider
 
-import "github.com/GP-Hacks/kdt2024-notifications/internal/controllers/rabbitmq"
+import (
+	"fmt"
+	"os"
+
+	"github.com/GP-Hacks/kdt2024-notifications/config"
+	grpc_controller "github.com/GP-Hacks/kdt2024-notifications/internal/controllers/grpc"
+	"github.com/GP-Hacks/kdt2024-notifications/internal/controllers/rabbitmq"
+	"github.com/GP-Hacks/kdt2024-notifications/internal/controllers/scheduler"
+)
 
 func (s *ServiceProvider) NotificationsController() *rabbitmq.NotificationsController {
 	if s.notificationsController == nil {
-		s.notificationsController = rabbitmq.NewNotificationsController(s.RabbitmqConnection(), s.NotificationsService())
+		s.notificationsController = rabbitmq.NewNotificationsController(s.RabbitmqConnection(), s.NotificationsService(), s.logger)
 	}
 
 	return s.notificationsController
 }
+
+func (s *ServiceProvider) SchedulerController() *scheduler.SchedulerController {
+	if s.schedulerController == nil {
+		hostname, _ := os.Hostname()
+		owner := fmt.Sprintf("%s-%d", hostname, os.Getpid())
+		s.schedulerController = scheduler.NewSchedulerController(
+			s.NotificationsService(),
+			s.logger,
+			owner,
+			config.Cfg.SchedulerInterval,
+			config.Cfg.SchedulerBatchSize,
+		)
+	}
+
+	return s.schedulerController
+}
+
+func (s *ServiceProvider) GRPCController() *grpc_controller.NotificationsController {
+	if s.grpcController == nil {
+		s.grpcController = grpc_controller.NewNotificationsController(s.NotificationsService(), s.logger)
+	}
+
+	return s.grpcController
+}
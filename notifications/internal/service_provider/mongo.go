@@ -24,8 +24,20 @@ func (s *ServiceProvider) MongoClient() *mongo.Client {
 
 func (s *ServiceProvider) MongoCollection() *mongo.Collection {
 	if s.mongoCollection == nil {
-		s.mongoCollection = s.MongoClient().Database(config.Cfg.MongoDBPath).Collection(config.Cfg.MongoDBCollection)
+		s.mongoCollection = s.MongoClient().Database(config.Cfg.MongoDBName).Collection(config.Cfg.MongoDBCollection)
 	}
 
 	return s.mongoCollection
 }
+
+func (s *ServiceProvider) DeviceTokensCollection() *mongo.Collection {
+	return s.MongoClient().Database(config.Cfg.MongoDBName).Collection(config.Cfg.MongoDBDeviceTokens)
+}
+
+func (s *ServiceProvider) InboxCollection() *mongo.Collection {
+	return s.MongoClient().Database(config.Cfg.MongoDBName).Collection(config.Cfg.MongoDBInbox)
+}
+
+func (s *ServiceProvider) ScheduledCollection() *mongo.Collection {
+	return s.MongoClient().Database(config.Cfg.MongoDBName).Collection(config.Cfg.MongoDBScheduled)
+}
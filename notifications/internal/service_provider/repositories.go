@@ -1,22 +1,31 @@
 package service_provider
 
 import (
-	"github.com/GP-Hacks/kdt2024-notifications/internal/repositories/notifications_repository"
+	"github.com/GP-Hacks/kdt2024-notifications/internal/repositories/inbox_repository"
+	"github.com/GP-Hacks/kdt2024-notifications/internal/repositories/scheduled_repository"
 	"github.com/GP-Hacks/kdt2024-notifications/internal/repositories/tokens_repository"
 )
 
-func (s *ServiceProvider) NotificationsRepository() *notifications_repository.NotificationsRepository {
-	if s.notificationsRepository == nil {
-		s.notificationsRepository = notifications_repository.NewNotificationsRepository(s.MessagingClient())
+func (s *ServiceProvider) TokensRepository() *tokens_repository.TokensRepository {
+	if s.tokensRepository == nil {
+		s.tokensRepository = tokens_repository.NewTokensRepository(s.MongoCollection(), s.DeviceTokensCollection())
 	}
 
-	return s.notificationsRepository
+	return s.tokensRepository
 }
 
-func (s *ServiceProvider) TokensRepository() *tokens_repository.TokensRepository {
-	if s.tokensRepository == nil {
-		s.tokensRepository = tokens_repository.NewTokensRepository(s.MongoCollection())
+func (s *ServiceProvider) InboxRepository() *inbox_repository.InboxRepository {
+	if s.inboxRepository == nil {
+		s.inboxRepository = inbox_repository.NewInboxRepository(s.InboxCollection())
 	}
 
-	return s.tokensRepository
+	return s.inboxRepository
+}
+
+func (s *ServiceProvider) ScheduledRepository() *scheduled_repository.ScheduledRepository {
+	if s.scheduledRepository == nil {
+		s.scheduledRepository = scheduled_repository.NewScheduledRepository(s.ScheduledCollection())
+	}
+
+	return s.scheduledRepository
 }
@@ -0,0 +1,56 @@
+package service_provider
+
+import (
+	"net/http"
+
+	"github.com/GP-Hacks/kdt2024-notifications/config"
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+	"github.com/GP-Hacks/kdt2024-notifications/internal/senders"
+	notification_service "github.com/GP-Hacks/kdt2024-notifications/internal/services/notifications_service"
+)
+
+// Senders returns a sender for every channel. Channels that are not configured fall back to a local sender,
+// so the service runs without Firebase, SMTP or webhook credentials.
+func (s *ServiceProvider) Senders() map[string]notification_service.ISender {
+	if s.senders == nil {
+		s.senders = map[string]notification_service.ISender{
+			models.ChannelPush:    s.pushSender(),
+			models.ChannelEmail:   s.emailSender(),
+			models.ChannelWebhook: s.webhookSender(),
+		}
+	}
+
+	return s.senders
+}
+
+func (s *ServiceProvider) pushSender() notification_service.ISender {
+	if config.Cfg.FirebaseProjectId == "" {
+		s.logger.Warn("Firebase is not configured, push notifications are delivered locally")
+		return senders.NewLocalSender(models.ChannelPush, config.Cfg.LocalSenderPath)
+	}
+
+	return senders.NewFCMSender(s.MessagingClient())
+}
+
+func (s *ServiceProvider) emailSender() notification_service.ISender {
+	if config.Cfg.SMTPAddress == "" {
+		s.logger.Warn("SMTP is not configured, emails are delivered locally")
+		return senders.NewLocalSender(models.ChannelEmail, config.Cfg.LocalSenderPath)
+	}
+
+	sender, err := senders.NewSMTPSender(config.Cfg.SMTPAddress, config.Cfg.SMTPUsername, config.Cfg.SMTPPassword, config.Cfg.SMTPFrom)
+	if err != nil {
+		panic(err)
+	}
+
+	return sender
+}
+
+func (s *ServiceProvider) webhookSender() notification_service.ISender {
+	if config.Cfg.WebhookURL == "" {
+		s.logger.Warn("Webhook is not configured, webhook notifications are delivered locally")
+		return senders.NewLocalSender(models.ChannelWebhook, config.Cfg.LocalSenderPath)
+	}
+
+	return senders.NewWebhookSender(config.Cfg.WebhookURL, &http.Client{Timeout: config.Cfg.WebhookTimeout})
+}
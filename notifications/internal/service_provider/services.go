@@ -1,10 +1,24 @@
 package service_provider
 
-import notification_service "github.com/GP-Hacks/kdt2024-notifications/internal/services/notifications_service"
+import (
+	"github.com/GP-Hacks/kdt2024-notifications/config"
+	notification_service "github.com/GP-Hacks/kdt2024-notifications/internal/services/notifications_service"
+)
 
 func (s *ServiceProvider) NotificationsService() *notification_service.NotificationsService {
 	if s.notificationsService == nil {
-		s.notificationsService = notification_service.NewNotificationsService(s.TokensRepository(), s.NotificationsRepository())
+		s.notificationsService = notification_service.NewNotificationsService(
+			s.TokensRepository(),
+			s.ScheduledRepository(),
+			s.InboxRepository(),
+			s.Senders(),
+			config.Cfg.DefaultChannels,
+			config.Cfg.Location,
+			s.logger,
+			config.Cfg.SchedulerLease,
+			config.Cfg.SchedulerMaxAttempts,
+			config.Cfg.SchedulerRetryDelay,
+		)
 	}
 
 	return s.notificationsService
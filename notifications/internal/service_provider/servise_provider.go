@@ -1,10 +1,16 @@
 package service_provider
 
 import (
+	"context"
+	"log/slog"
+
 	firebase "firebase.google.com/go"
 	"firebase.google.com/go/messaging"
+	grpc_controller "github.com/GP-Hacks/kdt2024-notifications/internal/controllers/grpc"
 	"github.com/GP-Hacks/kdt2024-notifications/internal/controllers/rabbitmq"
-	"github.com/GP-Hacks/kdt2024-notifications/internal/repositories/notifications_repository"
+	"github.com/GP-Hacks/kdt2024-notifications/internal/controllers/scheduler"
+	"github.com/GP-Hacks/kdt2024-notifications/internal/repositories/inbox_repository"
+	"github.com/GP-Hacks/kdt2024-notifications/internal/repositories/scheduled_repository"
 	"github.com/GP-Hacks/kdt2024-notifications/internal/repositories/tokens_repository"
 	notification_service "github.com/GP-Hacks/kdt2024-notifications/internal/services/notifications_service"
 	"github.com/streadway/amqp"
@@ -14,10 +20,15 @@ import (
 // ServiceProvider struct  
 // Struct for provide service objects
 type ServiceProvider struct {
+	logger                  *slog.Logger
 	notificationsController *rabbitmq.NotificationsController
+	schedulerController     *scheduler.SchedulerController
+	grpcController          *grpc_controller.NotificationsController
 	notificationsService    *notification_service.NotificationsService
-	notificationsRepository *notifications_repository.NotificationsRepository
+	senders                 map[string]notification_service.ISender
 	tokensRepository        *tokens_repository.TokensRepository
+	scheduledRepository     *scheduled_repository.ScheduledRepository
+	inboxRepository         *inbox_repository.InboxRepository
 	mongoCollection         *mongo.Collection
 	mongoClient             *mongo.Client
 	firebaseApp             *firebase.App
@@ -25,6 +36,20 @@ type ServiceProvider struct {
 	rabbitmqConnection      *amqp.Connection
 }
 
-func NewServiceProvider() *ServiceProvider {
-	return &ServiceProvider{}
+func NewServiceProvider(logger *slog.Logger) *ServiceProvider {
+	return &ServiceProvider{logger: logger}
+}
+
+// Close releases the connections opened by the provider.
+func (s *ServiceProvider) Close(ctx context.Context) {
+	if s.rabbitmqConnection != nil {
+		if err := s.rabbitmqConnection.Close(); err != nil {
+			s.logger.Error("Failed to close RabbitMQ connection", slog.String("error", err.Error()))
+		}
+	}
+	if s.mongoClient != nil {
+		if err := s.mongoClient.Disconnect(ctx); err != nil {
+			s.logger.Error("Failed to disconnect MongoDB", slog.String("error", err.Error()))
+		}
+	}
 }
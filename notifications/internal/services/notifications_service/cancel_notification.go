@@ -0,0 +1,20 @@
+package notification_service
+
+import (
+	"context"
+	"log/slog"
+)
+
+func (s *NotificationsService) CancelNotification(ctx context.Context, key string) error {
+	cancelled, err := s.scheduledRepository.Cancel(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if cancelled == 0 {
+		s.logger.Info("No pending notification to cancel", slog.String("key", key))
+		return nil
+	}
+	s.logger.Info("Scheduled notification cancelled", slog.String("key", key), slog.Int64("count", cancelled))
+	return nil
+}
@@ -0,0 +1,200 @@
+package notification_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+	"github.com/GP-Hacks/kdt2024-notifications/internal/senders"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// DeliverDue claims due notifications one by one and sends them until nothing is due or limit is reached.
+// Delivery is at least once: a worker that dies mid-delivery loses its lease and the notification is sent again,
+// skipping the devices recorded as already served. It returns the number of processed notifications.
+func (s *NotificationsService) DeliverDue(ctx context.Context, owner string, limit int) (int, error) {
+	processed := 0
+	for processed < limit {
+		notification, err := s.scheduledRepository.ClaimDue(ctx, owner, s.lease)
+		if err != nil {
+			return processed, err
+		}
+		if notification == nil {
+			return processed, nil
+		}
+
+		s.deliver(ctx, owner, notification)
+		processed++
+	}
+
+	return processed, nil
+}
+
+func (s *NotificationsService) deliver(ctx context.Context, owner string, notification *models.ScheduledNotification) {
+	logger := s.logger.With(slog.String("id", notification.Id.Hex()), slog.String("user_id", notification.UserId), slog.Int("attempt", notification.Attempts))
+
+	recipient, err := s.tokensRepository.GetRecipient(ctx, notification.UserId)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		recipient = &models.Recipient{UserId: notification.UserId}
+	} else if err != nil {
+		s.retry(ctx, owner, notification, fmt.Errorf("failed to fetch recipient: %w", err), logger)
+		return
+	}
+
+	if reason, skip := s.skipReason(notification, recipient); skip {
+		logger.Info("Notification skipped", slog.String("reason", reason))
+		if err := s.scheduledRepository.MarkSkipped(ctx, notification.Id, owner, reason); err != nil {
+			logger.Error("Failed to mark notification as skipped", slog.String("error", err.Error()))
+		}
+		return
+	}
+
+	now := time.Now()
+	if until, quiet := recipient.Preferences.QuietUntil(now, recipient.Preferences.Location(s.location)); quiet {
+		if !notification.ExpiresAt.IsZero() && until.After(notification.ExpiresAt) {
+			logger.Info("Notification expires during quiet hours, skipping")
+			if err := s.scheduledRepository.MarkSkipped(ctx, notification.Id, owner, "expires during quiet hours"); err != nil {
+				logger.Error("Failed to mark notification as skipped", slog.String("error", err.Error()))
+			}
+			return
+		}
+		logger.Info("Quiet hours, deferring notification", slog.Time("until", until))
+		if err := s.scheduledRepository.Defer(ctx, notification.Id, owner, until); err != nil {
+			logger.Error("Failed to defer notification", slog.String("error", err.Error()))
+		}
+		return
+	}
+
+	message := &models.Notification{
+		Header:  notification.Header,
+		Content: notification.Content,
+		Time:    notification.DueAt,
+		UserId:  notification.UserId,
+		Key:     notification.Key,
+	}
+	if translation, ok := notification.Translations[recipient.Preferences.Language]; ok {
+		message.Header = translation.Header
+		message.Content = translation.Content
+	}
+
+	// The inbox keeps the notification even when no device gets the push.
+	err = s.inboxRepository.Add(ctx, &models.InboxItem{
+		UserId:         notification.UserId,
+		NotificationId: notification.Id,
+		Key:            notification.Key,
+		Category:       notification.Category,
+		Header:         message.Header,
+		Content:        message.Content,
+		CreatedAt:      now,
+	})
+	if err != nil {
+		s.retry(ctx, owner, notification, fmt.Errorf("failed to add notification to inbox: %w", err), logger)
+		return
+	}
+
+	var errs []error
+	var invalidTokens []string
+	targets := 0
+	for _, channel := range s.channels(notification, recipient) {
+		sender, ok := s.senders[channel]
+		if !ok {
+			logger.Warn("Unknown notification channel", slog.String("channel", channel))
+			continue
+		}
+
+		for _, address := range recipient.Addresses(channel) {
+			target := channel + ":" + address
+			if slices.Contains(notification.SentTargets, target) {
+				targets++
+				continue
+			}
+			err := sender.Send(ctx, address, message)
+			if errors.Is(err, senders.ErrInvalidAddress) {
+				logger.Info("Address rejected by the provider", slog.String("channel", channel), slog.String("address", address), slog.String("error", err.Error()))
+				if channel == models.ChannelPush {
+					invalidTokens = append(invalidTokens, address)
+				}
+				continue
+			}
+			targets++
+			if err != nil {
+				logger.Warn("Failed to send notification", slog.String("channel", channel), slog.String("address", address), slog.String("error", err.Error()))
+				errs = append(errs, fmt.Errorf("%s: %w", target, err))
+				continue
+			}
+			if err := s.scheduledRepository.AddSentTarget(ctx, notification.Id, owner, target); err != nil {
+				logger.Error("Failed to record sent target", slog.String("target", target), slog.String("error", err.Error()))
+			}
+		}
+	}
+
+	if len(invalidTokens) > 0 {
+		if deleted, err := s.tokensRepository.DeleteTokens(ctx, invalidTokens); err != nil {
+			logger.Error("Failed to delete invalid device tokens", slog.String("error", err.Error()))
+		} else {
+			logger.Info("Invalid device tokens deleted", slog.Int64("count", deleted))
+		}
+	}
+
+	if targets == 0 {
+		logger.Warn("User has no addresses for the notification channels, notification is only kept in the inbox")
+		if err := s.scheduledRepository.MarkSkipped(ctx, notification.Id, owner, "no recipients"); err != nil {
+			logger.Error("Failed to mark notification as skipped", slog.String("error", err.Error()))
+		}
+		return
+	}
+
+	if len(errs) > 0 {
+		s.retry(ctx, owner, notification, errors.Join(errs...), logger)
+		return
+	}
+
+	if err := s.scheduledRepository.MarkSent(ctx, notification.Id, owner); err != nil {
+		logger.Error("Failed to mark notification as sent", slog.String("error", err.Error()))
+		return
+	}
+	logger.Info("Notification sent successfully", slog.String("header", notification.Header))
+}
+
+// skipReason reports why the notification must not be delivered at all.
+func (s *NotificationsService) skipReason(notification *models.ScheduledNotification, recipient *models.Recipient) (string, bool) {
+	if !recipient.Preferences.Allows(notification.Category) {
+		return "user opted out of category " + notification.Category, true
+	}
+	if !notification.ExpiresAt.IsZero() && time.Now().After(notification.ExpiresAt) {
+		return "expired", true
+	}
+	return "", false
+}
+
+// channels picks the channels for the notification: the ones requested by the producer, otherwise the ones
+// preferred by the user, otherwise the service defaults.
+func (s *NotificationsService) channels(notification *models.ScheduledNotification, recipient *models.Recipient) []string {
+	if len(notification.Channels) > 0 {
+		return notification.Channels
+	}
+	if len(recipient.Channels) > 0 {
+		return recipient.Channels
+	}
+	return s.defaultChannels
+}
+
+func (s *NotificationsService) retry(ctx context.Context, owner string, notification *models.ScheduledNotification, cause error, logger *slog.Logger) {
+	if notification.Attempts >= s.maxAttempts {
+		logger.Error("Giving up on notification", slog.String("error", cause.Error()))
+		if err := s.scheduledRepository.MarkFailed(ctx, notification.Id, owner, cause.Error()); err != nil {
+			logger.Error("Failed to mark notification as failed", slog.String("error", err.Error()))
+		}
+		return
+	}
+
+	dueAt := time.Now().Add(s.retryDelay * time.Duration(notification.Attempts))
+	logger.Warn("Notification delivery failed, will retry", slog.String("error", cause.Error()), slog.Time("retry_at", dueAt))
+	if err := s.scheduledRepository.Retry(ctx, notification.Id, owner, dueAt, cause.Error()); err != nil {
+		logger.Error("Failed to reschedule notification", slog.String("error", err.Error()))
+	}
+}
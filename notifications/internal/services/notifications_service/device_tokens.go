@@ -0,0 +1,57 @@
+package notification_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+)
+
+const maxTokenLength = 4096
+
+var (
+	ErrInvalidToken  = errors.New("invalid device token")
+	ErrTokenNotFound = errors.New("device token not found")
+)
+
+// RegisterToken stores the user's device token or refreshes its last seen time. Validation errors wrap ErrInvalidToken.
+func (s *NotificationsService) RegisterToken(ctx context.Context, userId, token, platform, appVersion string) error {
+	if token == "" || len(token) > maxTokenLength {
+		return fmt.Errorf("%w: token must be between 1 and %d characters", ErrInvalidToken, maxTokenLength)
+	}
+	if platform != "" && !slices.Contains([]string{models.PlatformAndroid, models.PlatformIOS, models.PlatformWeb}, platform) {
+		return fmt.Errorf("%w: unknown platform %q", ErrInvalidToken, platform)
+	}
+
+	err := s.tokensRepository.UpsertToken(ctx, &models.DeviceToken{
+		Token:      token,
+		UserId:     userId,
+		Platform:   platform,
+		AppVersion: appVersion,
+		LastSeenAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Device token registered", slog.String("user_id", userId), slog.String("platform", platform), slog.String("app_version", appVersion))
+	return nil
+}
+
+// DeleteToken removes the user's device token, e.g. on logout. It returns ErrTokenNotFound when the user has no such token.
+func (s *NotificationsService) DeleteToken(ctx context.Context, userId, token string) error {
+	deleted, err := s.tokensRepository.DeleteUserToken(ctx, userId, token)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrTokenNotFound
+	}
+
+	s.logger.Info("Device token deleted", slog.String("user_id", userId))
+	return nil
+}
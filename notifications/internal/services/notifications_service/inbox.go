@@ -0,0 +1,70 @@
+package notification_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	defaultInboxLimit = 20
+	maxInboxLimit     = 100
+)
+
+var ErrInvalidInboxRequest = errors.New("invalid inbox request")
+
+// ListInbox returns a page of the user's inbox, newest first, and the cursor of the next page. The cursor is empty
+// on the last page. Validation errors wrap ErrInvalidInboxRequest.
+func (s *NotificationsService) ListInbox(ctx context.Context, userId, cursor string, limit int) ([]*models.InboxItem, string, error) {
+	if limit < 0 || limit > maxInboxLimit {
+		return nil, "", fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidInboxRequest, maxInboxLimit)
+	}
+	if limit == 0 {
+		limit = defaultInboxLimit
+	}
+
+	var before primitive.ObjectID
+	if cursor != "" {
+		id, err := primitive.ObjectIDFromHex(cursor)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: invalid cursor", ErrInvalidInboxRequest)
+		}
+		before = id
+	}
+
+	// One extra item tells whether there is a next page.
+	items, err := s.inboxRepository.List(ctx, userId, before, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(items) > limit {
+		items = items[:limit]
+		next = items[limit-1].Id.Hex()
+	}
+
+	return items, next, nil
+}
+
+// MarkInboxRead marks the given items as read, or the whole inbox when ids is empty. It returns the number of items
+// that were unread before.
+func (s *NotificationsService) MarkInboxRead(ctx context.Context, userId string, ids []string) (int64, error) {
+	objectIds := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		objectId, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return 0, fmt.Errorf("%w: invalid id %q", ErrInvalidInboxRequest, id)
+		}
+		objectIds = append(objectIds, objectId)
+	}
+
+	return s.inboxRepository.MarkRead(ctx, userId, objectIds)
+}
+
+func (s *NotificationsService) CountUnread(ctx context.Context, userId string) (int64, error) {
+	return s.inboxRepository.CountUnread(ctx, userId)
+}
@@ -2,29 +2,73 @@ package notification_service
 
 import (
 	"context"
+	"log/slog"
+	"time"
 
 	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type (
 	ITokensRepository interface {
 		GetTokensByUserId(ctx context.Context, userId string) ([]string, error)
-		AddUserToken(ctx context.Context, userId string, token string) error
+		GetRecipient(ctx context.Context, userId string) (*models.Recipient, error)
+		UpsertToken(ctx context.Context, token *models.DeviceToken) error
+		DeleteUserToken(ctx context.Context, userId string, token string) (bool, error)
+		DeleteTokens(ctx context.Context, tokens []string) (int64, error)
+		UpdatePreferences(ctx context.Context, userId string, preferences models.Preferences, channels []string, email string) error
 	}
 
-	INotificationsRepository interface {
-		SendNotifications(ctx context.Context, notification *models.Notification, token ...string) error
+	ISender interface {
+		Send(ctx context.Context, address string, notification *models.Notification) error
+	}
+
+	IScheduledRepository interface {
+		Schedule(ctx context.Context, notification *models.ScheduledNotification) (bool, error)
+		Cancel(ctx context.Context, key string) (int64, error)
+		ClaimDue(ctx context.Context, owner string, lease time.Duration) (*models.ScheduledNotification, error)
+		AddSentTarget(ctx context.Context, id primitive.ObjectID, owner string, target string) error
+		MarkSent(ctx context.Context, id primitive.ObjectID, owner string) error
+		Retry(ctx context.Context, id primitive.ObjectID, owner string, dueAt time.Time, reason string) error
+		MarkFailed(ctx context.Context, id primitive.ObjectID, owner string, reason string) error
+		MarkSkipped(ctx context.Context, id primitive.ObjectID, owner string, reason string) error
+		Defer(ctx context.Context, id primitive.ObjectID, owner string, dueAt time.Time) error
+	}
+
+	IInboxRepository interface {
+		Add(ctx context.Context, item *models.InboxItem) error
+		List(ctx context.Context, userId string, before primitive.ObjectID, limit int) ([]*models.InboxItem, error)
+		MarkRead(ctx context.Context, userId string, ids []primitive.ObjectID) (int64, error)
+		CountUnread(ctx context.Context, userId string) (int64, error)
 	}
 
 	NotificationsService struct {
-		tokensRepository       ITokensRepository
-		noificationsRepository INotificationsRepository
+		tokensRepository    ITokensRepository
+		scheduledRepository IScheduledRepository
+		inboxRepository     IInboxRepository
+		// senders maps a channel name to the sender delivering over it.
+		senders         map[string]ISender
+		defaultChannels []string
+		// location is used for quiet hours of users without a timezone.
+		location    *time.Location
+		logger      *slog.Logger
+		lease       time.Duration
+		maxAttempts int
+		retryDelay  time.Duration
 	}
 )
 
-func NewNotificationsService(tokensRepository ITokensRepository, notificationsRepository INotificationsRepository) *NotificationsService {
+func NewNotificationsService(tokensRepository ITokensRepository, scheduledRepository IScheduledRepository, inboxRepository IInboxRepository, senders map[string]ISender, defaultChannels []string, location *time.Location, logger *slog.Logger, lease time.Duration, maxAttempts int, retryDelay time.Duration) *NotificationsService {
 	return &NotificationsService{
-		tokensRepository:       tokensRepository,
-		noificationsRepository: notificationsRepository,
+		tokensRepository:    tokensRepository,
+		scheduledRepository: scheduledRepository,
+		inboxRepository:     inboxRepository,
+		senders:             senders,
+		defaultChannels:     defaultChannels,
+		location:            location,
+		logger:              logger,
+		lease:               lease,
+		maxAttempts:         maxAttempts,
+		retryDelay:          retryDelay,
 	}
 }
@@ -0,0 +1,90 @@
+package notification_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"slices"
+	"time"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var ErrInvalidPreferences = errors.New("invalid preferences")
+
+// GetRecipient returns the user's addresses and preferences. Users without any settings get empty ones.
+func (s *NotificationsService) GetRecipient(ctx context.Context, userId string) (*models.Recipient, error) {
+	recipient, err := s.tokensRepository.GetRecipient(ctx, userId)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &models.Recipient{UserId: userId}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return recipient, nil
+}
+
+// UpdatePreferences validates and stores the user's preferences. Validation errors wrap ErrInvalidPreferences.
+func (s *NotificationsService) UpdatePreferences(ctx context.Context, userId string, preferences models.Preferences, channels []string, email string) error {
+	if err := s.validatePreferences(preferences, channels, email); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidPreferences, err)
+	}
+
+	if err := s.tokensRepository.UpdatePreferences(ctx, userId, preferences, channels, email); err != nil {
+		return err
+	}
+
+	s.logger.Info("Notification preferences updated", slog.String("user_id", userId))
+	return nil
+}
+
+func (s *NotificationsService) validatePreferences(preferences models.Preferences, channels []string, email string) error {
+	for category := range preferences.Categories {
+		if !slices.Contains([]string{models.CategoryTickets, models.CategoryDonations, models.CategoryVotes}, category) {
+			return fmt.Errorf("unknown category %q", category)
+		}
+	}
+
+	if (preferences.QuietHoursStart == "") != (preferences.QuietHoursEnd == "") {
+		return errors.New("quiet hours need both start and end")
+	}
+	if preferences.QuietHoursStart != "" {
+		if _, err := models.ParseClock(preferences.QuietHoursStart); err != nil {
+			return err
+		}
+		if _, err := models.ParseClock(preferences.QuietHoursEnd); err != nil {
+			return err
+		}
+	}
+
+	if preferences.Timezone != "" {
+		if _, err := time.LoadLocation(preferences.Timezone); err != nil {
+			return fmt.Errorf("unknown timezone %q", preferences.Timezone)
+		}
+	}
+
+	if preferences.Language != "" && preferences.Language != models.LanguageRussian && preferences.Language != models.LanguageEnglish {
+		return fmt.Errorf("unsupported language %q", preferences.Language)
+	}
+
+	for _, channel := range channels {
+		if _, ok := s.senders[channel]; !ok {
+			return fmt.Errorf("unknown channel %q", channel)
+		}
+	}
+
+	if email != "" {
+		if _, err := mail.ParseAddress(email); err != nil {
+			return fmt.Errorf("invalid email %q", email)
+		}
+	}
+	if slices.Contains(channels, models.ChannelEmail) && email == "" {
+		return errors.New("email channel needs an email address")
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package notification_service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/GP-Hacks/kdt2024-notifications/internal/models"
+)
+
+// ScheduleNotification stores the notification to be delivered at notification.Time. dedupKey identifies the
+// source message: scheduling the same key again is a no-op.
+func (s *NotificationsService) ScheduleNotification(ctx context.Context, dedupKey string, notification *models.Notification) error {
+	dueAt := notification.Time
+	if dueAt.IsZero() {
+		dueAt = time.Now()
+	}
+
+	created, err := s.scheduledRepository.Schedule(ctx, &models.ScheduledNotification{
+		DedupKey:     dedupKey,
+		Key:          notification.Key,
+		UserId:       notification.UserId,
+		Header:       notification.Header,
+		Content:      notification.Content,
+		Channels:     notification.Channels,
+		Category:     notification.Category,
+		Translations: notification.Translations,
+		DueAt:        dueAt,
+		ExpiresAt:    notification.ExpiresAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !created {
+		s.logger.Info("Notification already scheduled, skipping duplicate", slog.String("dedup_key", dedupKey))
+		return nil
+	}
+	s.logger.Info("Notification scheduled", slog.String("dedup_key", dedupKey), slog.String("user_id", notification.UserId), slog.Time("due_at", dueAt))
+	return nil
+}
@@ -2,23 +2,42 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/migrate"
+	"github.com/GP-Hacks/kdt2024-commons/outbox"
 	"github.com/GP-Hacks/kdt2024-commons/prettylogger"
 	"github.com/GP-Hacks/kdt2024-places/config"
+	"github.com/GP-Hacks/kdt2024-places/internal/foursquare"
 	"github.com/GP-Hacks/kdt2024-places/internal/grpc-server/handler"
+	"github.com/GP-Hacks/kdt2024-places/internal/importer"
+	"github.com/GP-Hacks/kdt2024-places/internal/seed"
 	"github.com/GP-Hacks/kdt2024-places/internal/storage"
+	"github.com/GP-Hacks/kdt2024-places/internal/ticketcode"
 	"github.com/streadway/amqp"
 	"google.golang.org/grpc"
 	"log/slog"
 	"net"
+	"net/http"
+	"os"
+	"time"
 )
 
 func main() {
 	cfg := config.MustLoad()
 	log := prettylogger.SetupLogger(cfg.Env)
+
+	if len(os.Args) > 1 {
+		if err := runCommand(cfg, log, os.Args[1], os.Args[2:]); err != nil {
+			os.Exit(1)
+		}
+		return
+	}
+
 	log.Info("Configuration and logger initialized", slog.String("environment", cfg.Env))
 	log.Info("Logger initialized")
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(auth.UnaryServerInterceptor()))
 
 	log.Info("Starting TCP listener", slog.String("address", cfg.Address))
 	l, err := net.Listen("tcp", cfg.Address)
@@ -33,6 +52,12 @@ func main() {
 	}()
 	log.Info("TCP listener started successfully", slog.String("address", cfg.Address))
 
+	signer, err := ticketcode.NewSigner([]byte(cfg.TicketCodeSecret))
+	if err != nil {
+		log.Error("Failed to setup ticket code signer, set TICKET_CODE_SECRET", slog.String("error", err.Error()))
+		return
+	}
+
 	storage, err := setupPostgreSQL(cfg, log)
 	if err != nil {
 		return
@@ -55,7 +80,22 @@ func main() {
 		return
 	}
 
-	handler.NewGRPCHandler(cfg, grpcServer, storage, log, ch)
+	relay, err := outbox.NewRelay(storage, "places-outbox-", ch, log, cfg.OutboxInterval)
+	if err != nil {
+		log.Error("Failed to setup outbox relay", slog.String("error", err.Error()))
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go relay.Run(ctx)
+	go generateSlots(ctx, cfg, storage, log)
+	if cfg.FoursquareAPIKey != "" {
+		go newImporter(cfg, storage, log).Run(ctx)
+	} else {
+		log.Warn("FOURSQUARE_API_KEY is not set, places are not imported")
+	}
+
+	handler.NewGRPCHandler(cfg, grpcServer, storage, signer, log)
 	if err := grpcServer.Serve(l); err != nil {
 		log.Error("Error serving gRPC server for PlacesService", slog.String("address", cfg.Address), slog.String("error", err.Error()))
 	}
@@ -70,19 +110,34 @@ func setupPostgreSQL(cfg *config.Config, log *slog.Logger) (*storage.PostgresSto
 	}
 	log.Info("Postgres connection established")
 
-	if err := storage.CreateTables(context.Background()); err != nil {
+	if err := migrate.UpWithLog(context.Background(), storage.Migrate, log); err != nil {
 		return nil, err
 	}
 
-	log.Info("Fetching and storing data")
-	if err := storage.FetchAndStoreData(context.Background()); err != nil {
-		log.Error("Failed to fetch and store data", slog.String("error", err.Error()))
-		return nil, err
-	}
-	log.Info("Data fetching and storing completed successfully")
 	return storage, nil
 }
 
+func generateSlots(ctx context.Context, cfg *config.Config, storage *storage.PostgresStorage, log *slog.Logger) {
+	ticker := time.NewTicker(cfg.SlotsInterval)
+	defer ticker.Stop()
+
+	for {
+		_, offset := time.Now().In(cfg.Location).Zone()
+		created, err := storage.GenerateSlots(ctx, cfg.SlotDays, cfg.SlotDuration, cfg.SlotCapacity, time.Duration(offset)*time.Second)
+		if err != nil {
+			log.Error("Failed to generate slots", slog.String("error", err.Error()))
+		} else {
+			log.Info("Slots generated", slog.Int("created", created), slog.Int("days", cfg.SlotDays))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func setupRabbitMQ(cfg *config.Config, log *slog.Logger) (*amqp.Connection, *amqp.Channel, error) {
 	log.Info("Connecting to RabbitMQ", slog.String("address", cfg.RabbitMQAddress))
 	conn, err := amqp.Dial(cfg.RabbitMQAddress)
@@ -126,3 +181,97 @@ func declareQueues(cfg *config.Config, ch *amqp.Channel, log *slog.Logger) error
 func logCriticalError(log *slog.Logger, message string, err error, context string) {
 	log.Error(message, slog.String("error", err.Error()), slog.String("context", context))
 }
+
+// runCommand runs a maintenance subcommand instead of starting the service.
+func runCommand(cfg *config.Config, log *slog.Logger, command string, args []string) error {
+	switch command {
+	case "migrate":
+		return runMigrate(cfg, log, args)
+	case "seed":
+		return runSeed(cfg, log, args)
+	case "import":
+		return runImport(cfg, log)
+	default:
+		log.Error("Unknown command, use migrate, seed or import", slog.String("command", command))
+		return fmt.Errorf("unknown command %q", command)
+	}
+}
+
+// runMigrate runs the migrate subcommand, which applies, reverts or lists the migrations without starting the service.
+func runMigrate(cfg *config.Config, log *slog.Logger, args []string) error {
+	storage, err := storage.NewPostgresStorage(cfg.PostgresAddress + "?sslmode=disable")
+	if err != nil {
+		log.Error("Failed to connect to PostgreSQL", slog.String("error", err.Error()))
+		return err
+	}
+	defer storage.Close()
+
+	migrator, err := storage.Migrator()
+	if err != nil {
+		log.Error("Failed to load migrations", slog.String("error", err.Error()))
+		return err
+	}
+	if err := migrate.Run(context.Background(), migrator, args, os.Stdout); err != nil {
+		log.Error("Migrate command failed", slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
+
+// runSeed runs the seed subcommand, which stores the places of the given fixture files, or of the bundled fixtures,
+// that are not stored yet. Seeding again adds nothing, so it is safe to run on every deployment.
+func runSeed(cfg *config.Config, log *slog.Logger, args []string) error {
+	storage, err := storage.NewPostgresStorage(cfg.PostgresAddress + "?sslmode=disable")
+	if err != nil {
+		log.Error("Failed to connect to PostgreSQL", slog.String("error", err.Error()))
+		return err
+	}
+	defer storage.Close()
+
+	if err := migrate.UpWithLog(context.Background(), storage.Migrate, log); err != nil {
+		return err
+	}
+
+	places, err := seed.Load(args)
+	if err != nil {
+		log.Error("Failed to load fixtures", slog.String("error", err.Error()))
+		return err
+	}
+	added, err := storage.SeedPlaces(context.Background(), places)
+	if err != nil {
+		log.Error("Failed to seed places", slog.String("error", err.Error()))
+		return err
+	}
+	log.Info("Places seeded", slog.Int("added", added), slog.Int("skipped", len(places)-added))
+	return nil
+}
+
+// runImport runs the import subcommand, which imports the places from Foursquare once.
+func runImport(cfg *config.Config, log *slog.Logger) error {
+	if cfg.FoursquareAPIKey == "" {
+		log.Error("FOURSQUARE_API_KEY is required to import places")
+		return fmt.Errorf("no Foursquare API key")
+	}
+
+	storage, err := storage.NewPostgresStorage(cfg.PostgresAddress + "?sslmode=disable")
+	if err != nil {
+		log.Error("Failed to connect to PostgreSQL", slog.String("error", err.Error()))
+		return err
+	}
+	defer storage.Close()
+
+	if err := migrate.UpWithLog(context.Background(), storage.Migrate, log); err != nil {
+		return err
+	}
+
+	if _, err := newImporter(cfg, storage, log).Import(context.Background()); err != nil {
+		log.Error("Failed to import places", slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
+
+func newImporter(cfg *config.Config, storage *storage.PostgresStorage, log *slog.Logger) *importer.Importer {
+	client := foursquare.NewClient(&http.Client{Timeout: 30 * time.Second}, cfg.FoursquareURL, cfg.FoursquareAPIKey)
+	return importer.NewImporter(cfg, client, storage, log)
+}
@@ -1,6 +1,17 @@
 package config
 
-import "os"
+import (
+	"os"
+	"time"
+)
+
+// defaultFoursquareCategories are the sights, museums, theatres, parks and other leisure categories.
+const defaultFoursquareCategories = "10001,10002,10004,10009,10027,10028,10029,10030,10031,10044,10046,10047,10056," +
+	"10058,10059,10068,10069,16005,16011,16020,16025,16026,16031,16034,16035,16038,16039,16041,16046,16047,16052"
+
+// defaultFoursquareRegion outlines the Republic of Tatarstan.
+const defaultFoursquareRegion = "54.9887,48.0821~56.2968,49.1917~56.5096,50.3453~55.8923,51.4659~55.7380,54.0586~" +
+	"55.1836,53.0369~54.3534,53.2347~54.7675,51.1912~54.9193,49.2466~54.6405,48.6644"
 
 type Config struct {
 	Env                string
@@ -9,15 +20,56 @@ type Config struct {
 	QueueNotifications string
 	QueuePurchases     string
 	PostgresAddress    string
+	OutboxInterval     time.Duration
+	Location           *time.Location
+	SlotDays           int
+	SlotDuration       time.Duration
+	SlotCapacity       int
+	SlotsInterval      time.Duration
+	RefundWindow       time.Duration
+	TicketCodeSecret   string
+	// FoursquareAPIKey enables the importer, places are not imported from Foursquare without it.
+	FoursquareAPIKey string
+	FoursquareURL    string
+	// FoursquareCategories are the comma separated IDs of the imported Foursquare categories.
+	FoursquareCategories string
+	// FoursquareRegion is the polygon places are imported from, as lat,lng points separated by ~.
+	FoursquareRegion   string
+	FoursquarePageSize int
+	FoursquareMaxPages int
+	// ImportInterval is how often the service imports places while it runs.
+	ImportInterval time.Duration
 }
 
 func MustLoad() *Config {
 	return &Config{
-		Env:                "local",
-		Address:            os.Getenv("SERVICE_ADDRESS"),
-		RabbitMQAddress:    os.Getenv("RABBITMQ_ADDRESS"),
-		QueueNotifications: os.Getenv("QUEUE_NOTIFICATIONS"),
-		QueuePurchases:     os.Getenv("QUEUE_PURCHASES"),
-		PostgresAddress:    os.Getenv("POSTGRES_ADDRESS"),
+		Env:                  "local",
+		Address:              os.Getenv("SERVICE_ADDRESS"),
+		RabbitMQAddress:      os.Getenv("RABBITMQ_ADDRESS"),
+		QueueNotifications:   os.Getenv("QUEUE_NOTIFICATIONS"),
+		QueuePurchases:       os.Getenv("QUEUE_PURCHASES"),
+		PostgresAddress:      os.Getenv("POSTGRES_ADDRESS"),
+		OutboxInterval:       time.Second,
+		Location:             time.FixedZone("MSK", 3*60*60),
+		SlotDays:             14,
+		SlotDuration:         time.Hour,
+		SlotCapacity:         50,
+		SlotsInterval:        time.Hour,
+		RefundWindow:         24 * time.Hour,
+		TicketCodeSecret:     os.Getenv("TICKET_CODE_SECRET"),
+		FoursquareAPIKey:     os.Getenv("FOURSQUARE_API_KEY"),
+		FoursquareURL:        getEnv("FOURSQUARE_URL", "https://api.foursquare.com/v3"),
+		FoursquareCategories: getEnv("FOURSQUARE_CATEGORIES", defaultFoursquareCategories),
+		FoursquareRegion:     getEnv("FOURSQUARE_REGION", defaultFoursquareRegion),
+		FoursquarePageSize:   50,
+		FoursquareMaxPages:   100,
+		ImportInterval:       24 * time.Hour,
+	}
+}
+
+func getEnv(key string, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
 	}
+	return fallback
 }
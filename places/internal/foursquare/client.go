@@ -0,0 +1,114 @@
+package foursquare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// fields are the place fields requested from the search.
+const fields = "fsq_id,categories,name,description,geocodes,location,tel,photos,website"
+
+// nextLink matches the link to the next page in the Link header of a search response.
+var nextLink = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+
+// HTTPClient sends the requests of Client. *http.Client implements it, tests can use a client for a fixture server
+// or one replaying recorded responses.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+type Client struct {
+	http    HTTPClient
+	baseURL string
+	apiKey  string
+}
+
+// NewClient returns a client for the Places API at baseURL, like https://api.foursquare.com/v3.
+func NewClient(httpClient HTTPClient, baseURL string, apiKey string) *Client {
+	return &Client{http: httpClient, baseURL: strings.TrimSuffix(baseURL, "/"), apiKey: apiKey}
+}
+
+type SearchQuery struct {
+	// Categories are the comma separated IDs of the categories to search.
+	Categories string
+	// Polygon is the searched region as lat,lng points separated by ~.
+	Polygon string
+	// Limit is the number of places on a page, at most 50.
+	Limit int
+	// MaxPages stops runaway pagination, the search fails when there are more pages.
+	MaxPages int
+}
+
+// Search returns the places matching query from every page of results.
+func (c *Client) Search(ctx context.Context, query SearchQuery) ([]Place, error) {
+	const op = "foursquare.Search"
+
+	params := url.Values{}
+	params.Set("categories", query.Categories)
+	params.Set("polygon", query.Polygon)
+	params.Set("exclude_all_chains", "true")
+	params.Set("fields", fields)
+	params.Set("limit", strconv.Itoa(query.Limit))
+
+	var places []Place
+	for page := 1; ; page++ {
+		if page > query.MaxPages {
+			return nil, fmt.Errorf("%s: more than %d pages of results", op, query.MaxPages)
+		}
+
+		response, next, err := c.searchPage(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("%s: page %d: %w", op, page, err)
+		}
+		places = append(places, response.Results...)
+		if next == nil {
+			return places, nil
+		}
+		params = next
+	}
+}
+
+// searchPage fetches one page of results and returns the parameters of the next page, nil on the last one. Only the
+// parameters of the next page link are used, so the client keeps sending requests to baseURL.
+func (c *Client) searchPage(ctx context.Context, params url.Values) (*SearchResponse, url.Values, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/places/search?"+params.Encode(), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Accept-Language", "ru")
+	req.Header.Set("Authorization", c.apiKey)
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return nil, nil, fmt.Errorf("unexpected status code %d: %s", res.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var response SearchResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	match := nextLink.FindStringSubmatch(res.Header.Get("Link"))
+	if match == nil {
+		return &response, nil, nil
+	}
+	link, err := url.Parse(match[1])
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid next page link %q: %w", match[1], err)
+	}
+	return &response, link.Query(), nil
+}
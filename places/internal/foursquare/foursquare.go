@@ -0,0 +1,91 @@
+// Package foursquare converts places from the Foursquare Places API, or files exported from it, into places of the
+// service.
+package foursquare
+
+import (
+	"errors"
+	"github.com/GP-Hacks/kdt2024-places/internal/storage"
+	"hash/fnv"
+)
+
+// SearchResponse is the response of the place search, export files have the same format.
+type SearchResponse struct {
+	Results []Place `json:"results" yaml:"results"`
+}
+
+type Place struct {
+	ID          string     `json:"fsq_id" yaml:"fsq_id"`
+	Categories  []Category `json:"categories" yaml:"categories"`
+	Description string     `json:"description,omitempty" yaml:"description"`
+	Geocodes    struct {
+		Main struct {
+			Latitude  float64 `json:"latitude" yaml:"latitude"`
+			Longitude float64 `json:"longitude" yaml:"longitude"`
+		} `json:"main" yaml:"main"`
+	} `json:"geocodes" yaml:"geocodes"`
+	Location struct {
+		FormattedAddress string `json:"formatted_address" yaml:"formatted_address"`
+	} `json:"location" yaml:"location"`
+	Name    string  `json:"name" yaml:"name"`
+	Tel     string  `json:"tel,omitempty" yaml:"tel"`
+	Website string  `json:"website,omitempty" yaml:"website"`
+	Photos  []Photo `json:"photos,omitempty" yaml:"photos"`
+}
+
+type Category struct {
+	Name string `json:"name" yaml:"name"`
+}
+
+type Photo struct {
+	Prefix string `json:"prefix" yaml:"prefix"`
+	Suffix string `json:"suffix" yaml:"suffix"`
+}
+
+// categoryNames shortens Foursquare category names too long for the app.
+var categoryNames = map[string]string{
+	"Историческое место или особо охраняемая территория": "Историческое место",
+}
+
+// ToStorage returns the place as stored by the service, its category is the first Foursquare category.
+func (p *Place) ToStorage() (*storage.SeedPlace, error) {
+	if p.ID == "" || p.Name == "" {
+		return nil, errors.New("place needs an fsq_id and a name")
+	}
+	if len(p.Categories) == 0 {
+		return nil, errors.New("place " + p.ID + " has no category")
+	}
+
+	category := p.Categories[0].Name
+	if name, ok := categoryNames[category]; ok {
+		category = name
+	}
+
+	photos := make([]string, 0, len(p.Photos))
+	for _, photo := range p.Photos {
+		photos = append(photos, photo.Prefix+"original"+photo.Suffix)
+	}
+
+	return &storage.SeedPlace{
+		ExternalID: p.ID,
+		Place: storage.Place{
+			Category:    category,
+			Description: p.Description,
+			Latitude:    p.Geocodes.Main.Latitude,
+			Longitude:   p.Geocodes.Main.Longitude,
+			Location:    p.Location.FormattedAddress,
+			Name:        p.Name,
+			Tel:         p.Tel,
+			Website:     p.Website,
+			Cost:        ticketCost(p.ID),
+		},
+		Photos: photos,
+	}, nil
+}
+
+// ticketCost returns a ticket price between 200 and 699. Foursquare has no prices, so one is derived from the ID to
+// stay the same when the place is imported again.
+func ticketCost(id string) int {
+	hash := fnv.New32a()
+	hash.Write([]byte(id))
+	return 200 + int(hash.Sum32()%500)
+}
@@ -0,0 +1,216 @@
+package handler
+
+import (
+	"context"
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-places/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/url"
+	"unicode/utf8"
+)
+
+func (h *GRPCHandler) CreatePlace(ctx context.Context, request *proto.CreatePlaceRequest) (*proto.PlaceResponse, error) {
+	h.logger.Debug("Processing CreatePlace request", slog.Any("request", request))
+
+	actor, err := h.requireAdmin(ctx, "CreatePlace")
+	if err != nil {
+		return nil, err
+	}
+
+	place, err := placeFromInput(request.GetPlace())
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := h.storage.CreatePlace(ctx, actor, place)
+	if err != nil {
+		return nil, h.handleStorageError(err, "place")
+	}
+
+	h.logger.Info("Place created", slog.String("actor", actor), slog.Int("place_id", created.ID))
+	return &proto.PlaceResponse{Response: "Place created", Place: toProtoPlace(created, nil)}, nil
+}
+
+func (h *GRPCHandler) UpdatePlace(ctx context.Context, request *proto.UpdatePlaceRequest) (*proto.PlaceResponse, error) {
+	h.logger.Debug("Processing UpdatePlace request", slog.Any("request", request))
+
+	actor, err := h.requireAdmin(ctx, "UpdatePlace")
+	if err != nil {
+		return nil, err
+	}
+
+	if request.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid place id")
+	}
+	place, err := placeFromInput(request.GetPlace())
+	if err != nil {
+		return nil, err
+	}
+	place.ID = int(request.GetId())
+
+	updated, err := h.storage.UpdatePlace(ctx, actor, place)
+	if err != nil {
+		return nil, h.handleStorageError(err, "place")
+	}
+	photos, err := h.getPlacePhotos(ctx, updated.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("Place updated", slog.String("actor", actor), slog.Int("place_id", updated.ID))
+	return &proto.PlaceResponse{Response: "Place updated", Place: toProtoPlace(updated, photos)}, nil
+}
+
+func (h *GRPCHandler) DeletePlace(ctx context.Context, request *proto.DeletePlaceRequest) (*proto.DeletePlaceResponse, error) {
+	h.logger.Debug("Processing DeletePlace request", slog.Any("request", request))
+
+	actor, err := h.requireAdmin(ctx, "DeletePlace")
+	if err != nil {
+		return nil, err
+	}
+
+	placeID := int(request.GetId())
+	if placeID <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid place id")
+	}
+
+	if err := h.storage.DeletePlace(ctx, actor, placeID); err != nil {
+		return nil, h.handleStorageError(err, "place")
+	}
+
+	h.logger.Info("Place deleted", slog.String("actor", actor), slog.Int("place_id", placeID))
+	return &proto.DeletePlaceResponse{Response: "Place deleted"}, nil
+}
+
+func (h *GRPCHandler) AddPlacePhoto(ctx context.Context, request *proto.AddPlacePhotoRequest) (*proto.PlacePhotoResponse, error) {
+	h.logger.Debug("Processing AddPlacePhoto request", slog.Any("request", request))
+
+	actor, err := h.requireAdmin(ctx, "AddPlacePhoto")
+	if err != nil {
+		return nil, err
+	}
+
+	placeID := int(request.GetPlaceId())
+	if placeID <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid place id")
+	}
+	if !isURL(request.GetUrl()) {
+		return nil, status.Errorf(codes.InvalidArgument, "Photo must be an http(s) URL")
+	}
+
+	photo, err := h.storage.AddPhoto(ctx, actor, placeID, request.GetUrl())
+	if err != nil {
+		return nil, h.handleStorageError(err, "place")
+	}
+
+	h.logger.Info("Place photo added", slog.String("actor", actor), slog.Int("place_id", placeID), slog.Int("photo_id", photo.ID))
+	return &proto.PlacePhotoResponse{Response: "Photo added", Photo: &proto.Photo{Id: int32(photo.ID), Url: photo.Url}}, nil
+}
+
+func (h *GRPCHandler) DeletePlacePhoto(ctx context.Context, request *proto.DeletePlacePhotoRequest) (*proto.DeletePlaceResponse, error) {
+	h.logger.Debug("Processing DeletePlacePhoto request", slog.Any("request", request))
+
+	actor, err := h.requireAdmin(ctx, "DeletePlacePhoto")
+	if err != nil {
+		return nil, err
+	}
+
+	placeID := int(request.GetPlaceId())
+	photoID := int(request.GetPhotoId())
+	if placeID <= 0 || photoID <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid place or photo id")
+	}
+
+	if err := h.storage.DeletePhoto(ctx, actor, placeID, photoID); err != nil {
+		return nil, h.handleStorageError(err, "photo")
+	}
+
+	h.logger.Info("Place photo deleted", slog.String("actor", actor), slog.Int("place_id", placeID), slog.Int("photo_id", photoID))
+	return &proto.DeletePlaceResponse{Response: "Photo deleted"}, nil
+}
+
+// requireAdmin returns the ID of the admin making the request.
+func (h *GRPCHandler) requireAdmin(ctx context.Context, method string) (string, error) {
+	select {
+	case <-ctx.Done():
+		h.logger.Warn(method + " request was cancelled by the client")
+		return "", status.Errorf(codes.Canceled, "Request was cancelled")
+	default:
+	}
+
+	actor, err := auth.RequireRole(ctx, auth.RoleAdmin)
+	if err != nil {
+		h.logger.Warn(method+" request is not allowed", slog.String("error", err.Error()))
+		return "", err
+	}
+	return actor, nil
+}
+
+// placeFromInput validates the fields of a place.
+func placeFromInput(input *proto.PlaceInput) (*storage.Place, error) {
+	if input == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Place is required")
+	}
+
+	required := []struct{ name, value string }{
+		{"category", input.GetCategory()},
+		{"name", input.GetName()},
+		{"location", input.GetLocation()},
+	}
+	for _, field := range required {
+		if field.value == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "Field %s is required", field.name)
+		}
+	}
+	if utf8.RuneCountInString(input.GetCategory()) > 255 || utf8.RuneCountInString(input.GetName()) > 255 {
+		return nil, status.Errorf(codes.InvalidArgument, "Category and name must be at most 255 characters")
+	}
+	if utf8.RuneCountInString(input.GetTel()) > 50 {
+		return nil, status.Errorf(codes.InvalidArgument, "Tel must be at most 50 characters")
+	}
+	if input.GetWebsite() != "" && (!isURL(input.GetWebsite()) || utf8.RuneCountInString(input.GetWebsite()) > 255) {
+		return nil, status.Errorf(codes.InvalidArgument, "Website must be an http(s) URL of at most 255 characters")
+	}
+	if input.GetLatitude() < -90 || input.GetLatitude() > 90 || input.GetLongitude() < -180 || input.GetLongitude() > 180 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid coordinates")
+	}
+	if input.GetCost() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Cost must not be negative")
+	}
+
+	return &storage.Place{
+		Category:    input.GetCategory(),
+		Description: input.GetDescription(),
+		Latitude:    input.GetLatitude(),
+		Longitude:   input.GetLongitude(),
+		Location:    input.GetLocation(),
+		Name:        input.GetName(),
+		Tel:         input.GetTel(),
+		Website:     input.GetWebsite(),
+		Cost:        int(input.GetCost()),
+	}, nil
+}
+
+func toProtoPlace(place *storage.Place, photos []*proto.Photo) *proto.Place {
+	return &proto.Place{
+		Id:          int32(place.ID),
+		Category:    place.Category,
+		Description: place.Description,
+		Latitude:    place.Latitude,
+		Longitude:   place.Longitude,
+		Location:    place.Location,
+		Name:        place.Name,
+		Tel:         place.Tel,
+		Website:     place.Website,
+		Cost:        int32(place.Cost),
+		Photos:      photos,
+	}
+}
+
+func isURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
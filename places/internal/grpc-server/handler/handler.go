@@ -6,28 +6,47 @@ import (
 	"errors"
 	"fmt"
 	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/pagination"
 	"github.com/GP-Hacks/kdt2024-places/config"
 	"github.com/GP-Hacks/kdt2024-places/internal/storage"
+	"github.com/GP-Hacks/kdt2024-places/internal/ticketcode"
 	"github.com/jackc/pgx/v5"
-	"github.com/streadway/amqp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"log/slog"
 	"math"
-	"sort"
 	"time"
 )
 
 type NotificationMessage struct {
-	UserID  string    `json:"user_id"`
-	Header  string    `json:"header"`
-	Content string    `json:"content"`
-	Time    time.Time `json:"time"`
+	UserID       string                             `json:"user_id"`
+	Key          string                             `json:"key,omitempty"`
+	Action       string                             `json:"action,omitempty"`
+	Category     string                             `json:"category,omitempty"`
+	Header       string                             `json:"header"`
+	Content      string                             `json:"content"`
+	Time         time.Time                          `json:"time"`
+	ExpiresAt    time.Time                          `json:"expires_at,omitempty"`
+	Translations map[string]NotificationTranslation `json:"translations,omitempty"`
 }
 
+type NotificationTranslation struct {
+	Header  string `json:"header"`
+	Content string `json:"content"`
+}
+
+const (
+	// notificationActionCancel asks the notifications service to drop the scheduled notification with the same key.
+	notificationActionCancel = "cancel"
+	// notificationCategoryTickets lets users opt out of ticket notifications.
+	notificationCategoryTickets = "tickets"
+)
+
 type PurchaseMessage struct {
+	TicketID     int       `json:"ticket_id"`
 	UserToken    string    `json:"user_token"`
 	PlaceID      int       `json:"place_id"`
 	EventTime    time.Time `json:"event_time"`
@@ -35,47 +54,32 @@ type PurchaseMessage struct {
 	Cost         int       `json:"cost"`
 }
 
-const EarthRadius = 6371
-
-func distance(lat1, lon1, lat2, lon2 float64) float64 {
-	lat1, lon1 = toRadians(lat1), toRadians(lon1)
-	lat2, lon2 = toRadians(lat2), toRadians(lon2)
-
-	dlat := lat2 - lat1
-	dlon := lon2 - lon1
-
-	a := math.Sin(dlat/2)*math.Sin(dlat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dlon/2)*math.Sin(dlon/2)
-	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
-
-	return EarthRadius * c
-}
-
-func toRadians(deg float64) float64 {
-	return deg * math.Pi / 180
+type RefundMessage struct {
+	TicketID     int       `json:"ticket_id"`
+	UserToken    string    `json:"user_token"`
+	RefundAmount int       `json:"refund_amount"`
+	RefundTime   time.Time `json:"refund_time"`
 }
 
-func roundMinutes(t time.Time) time.Time {
-	minute := t.Minute()
-	roundedMinute := 5 * ((minute + 4) / 5)
-
-	if roundedMinute == 60 {
-		roundedMinute = 0
-		t = t.Add(time.Hour)
-	}
+const maxIdempotencyKeyLength = 255
 
-	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), roundedMinute, 0, 0, t.Location())
-}
+const (
+	// placeTimesWindow limits the slot start times listed in GetPlaces.
+	placeTimesWindow = 24 * time.Hour
+	// defaultSlotsWindow is used by GetAvailableSlots when the request has no upper bound.
+	defaultSlotsWindow = 7 * 24 * time.Hour
+)
 
 type GRPCHandler struct {
 	cfg *config.Config
 	proto.UnimplementedPlacesServiceServer
 	storage *storage.PostgresStorage
+	signer  *ticketcode.Signer
 	logger  *slog.Logger
-	mqch    *amqp.Channel
 }
 
-func NewGRPCHandler(cfg *config.Config, server *grpc.Server, storage *storage.PostgresStorage, logger *slog.Logger, mqch *amqp.Channel) *GRPCHandler {
-	handler := &GRPCHandler{cfg: cfg, storage: storage, logger: logger, mqch: mqch}
+func NewGRPCHandler(cfg *config.Config, server *grpc.Server, storage *storage.PostgresStorage, signer *ticketcode.Signer, logger *slog.Logger) *GRPCHandler {
+	handler := &GRPCHandler{cfg: cfg, storage: storage, signer: signer, logger: logger}
 	proto.RegisterPlacesServiceServer(server, handler)
 	logger.Info("gRPC handler successfully registered")
 	return handler
@@ -100,23 +104,54 @@ func (h *GRPCHandler) GetTickets(ctx context.Context, request *proto.GetTicketsR
 	default:
 	}
 
-	userToken := request.GetToken()
-	tickets, err := h.storage.GetTickets(ctx, userToken)
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		h.logger.Warn("GetTickets request is not authenticated")
+		return nil, err
+	}
+
+	query := &storage.TicketsQuery{UserToken: userID}
+	if query.Sort, err = pagination.ParseSort(request.GetSort(), storage.TicketSortID, storage.TicketSortID, storage.TicketSortEventTime); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if query.Limit, err = pagination.PageSize(request.GetPageSize()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if query.AfterID, err = pagination.Decode(request.GetPageToken(), query.Sort, &query.AfterTime); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tickets, hasMore, err := h.storage.GetTickets(ctx, query)
 	if err != nil {
 		return nil, h.handleStorageError(err, "tickets")
 	}
 	var responseTickets []*proto.Ticket
 	for _, ticket := range tickets {
 		responseTickets = append(responseTickets, &proto.Ticket{
-			Id:        int32(ticket.ID),
-			Name:      ticket.Name,
-			Location:  ticket.Location,
-			Timestamp: timestamppb.New(ticket.EventTime),
+			Id:           int32(ticket.ID),
+			Name:         ticket.Name,
+			Location:     ticket.Location,
+			Timestamp:    timestamppb.New(ticket.EventTime),
+			Cancelled:    ticket.Cancelled,
+			RefundAmount: int32(ticket.RefundAmount),
 		})
 	}
 
+	response := &proto.GetTicketsResponse{Response: responseTickets}
+	if hasMore {
+		last := tickets[len(tickets)-1]
+		var key interface{}
+		if query.Sort.Key == storage.TicketSortEventTime {
+			key = last.EventTime
+		}
+		if response.NextPageToken, err = pagination.Encode(query.Sort, key, last.ID); err != nil {
+			h.logger.Error("Failed to encode page token", slog.String("error", err.Error()))
+			return nil, status.Errorf(codes.Internal, "An internal error occurred, please try again later")
+		}
+	}
+
 	h.logger.Info("Tickets retrieved successfully")
-	return &proto.GetTicketsResponse{Response: responseTickets}, nil
+	return response, nil
 }
 
 func (h *GRPCHandler) GetPlaces(ctx context.Context, request *proto.GetPlacesRequest) (*proto.GetPlacesResponse, error) {
@@ -129,38 +164,43 @@ func (h *GRPCHandler) GetPlaces(ctx context.Context, request *proto.GetPlacesReq
 	default:
 	}
 
-	var places []*storage.Place
-	var err error
-
-	category := request.GetCategory()
-	if category == "all" {
-		places, err = h.storage.GetPlaces(ctx)
-	} else {
-		places, err = h.storage.GetPlacesByCategory(ctx, category)
+	query, err := placesQuery(request)
+	if err != nil {
+		h.logger.Warn("Invalid GetPlaces request", slog.String("error", err.Error()))
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+
+	places, hasMore, err := h.storage.SearchPlaces(ctx, query)
 	if err != nil {
 		return nil, h.handleStorageError(err, "places")
 	}
 
 	h.logger.Info("Places retrieved from the database", slog.Int("count", len(places)))
 
-	userLatitude := request.GetLatitude()
-	userLongitude := request.GetLongitude()
+	placeIDs := make([]int, 0, len(places))
+	for _, place := range places {
+		placeIDs = append(placeIDs, place.ID)
+	}
 
-	sort.Slice(places, func(i, j int) bool {
-		return distance(places[i].Latitude, places[i].Longitude, userLatitude, userLongitude) <
-			distance(places[j].Latitude, places[j].Longitude, userLatitude, userLongitude)
-	})
+	now := time.Now()
+	slotTimes, err := h.storage.GetAvailableSlotTimes(ctx, placeIDs, now, now.Add(placeTimesWindow))
+	if err != nil {
+		return nil, h.handleStorageError(err, "slots")
+	}
+
+	photos, err := h.storage.GetPhotosByPlaceIDs(ctx, placeIDs)
+	if err != nil {
+		return nil, h.handleStorageError(err, "photos")
+	}
 
 	var responsePlaces []*proto.Place
 	for _, place := range places {
-		protoPhotos, err := h.getPlacePhotos(ctx, place.ID)
-		if err != nil {
-			return nil, err
-		}
+		protoPhotos := toProtoPhotos(photos[place.ID])
 
-		times := []string{place.Time, roundMinutes(time.Now().Add(3 * time.Hour)).Format("15:04")}
-		sort.Strings(times)
+		times := []string{}
+		for _, startTime := range slotTimes[place.ID] {
+			times = append(times, startTime.In(h.cfg.Location).Format("15:04"))
+		}
 
 		responsePlaces = append(responsePlaces, &proto.Place{
 			Id:          int32(place.ID),
@@ -175,11 +215,70 @@ func (h *GRPCHandler) GetPlaces(ctx context.Context, request *proto.GetPlacesReq
 			Cost:        int32(place.Cost),
 			Times:       times,
 			Photos:      protoPhotos,
+			Distance:    place.Distance,
 		})
 	}
 
-	h.logger.Info("Successfully processed GetPlaces request", slog.Int("response_count", len(responsePlaces)))
-	return &proto.GetPlacesResponse{Response: responsePlaces}, nil
+	response := &proto.GetPlacesResponse{Response: responsePlaces}
+	if hasMore {
+		last := places[len(places)-1]
+		if response.NextPageToken, err = pagination.Encode(query.Sort, last.SortKey(query.Sort.Key), last.ID); err != nil {
+			h.logger.Error("Failed to encode page token", slog.String("error", err.Error()))
+			return nil, status.Errorf(codes.Internal, "An internal error occurred, please try again later")
+		}
+	}
+
+	h.logger.Info("Successfully processed GetPlaces request", slog.Int("response_count", len(responsePlaces)), slog.Bool("has_more", hasMore))
+	return response, nil
+}
+
+// placesQuery validates the filters and page of the request.
+func placesQuery(request *proto.GetPlacesRequest) (*storage.PlacesQuery, error) {
+	query := &storage.PlacesQuery{
+		Latitude:  request.GetLatitude(),
+		Longitude: request.GetLongitude(),
+		Radius:    request.GetRadius(),
+	}
+	if category := request.GetCategory(); category != "all" {
+		query.Category = category
+	}
+
+	if !validCoordinates(query.Latitude, query.Longitude) {
+		return nil, errors.New("Latitude must be between -90 and 90 and longitude between -180 and 180")
+	}
+	if query.Radius < 0 || math.IsNaN(query.Radius) || math.IsInf(query.Radius, 0) {
+		return nil, errors.New("Radius must be a non-negative number of meters")
+	}
+	if box := request.GetBoundingBox(); box != nil {
+		if !validCoordinates(box.GetMinLatitude(), box.GetMinLongitude()) || !validCoordinates(box.GetMaxLatitude(), box.GetMaxLongitude()) {
+			return nil, errors.New("Bounding box corners must have latitudes between -90 and 90 and longitudes between -180 and 180")
+		}
+		if box.GetMinLatitude() > box.GetMaxLatitude() {
+			return nil, errors.New("Bounding box min latitude must not be greater than its max latitude")
+		}
+		query.Box = &storage.BoundingBox{
+			MinLatitude:  box.GetMinLatitude(),
+			MinLongitude: box.GetMinLongitude(),
+			MaxLatitude:  box.GetMaxLatitude(),
+			MaxLongitude: box.GetMaxLongitude(),
+		}
+	}
+
+	var err error
+	if query.Sort, err = pagination.ParseSort(request.GetSort(), storage.PlaceSortDistance, storage.PlaceSortDistance, storage.PlaceSortCost); err != nil {
+		return nil, err
+	}
+	if query.Limit, err = pagination.PageSize(request.GetPageSize()); err != nil {
+		return nil, err
+	}
+	if query.AfterID, err = pagination.Decode(request.GetPageToken(), query.Sort, &query.AfterKey); err != nil {
+		return nil, err
+	}
+	return query, nil
+}
+
+func validCoordinates(latitude, longitude float64) bool {
+	return latitude >= -90 && latitude <= 90 && longitude >= -180 && longitude <= 180
 }
 
 func (h *GRPCHandler) getPlacePhotos(ctx context.Context, placeID int) ([]*proto.Photo, error) {
@@ -191,18 +290,21 @@ func (h *GRPCHandler) getPlacePhotos(ctx context.Context, placeID int) ([]*proto
 	}
 	if placePhotos == nil {
 		h.logger.Warn("No photos found for place", slog.Int("place_id", placeID))
-		placePhotos = []*storage.Photo{}
-	}
-
-	var protoPhotos []*proto.Photo
-	for _, placePhoto := range placePhotos {
-		protoPhotos = append(protoPhotos, &proto.Photo{Url: placePhoto.Url})
 	}
 
+	protoPhotos := toProtoPhotos(placePhotos)
 	h.logger.Info("Photos retrieved successfully", slog.Int("place_id", placeID), slog.Int("photo_count", len(protoPhotos)))
 	return protoPhotos, nil
 }
 
+func toProtoPhotos(photos []*storage.Photo) []*proto.Photo {
+	var protoPhotos []*proto.Photo
+	for _, photo := range photos {
+		protoPhotos = append(protoPhotos, &proto.Photo{Id: int32(photo.ID), Url: photo.Url})
+	}
+	return protoPhotos
+}
+
 func (h *GRPCHandler) BuyTicket(ctx context.Context, request *proto.BuyTicketRequest) (*proto.BuyTicketResponse, error) {
 	h.logger.Debug("Processing BuyTicket request", slog.Any("request", request))
 
@@ -213,52 +315,314 @@ func (h *GRPCHandler) BuyTicket(ctx context.Context, request *proto.BuyTicketReq
 	default:
 	}
 
-	dbPlace, err := h.storage.GetPlaceById(ctx, int(request.GetPlaceId()))
+	userID, err := auth.RequireUserID(ctx)
 	if err != nil {
-		return nil, h.handleStorageError(err, "place")
+		h.logger.Warn("BuyTicket request is not authenticated")
+		return nil, err
 	}
 
-	message := NotificationMessage{
-		UserID:  request.GetToken(),
-		Header:  "Напоминание о покупке!",
-		Content: fmt.Sprintf("Вы приобрели билет на %s в %s", dbPlace.Name, request.GetTimestamp().AsTime().Format("15:04")),
-		Time:    request.GetTimestamp().AsTime().Add(-15 * time.Minute),
-	}
-	if err := h.publishToRabbitMQ(message, h.cfg.QueueNotifications); err != nil {
-		h.logger.Error("Failed to publish notification message to RabbitMQ", slog.Any("error", err.Error()))
-		return nil, status.Errorf(codes.Internal, "An error occurred while processing your purchase")
+	if len(request.GetIdempotencyKey()) > maxIdempotencyKeyLength {
+		h.logger.Warn("Idempotency key is too long", slog.Int("length", len(request.GetIdempotencyKey())))
+		return nil, status.Errorf(codes.InvalidArgument, "Idempotency key must be at most %d characters", maxIdempotencyKeyLength)
 	}
-	h.logger.Info("Notification message successfully published to RabbitMQ", slog.String("queue", h.cfg.QueueNotifications))
 
-	purchaseMessage := PurchaseMessage{
-		UserToken:    request.GetToken(),
-		PlaceID:      dbPlace.ID,
-		EventTime:    request.GetTimestamp().AsTime(),
-		PurchaseTime: time.Now(),
-		Cost:         dbPlace.Cost,
+	dbPlace, err := h.storage.GetPlaceById(ctx, int(request.GetPlaceId()))
+	if err != nil {
+		return nil, h.handleStorageError(err, "place")
 	}
-	if err := h.publishToRabbitMQ(purchaseMessage, h.cfg.QueuePurchases); err != nil {
-		h.logger.Error("Failed to publish purchase message to RabbitMQ", slog.Any("error", err.Error()))
-		return nil, status.Errorf(codes.Internal, "An error occurred while processing your purchase")
+
+	eventTime := request.GetTimestamp().AsTime()
+	if !eventTime.After(time.Now()) {
+		h.logger.Warn("Requested slot has already started", slog.Time("event_time", eventTime))
+		return nil, status.Errorf(codes.FailedPrecondition, "The requested time has already passed")
 	}
-	h.logger.Info("Purchase message successfully published to RabbitMQ", slog.String("queue", h.cfg.QueuePurchases))
 
-	err = h.storage.SaveTicket(ctx, &storage.Ticket{
-		Name:      dbPlace.Name,
-		Location:  dbPlace.Location,
-		UserToken: request.GetToken(),
-		EventTime: request.GetTimestamp().AsTime(),
+	ticket, created, err := h.storage.SaveTicket(ctx, &storage.Ticket{
+		PlaceID:        dbPlace.ID,
+		Name:           dbPlace.Name,
+		Location:       dbPlace.Location,
+		UserToken:      userID,
+		EventTime:      eventTime,
+		IdempotencyKey: request.GetIdempotencyKey(),
+	}, func(saved *storage.Ticket) ([]*storage.OutboxMessage, error) {
+		startsAt := saved.EventTime.In(h.cfg.Location).Format("15:04")
+		notification, err := json.Marshal(NotificationMessage{
+			UserID:    userID,
+			Key:       reminderKey(saved.ID),
+			Category:  notificationCategoryTickets,
+			Header:    "Напоминание о покупке!",
+			Content:   fmt.Sprintf("Вы приобрели билет на %s в %s", dbPlace.Name, startsAt),
+			Time:      saved.EventTime.Add(-15 * time.Minute),
+			ExpiresAt: saved.EventTime,
+			Translations: map[string]NotificationTranslation{
+				"en": {
+					Header:  "Ticket reminder",
+					Content: fmt.Sprintf("You have a ticket to %s at %s", dbPlace.Name, startsAt),
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		purchase, err := json.Marshal(PurchaseMessage{
+			TicketID:     saved.ID,
+			UserToken:    userID,
+			PlaceID:      saved.PlaceID,
+			EventTime:    saved.EventTime,
+			PurchaseTime: time.Now(),
+			Cost:         saved.Cost,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return []*storage.OutboxMessage{
+			{Queue: h.cfg.QueueNotifications, Payload: notification},
+			{Queue: h.cfg.QueuePurchases, Payload: purchase},
+		}, nil
 	})
+	if errors.Is(err, storage.ErrSlotNotFound) {
+		h.logger.Warn("No slot for the requested time", slog.Int("place_id", dbPlace.ID), slog.Time("event_time", eventTime))
+		return nil, status.Errorf(codes.FailedPrecondition, "There are no tickets for this place at the requested time")
+	}
+	if errors.Is(err, storage.ErrSlotSoldOut) {
+		h.logger.Warn("Requested slot is sold out", slog.Int("place_id", dbPlace.ID), slog.Time("event_time", eventTime))
+		return nil, status.Errorf(codes.FailedPrecondition, "Tickets for the requested time are sold out")
+	}
 	if err != nil {
 		h.logger.Error("Failed to save ticket", slog.Any("error", err.Error()))
 		return nil, status.Errorf(codes.Internal, "An error occurred while processing your purchase")
 	}
 
+	if created {
+		h.logger.Info("Ticket saved, purchase messages queued in outbox", slog.Int("ticket_id", ticket.ID))
+	} else {
+		h.logger.Info("Repeated purchase request, returning existing ticket", slog.Int("ticket_id", ticket.ID), slog.String("idempotency_key", ticket.IdempotencyKey))
+	}
+
 	return &proto.BuyTicketResponse{
 		Response: "Ticket purchased successfully",
+		TicketId: int32(ticket.ID),
+	}, nil
+}
+
+// errEventStarted rejects cancellation of tickets for events that have already started.
+var errEventStarted = errors.New("event has already started")
+
+func (h *GRPCHandler) CancelTicket(ctx context.Context, request *proto.CancelTicketRequest) (*proto.CancelTicketResponse, error) {
+	h.logger.Debug("Processing CancelTicket request", slog.Any("request", request))
+
+	select {
+	case <-ctx.Done():
+		h.logger.Warn("Request was cancelled by the client", slog.Any("request", request))
+		return nil, ctx.Err()
+	default:
+	}
+
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		h.logger.Warn("CancelTicket request is not authenticated")
+		return nil, err
+	}
+
+	ticket, err := h.storage.CancelTicket(ctx, int(request.GetTicketId()), userID, func(ticket *storage.Ticket) (int, []*storage.OutboxMessage, error) {
+		now := time.Now()
+		if !ticket.EventTime.After(now) {
+			return 0, nil, errEventStarted
+		}
+		refundAmount := h.refundAmount(ticket, now)
+
+		cancelReminder, err := json.Marshal(NotificationMessage{
+			UserID: userID,
+			Key:    reminderKey(ticket.ID),
+			Action: notificationActionCancel,
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+		messages := []*storage.OutboxMessage{
+			{Queue: h.cfg.QueueNotifications, Payload: cancelReminder},
+		}
+		if refundAmount > 0 {
+			refund, err := json.Marshal(RefundMessage{
+				TicketID:     ticket.ID,
+				UserToken:    userID,
+				RefundAmount: refundAmount,
+				RefundTime:   now,
+			})
+			if err != nil {
+				return 0, nil, err
+			}
+			messages = append(messages, &storage.OutboxMessage{Queue: h.cfg.QueuePurchases, Payload: refund})
+		}
+		return refundAmount, messages, nil
+	})
+	if errors.Is(err, storage.ErrTicketCancelled) {
+		h.logger.Warn("Ticket is already cancelled", slog.Int("ticket_id", int(request.GetTicketId())))
+		return nil, status.Errorf(codes.FailedPrecondition, "The ticket is already cancelled")
+	}
+	if errors.Is(err, errEventStarted) {
+		h.logger.Warn("Ticket event has already started", slog.Int("ticket_id", int(request.GetTicketId())))
+		return nil, status.Errorf(codes.FailedPrecondition, "The event has already started and the ticket can no longer be cancelled")
+	}
+	if err != nil {
+		return nil, h.handleStorageError(err, "ticket")
+	}
+
+	h.logger.Info("Ticket cancelled", slog.Int("ticket_id", ticket.ID), slog.String("user_id", userID), slog.Int("refund_amount", ticket.RefundAmount))
+	return &proto.CancelTicketResponse{
+		Response:     "Ticket cancelled successfully",
+		RefundAmount: int32(ticket.RefundAmount),
+	}, nil
+}
+
+// refundAmount applies the refund policy: the full cost is returned until RefundWindow before the event, nothing after.
+func (h *GRPCHandler) refundAmount(ticket *storage.Ticket, now time.Time) int {
+	if now.Before(ticket.EventTime.Add(-h.cfg.RefundWindow)) {
+		return ticket.Cost
+	}
+	return 0
+}
+
+func reminderKey(ticketID int) string {
+	return fmt.Sprintf("ticket-%d-reminder", ticketID)
+}
+
+func (h *GRPCHandler) GetTicketCode(ctx context.Context, request *proto.GetTicketCodeRequest) (*proto.GetTicketCodeResponse, error) {
+	h.logger.Debug("Processing GetTicketCode request", slog.Any("request", request))
+
+	select {
+	case <-ctx.Done():
+		h.logger.Warn("Request was cancelled by the client", slog.Any("request", request))
+		return nil, ctx.Err()
+	default:
+	}
+
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		h.logger.Warn("GetTicketCode request is not authenticated")
+		return nil, err
+	}
+
+	ticket, err := h.storage.GetTicket(ctx, int(request.GetTicketId()), userID)
+	if err != nil {
+		return nil, h.handleStorageError(err, "ticket")
+	}
+	if ticket.Cancelled {
+		h.logger.Warn("Code requested for a cancelled ticket", slog.Int("ticket_id", ticket.ID))
+		return nil, status.Errorf(codes.FailedPrecondition, "The ticket is cancelled")
+	}
+
+	code := h.signer.Sign(ticketcode.Claims{TicketID: ticket.ID, PlaceID: ticket.PlaceID, EventTime: ticket.EventTime})
+	h.logger.Info("Ticket code issued", slog.Int("ticket_id", ticket.ID), slog.String("user_id", userID))
+	return &proto.GetTicketCodeResponse{Code: code}, nil
+}
+
+func (h *GRPCHandler) ValidateTicket(ctx context.Context, request *proto.ValidateTicketRequest) (*proto.ValidateTicketResponse, error) {
+	h.logger.Debug("Processing ValidateTicket request", slog.Int("place_id", int(request.GetPlaceId())))
+
+	select {
+	case <-ctx.Done():
+		h.logger.Warn("Request was cancelled by the client", slog.Int("place_id", int(request.GetPlaceId())))
+		return nil, ctx.Err()
+	default:
+	}
+
+	staffID, err := auth.RequireRole(ctx, auth.RoleStaff)
+	if err != nil {
+		h.logger.Warn("ValidateTicket request is not authorized", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	claims, err := h.signer.Verify(request.GetCode())
+	if err != nil {
+		h.logger.Warn("Invalid ticket code", slog.String("staff_id", staffID), slog.Int("place_id", int(request.GetPlaceId())))
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid ticket code")
+	}
+	if claims.PlaceID != int(request.GetPlaceId()) {
+		h.logger.Warn("Ticket is for a different place", slog.Int("ticket_id", claims.TicketID), slog.Int("ticket_place_id", claims.PlaceID), slog.Int("place_id", int(request.GetPlaceId())))
+		return nil, status.Errorf(codes.FailedPrecondition, "The ticket is for a different place")
+	}
+	if !sameDay(claims.EventTime, time.Now(), h.cfg.Location) {
+		h.logger.Warn("Ticket is for a different date", slog.Int("ticket_id", claims.TicketID), slog.Time("event_time", claims.EventTime))
+		return nil, status.Errorf(codes.FailedPrecondition, "The ticket is for a different date")
+	}
+
+	ticket, err := h.storage.UseTicket(ctx, claims.TicketID, claims.PlaceID, claims.EventTime)
+	if errors.Is(err, storage.ErrTicketUsed) {
+		h.logger.Warn("Ticket is already used", slog.Int("ticket_id", claims.TicketID))
+		return nil, status.Errorf(codes.FailedPrecondition, "The ticket has already been used")
+	}
+	if errors.Is(err, storage.ErrTicketCancelled) {
+		h.logger.Warn("Ticket is cancelled", slog.Int("ticket_id", claims.TicketID))
+		return nil, status.Errorf(codes.FailedPrecondition, "The ticket is cancelled")
+	}
+	if err != nil {
+		return nil, h.handleStorageError(err, "ticket")
+	}
+
+	h.logger.Info("Ticket validated", slog.Int("ticket_id", ticket.ID), slog.String("staff_id", staffID))
+	return &proto.ValidateTicketResponse{
+		Response:  "Ticket is valid",
+		TicketId:  int32(ticket.ID),
+		Name:      ticket.Name,
+		Timestamp: timestamppb.New(ticket.EventTime),
 	}, nil
 }
 
+func sameDay(a, b time.Time, location *time.Location) bool {
+	ay, am, ad := a.In(location).Date()
+	by, bm, bd := b.In(location).Date()
+	return ay == by && am == bm && ad == bd
+}
+
+func (h *GRPCHandler) GetAvailableSlots(ctx context.Context, request *proto.GetAvailableSlotsRequest) (*proto.GetAvailableSlotsResponse, error) {
+	h.logger.Debug("Processing GetAvailableSlots request", slog.Any("request", request))
+
+	select {
+	case <-ctx.Done():
+		h.logger.Warn("Request was cancelled by the client", slog.Any("request", request))
+		return nil, ctx.Err()
+	default:
+	}
+
+	from := time.Now()
+	if request.GetFrom() != nil && request.GetFrom().AsTime().After(from) {
+		from = request.GetFrom().AsTime()
+	}
+	to := from.Add(defaultSlotsWindow)
+	if request.GetTo() != nil {
+		to = request.GetTo().AsTime()
+	}
+	if !to.After(from) {
+		h.logger.Warn("Invalid slots time range", slog.Time("from", from), slog.Time("to", to))
+		return nil, status.Errorf(codes.InvalidArgument, "The end of the time range must be after its start")
+	}
+
+	if _, err := h.storage.GetPlaceById(ctx, int(request.GetPlaceId())); err != nil {
+		return nil, h.handleStorageError(err, "place")
+	}
+
+	slots, err := h.storage.GetAvailableSlots(ctx, int(request.GetPlaceId()), from, to)
+	if err != nil {
+		return nil, h.handleStorageError(err, "slots")
+	}
+
+	var responseSlots []*proto.Slot
+	for _, slot := range slots {
+		responseSlots = append(responseSlots, &proto.Slot{
+			Id:        int32(slot.ID),
+			PlaceId:   int32(slot.PlaceID),
+			Start:     timestamppb.New(slot.StartTime),
+			Capacity:  int32(slot.Capacity),
+			Available: int32(slot.Capacity - slot.Sold),
+			Cost:      int32(slot.Cost),
+		})
+	}
+
+	h.logger.Info("Available slots retrieved successfully", slog.Int("place_id", int(request.GetPlaceId())), slog.Int("count", len(responseSlots)))
+	return &proto.GetAvailableSlotsResponse{Response: responseSlots}, nil
+}
+
 func (h *GRPCHandler) GetCategories(ctx context.Context, request *proto.GetCategoriesRequest) (*proto.GetCategoriesResponse, error) {
 	h.logger.Debug("Processing GetCategories request")
 
@@ -277,37 +641,3 @@ func (h *GRPCHandler) HealthCheck(ctx context.Context, req *proto.HealthCheckReq
 		IsHealthy: true,
 	}, nil
 }
-
-func (h *GRPCHandler) publishToRabbitMQ(message interface{}, queueName string) error {
-	q, err := h.mqch.QueueDeclare(
-		queueName,
-		true,
-		false,
-		false,
-		false,
-		nil,
-	)
-	if err != nil {
-		h.logger.Error("Failed to declare a queue", slog.Any("error", err.Error()))
-		return err
-	}
-	body, err := json.Marshal(message)
-	if err != nil {
-		h.logger.Error("Failed to marshal message to JSON", slog.Any("error", err.Error()))
-		return err
-	}
-	err = h.mqch.Publish(
-		"",
-		q.Name,
-		false,
-		false,
-		amqp.Publishing{
-			ContentType: "text/plain",
-			Body:        body,
-		})
-	if err != nil {
-		h.logger.Error("Failed to publish a message", slog.Any("error", err.Error()))
-		return err
-	}
-	return nil
-}
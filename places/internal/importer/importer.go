@@ -0,0 +1,113 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/GP-Hacks/kdt2024-places/config"
+	"github.com/GP-Hacks/kdt2024-places/internal/foursquare"
+	"github.com/GP-Hacks/kdt2024-places/internal/storage"
+)
+
+// Importer keeps the places in sync with Foursquare: it fetches every place of the configured categories in the
+// configured region, updates the stored ones, adds new ones and deletes the ones Foursquare no longer returns.
+type Importer struct {
+	cfg      *config.Config
+	searcher Searcher
+	store    Store
+	logger   *slog.Logger
+}
+
+// Searcher fetches the places to import, *foursquare.Client implements it.
+type Searcher interface {
+	Search(ctx context.Context, query foursquare.SearchQuery) ([]foursquare.Place, error)
+}
+
+// Store stores the imported places, *storage.PostgresStorage implements it.
+type Store interface {
+	ImportPlaces(ctx context.Context, places []*storage.SeedPlace, skipped []string) (*storage.ImportResult, error)
+}
+
+func NewImporter(cfg *config.Config, searcher Searcher, store Store, logger *slog.Logger) *Importer {
+	return &Importer{cfg: cfg, searcher: searcher, store: store, logger: logger}
+}
+
+func (i *Importer) Run(ctx context.Context) {
+	i.logger.Info("Places importer started", slog.Duration("interval", i.cfg.ImportInterval))
+	ticker := time.NewTicker(i.cfg.ImportInterval)
+	defer ticker.Stop()
+
+	for {
+		_, err := i.Import(ctx)
+		if errors.Is(err, storage.ErrImportRunning) {
+			i.logger.Info("Places are imported by another instance, skipping")
+		} else if err != nil {
+			i.logger.Error("Failed to import places", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			i.logger.Info("Places importer stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Import runs one import. Nothing is changed when fetching any page fails or Foursquare returns no valid places, so an
+// outage never deletes the stored places. Places Foursquare returns in a form that cannot be imported are kept as
+// they are stored.
+func (i *Importer) Import(ctx context.Context) (*storage.ImportResult, error) {
+	const op = "importer.Import"
+
+	results, err := i.searcher.Search(ctx, foursquare.SearchQuery{
+		Categories: i.cfg.FoursquareCategories,
+		Polygon:    i.cfg.FoursquareRegion,
+		Limit:      i.cfg.FoursquarePageSize,
+		MaxPages:   i.cfg.FoursquareMaxPages,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("%s: Foursquare returned no places, keeping the stored ones", op)
+	}
+
+	seen := make(map[string]bool, len(results))
+	places := make([]*storage.SeedPlace, 0, len(results))
+	var skipped []string
+	for _, result := range results {
+		if seen[result.ID] {
+			continue
+		}
+		seen[result.ID] = true
+
+		place, err := result.ToStorage()
+		if err != nil {
+			i.logger.Warn("Skipping invalid Foursquare place", slog.String("fsq_id", result.ID), slog.String("error", err.Error()))
+			skipped = append(skipped, result.ID)
+			continue
+		}
+		places = append(places, place)
+	}
+	if len(places) == 0 {
+		return nil, fmt.Errorf("%s: none of the %d places returned by Foursquare is valid, keeping the stored ones", op, len(skipped))
+	}
+
+	imported, err := i.store.ImportPlaces(ctx, places, skipped)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	i.logger.Info("Places imported",
+		slog.Int("fetched", len(results)),
+		slog.Int("skipped", len(skipped)),
+		slog.Int("created", imported.Created),
+		slog.Int("updated", imported.Updated),
+		slog.Int("restored", imported.Restored),
+		slog.Int("deleted", imported.Deleted),
+	)
+	return imported, nil
+}
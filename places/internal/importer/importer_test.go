@@ -0,0 +1,227 @@
+package importer
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/GP-Hacks/kdt2024-places/config"
+	"github.com/GP-Hacks/kdt2024-places/internal/foursquare"
+	"github.com/GP-Hacks/kdt2024-places/internal/storage"
+)
+
+const testAPIKey = "fsq-test-key"
+
+// fixtureServer serves the recorded search pages in testdata like the Places API: the page is picked by the cursor
+// parameter, and every page but the last links the next one in the Link header. A status other than 200 replaces
+// the page with that number.
+type fixtureServer struct {
+	t      *testing.T
+	pages  []string
+	failAt map[int]int
+
+	mu       sync.Mutex
+	requests []*http.Request
+}
+
+func (f *fixtureServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.requests = append(f.requests, r)
+	f.mu.Unlock()
+
+	if r.URL.Path != "/v3/places/search" || r.Header.Get("Authorization") != testAPIKey {
+		http.Error(w, `{"message":"Invalid request"}`, http.StatusUnauthorized)
+		return
+	}
+	page := 1
+	if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+		page, _ = strconv.Atoi(cursor)
+	}
+	if code, ok := f.failAt[page]; ok {
+		http.Error(w, `{"message":"Internal error"}`, code)
+		return
+	}
+	if page < 1 || page > len(f.pages) {
+		http.Error(w, `{"message":"Unknown cursor"}`, http.StatusBadRequest)
+		return
+	}
+	body, err := os.ReadFile(filepath.Join("testdata", f.pages[page-1]))
+	if err != nil {
+		f.t.Errorf("failed to read fixture: %v", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if page < len(f.pages) {
+		next := r.URL.Query()
+		next.Set("cursor", strconv.Itoa(page+1))
+		w.Header().Set("Link", `<https://api.foursquare.com/v3/places/search?`+next.Encode()+`>; rel="next"`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+// recordingStore records the places it is asked to import.
+type recordingStore struct {
+	calls   int
+	places  []*storage.SeedPlace
+	skipped []string
+}
+
+func (s *recordingStore) ImportPlaces(ctx context.Context, places []*storage.SeedPlace, skipped []string) (*storage.ImportResult, error) {
+	s.calls++
+	s.places = places
+	s.skipped = skipped
+	return &storage.ImportResult{Created: len(places)}, nil
+}
+
+func newTestImporter(t *testing.T, fixture *fixtureServer, maxPages int) (*Importer, *recordingStore) {
+	t.Helper()
+	fixture.t = t
+	server := httptest.NewServer(fixture)
+	t.Cleanup(server.Close)
+
+	cfg := &config.Config{
+		FoursquareCategories: "10027,10056,16032",
+		FoursquareRegion:     "54.9887,48.0821~56.2968,49.1917~55.7380,54.0586",
+		FoursquarePageSize:   50,
+		FoursquareMaxPages:   maxPages,
+	}
+	store := &recordingStore{}
+	client := foursquare.NewClient(server.Client(), server.URL+"/v3/", testAPIKey)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewImporter(cfg, client, store, logger), store
+}
+
+func externalIDs(places []*storage.SeedPlace) []string {
+	ids := make([]string, 0, len(places))
+	for _, place := range places {
+		ids = append(ids, place.ExternalID)
+	}
+	return ids
+}
+
+func TestImportFollowsLinkPagination(t *testing.T) {
+	fixture := &fixtureServer{pages: []string{"search_page1.json", "search_page2.json"}}
+	importer, store := newTestImporter(t, fixture, 5)
+
+	result, err := importer.Import(context.Background())
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	if len(fixture.requests) != 2 {
+		t.Fatalf("got %d requests, want 2", len(fixture.requests))
+	}
+	for i, r := range fixture.requests {
+		query := r.URL.Query()
+		if query.Get("categories") != "10027,10056,16032" || query.Get("limit") != "50" || query.Get("polygon") == "" {
+			t.Errorf("request %d has query %v", i+1, query)
+		}
+	}
+	if cursor := fixture.requests[1].URL.Query().Get("cursor"); cursor != "2" {
+		t.Errorf("second request has cursor %q, want the one of the Link header", cursor)
+	}
+
+	// The place without a category is skipped and the kremlin, on both pages, is imported once.
+	want := []string{"4c0f6b8a98102d7fba93ed11", "4bd5a2a2637ba59378b1e3e1", "4e4d7d1ec65b2dc8a0b8e6f3"}
+	if got := externalIDs(store.places); !reflect.DeepEqual(got, want) {
+		t.Errorf("imported %v, want %v", got, want)
+	}
+	if result.Created != len(want) {
+		t.Errorf("Created = %d, want %d", result.Created, len(want))
+	}
+	// The stored place without a category must not be deleted because Foursquare now returns it without one.
+	if want := []string{"5a1b3f0e8a6f17046f7a0c22"}; !reflect.DeepEqual(store.skipped, want) {
+		t.Errorf("skipped %v, want %v", store.skipped, want)
+	}
+
+	museum := store.places[0]
+	if museum.Place.Category != "Музей" || museum.Place.Name != "Национальный музей Республики Татарстан" {
+		t.Errorf("museum imported as %+v", museum.Place)
+	}
+	if want := []string{"https://fastly.4sqi.net/img/general/original/museum.jpg"}; !reflect.DeepEqual(museum.Photos, want) {
+		t.Errorf("museum photos = %v, want %v", museum.Photos, want)
+	}
+	if category := store.places[1].Place.Category; category != "Историческое место" {
+		t.Errorf("kremlin category = %q, want the shortened name", category)
+	}
+}
+
+func TestImportStopsAtMaxPages(t *testing.T) {
+	fixture := &fixtureServer{pages: []string{"search_page1.json", "search_page2.json"}}
+	importer, store := newTestImporter(t, fixture, 1)
+
+	_, err := importer.Import(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "more than 1 pages") {
+		t.Fatalf("Import() error = %v, want the page limit", err)
+	}
+	if len(fixture.requests) != 1 {
+		t.Errorf("got %d requests, want 1", len(fixture.requests))
+	}
+	if store.calls != 0 {
+		t.Error("places were stored after the page limit was hit")
+	}
+}
+
+func TestImportFailsOnErrorResponses(t *testing.T) {
+	tests := []struct {
+		name   string
+		failAt map[int]int
+		want   string
+	}{
+		{name: "first page", failAt: map[int]int{1: http.StatusUnauthorized}, want: "page 1: unexpected status code 401"},
+		{name: "later page", failAt: map[int]int{2: http.StatusInternalServerError}, want: "page 2: unexpected status code 500"},
+		{name: "rate limited", failAt: map[int]int{2: http.StatusTooManyRequests}, want: "page 2: unexpected status code 429"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixture := &fixtureServer{pages: []string{"search_page1.json", "search_page2.json"}, failAt: tt.failAt}
+			importer, store := newTestImporter(t, fixture, 5)
+
+			_, err := importer.Import(context.Background())
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Import() error = %v, want %q", err, tt.want)
+			}
+			if store.calls != 0 {
+				t.Error("places were stored although a page failed")
+			}
+		})
+	}
+}
+
+func TestImportKeepsPlacesWhenNothingIsFound(t *testing.T) {
+	fixture := &fixtureServer{pages: []string{"search_empty.json"}}
+	importer, store := newTestImporter(t, fixture, 5)
+
+	_, err := importer.Import(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "no places") {
+		t.Fatalf("Import() error = %v, want the empty result guard", err)
+	}
+	if store.calls != 0 {
+		t.Error("an empty result was stored, it would delete every imported place")
+	}
+}
+
+func TestImportKeepsPlacesWhenNoneIsValid(t *testing.T) {
+	fixture := &fixtureServer{pages: []string{"search_invalid.json"}}
+	importer, store := newTestImporter(t, fixture, 5)
+
+	_, err := importer.Import(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "none of the 1 places") {
+		t.Fatalf("Import() error = %v, want the invalid result guard", err)
+	}
+	if store.calls != 0 {
+		t.Error("a result without valid places was stored, it would delete every imported place")
+	}
+}
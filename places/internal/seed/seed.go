@@ -0,0 +1,47 @@
+// Package seed loads the fixture places used to populate local and test environments from Foursquare export files.
+package seed
+
+import (
+	"embed"
+	"fmt"
+	"github.com/GP-Hacks/kdt2024-commons/fixtures"
+	"github.com/GP-Hacks/kdt2024-places/internal/foursquare"
+	"github.com/GP-Hacks/kdt2024-places/internal/storage"
+)
+
+//go:embed fixtures/foursquare.json
+var bundled embed.FS
+
+// Load reads the places in the Foursquare export files at paths, JSON or YAML, or the bundled export when no paths
+// are given.
+func Load(paths []string) ([]*storage.SeedPlace, error) {
+	const op = "seed.Load"
+
+	var exports []foursquare.SearchResponse
+	if len(paths) == 0 {
+		var export foursquare.SearchResponse
+		if err := fixtures.ReadFS(bundled, "fixtures/foursquare.json", &export); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		exports = append(exports, export)
+	}
+	for _, path := range paths {
+		var export foursquare.SearchResponse
+		if err := fixtures.Read(path, &export); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		exports = append(exports, export)
+	}
+
+	var places []*storage.SeedPlace
+	for _, export := range exports {
+		for i := range export.Results {
+			place, err := export.Results[i].ToStorage()
+			if err != nil {
+				return nil, fmt.Errorf("%s: result %d: %w", op, i+1, err)
+			}
+			places = append(places, place)
+		}
+	}
+	return places, nil
+}
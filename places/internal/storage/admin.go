@@ -0,0 +1,116 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"github.com/GP-Hacks/kdt2024-commons/audit"
+	"github.com/jackc/pgx/v5"
+)
+
+// auditTable is the audit log of the admin changes, see audit.Change.
+const auditTable = "audit_log"
+
+// CreatePlace stores a new place from every field of place but the ID. The place gets the default opening hours
+// and its slots the next time slots are generated.
+func (s *PostgresStorage) CreatePlace(ctx context.Context, actor string, place *Place) (*Place, error) {
+	const op = "storage.postgresql.CreatePlace"
+	var created *Place
+	err := s.audited(ctx, actor, audit.Create, "places", 0, func(tx pgx.Tx) (int, error) {
+		var err error
+		created, err = scanPlace(tx.QueryRow(ctx, `
+			INSERT INTO places (category, description, latitude, longitude, location, name, tel, website, cost, time)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, '')
+			RETURNING `+placeColumns,
+			place.Category, place.Description, place.Latitude, place.Longitude, place.Location, place.Name, place.Tel,
+			place.Website, place.Cost))
+		if err != nil {
+			return 0, err
+		}
+		return created.ID, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to create place: %w", op, err)
+	}
+	return created, nil
+}
+
+// UpdatePlace replaces the fields of the place with the ID of place. Slots already generated keep their price.
+// It returns pgx.ErrNoRows when the place does not exist or was deleted.
+func (s *PostgresStorage) UpdatePlace(ctx context.Context, actor string, place *Place) (*Place, error) {
+	const op = "storage.postgresql.UpdatePlace"
+	var updated *Place
+	err := s.audited(ctx, actor, audit.Update, "places", place.ID, func(tx pgx.Tx) (int, error) {
+		var err error
+		updated, err = scanPlace(tx.QueryRow(ctx, `
+			UPDATE places
+			SET category = $2, description = $3, latitude = $4, longitude = $5, location = $6, name = $7, tel = $8,
+				website = $9, cost = $10
+			WHERE id = $1
+			RETURNING `+placeColumns,
+			place.ID, place.Category, place.Description, place.Latitude, place.Longitude, place.Location, place.Name,
+			place.Tel, place.Website, place.Cost))
+		if err != nil {
+			return 0, err
+		}
+		return updated.ID, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to update place with ID %d: %w", op, place.ID, err)
+	}
+	return updated, nil
+}
+
+// DeletePlace hides the place from users and stops selling its tickets. Tickets already sold stay valid.
+// It returns pgx.ErrNoRows when the place does not exist or was already deleted.
+func (s *PostgresStorage) DeletePlace(ctx context.Context, actor string, placeID int) error {
+	const op = "storage.postgresql.DeletePlace"
+	err := s.audited(ctx, actor, audit.Delete, "places", placeID, func(tx pgx.Tx) (int, error) {
+		_, err := tx.Exec(ctx, `UPDATE places SET deleted_at = NOW(), deleted_by = $2 WHERE id = $1`, placeID, actor)
+		return placeID, err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: failed to delete place with ID %d: %w", op, placeID, err)
+	}
+	return nil
+}
+
+// AddPhoto adds a photo to the place. It returns pgx.ErrNoRows when the place does not exist or was deleted.
+func (s *PostgresStorage) AddPhoto(ctx context.Context, actor string, placeID int, url string) (*Photo, error) {
+	const op = "storage.postgresql.AddPhoto"
+	photo := &Photo{}
+	err := s.audited(ctx, actor, audit.Create, "photos", 0, func(tx pgx.Tx) (int, error) {
+		err := tx.QueryRow(ctx, `
+			INSERT INTO photos (place_id, url)
+			SELECT id, $2 FROM places WHERE id = $1 AND deleted_at IS NULL
+			RETURNING id, place_id, url`, placeID, url).Scan(&photo.ID, &photo.PlaceID, &photo.Url)
+		return photo.ID, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to add photo to place ID %d: %w", op, placeID, err)
+	}
+	return photo, nil
+}
+
+// DeletePhoto removes the photo from the place. It returns pgx.ErrNoRows when the place has no such photo.
+func (s *PostgresStorage) DeletePhoto(ctx context.Context, actor string, placeID int, photoID int) error {
+	const op = "storage.postgresql.DeletePhoto"
+	err := s.audited(ctx, actor, audit.Delete, "photos", photoID, func(tx pgx.Tx) (int, error) {
+		tag, err := tx.Exec(ctx, `UPDATE photos SET deleted_at = NOW() WHERE id = $1 AND place_id = $2`, photoID, placeID)
+		if err != nil {
+			return 0, err
+		}
+		if tag.RowsAffected() == 0 {
+			return 0, pgx.ErrNoRows
+		}
+		return photoID, nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s: failed to delete photo %d of place ID %d: %w", op, photoID, placeID, err)
+	}
+	return nil
+}
+
+// audited runs change in a transaction and records it in the audit log, see audit.Change.
+func (s *PostgresStorage) audited(ctx context.Context, actor string, action string, table string, id int, change func(tx pgx.Tx) (int, error)) error {
+	return audit.Change(ctx, s.db, auditTable, actor, action, table, id, change)
+}
@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+)
+
+// ImportActor is recorded as the deleter of the places removed by imports.
+const ImportActor = "import"
+
+// importLockKey is the advisory lock held while places are imported.
+const importLockKey = 7_342_001
+
+// ErrImportRunning is returned by ImportPlaces when another import holds the lock.
+var ErrImportRunning = errors.New("another import is running")
+
+type ImportResult struct {
+	Created  int
+	Updated  int
+	Restored int
+	Deleted  int
+}
+
+// ImportPlaces makes the stored places with external IDs match places, the complete result of an import. Places are
+// matched by external ID: new ones are created, stored ones get the imported fields but keep their ticket price, and
+// their imported photos are replaced. Stored places missing from places are deleted and come back when they reappear,
+// unless an admin deleted them. skipped are the external IDs of the imported places that could not be converted,
+// their stored places are left as they are.
+func (s *PostgresStorage) ImportPlaces(ctx context.Context, places []*SeedPlace, skipped []string) (*ImportResult, error) {
+	const op = "storage.postgresql.ImportPlaces"
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	var locked bool
+	if err := tx.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock($1)`, importLockKey).Scan(&locked); err != nil {
+		return nil, fmt.Errorf("%s: failed to take import lock: %w", op, err)
+	}
+	if !locked {
+		return nil, fmt.Errorf("%s: %w", op, ErrImportRunning)
+	}
+
+	result := &ImportResult{}
+	externalIDs := make([]string, 0, len(places)+len(skipped))
+	externalIDs = append(externalIDs, skipped...)
+	for _, seed := range places {
+		externalIDs = append(externalIDs, seed.ExternalID)
+		if _, err := adoptPlace(ctx, tx, seed); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		place := seed.Place
+		var placeID int
+		var created, restored bool
+		err := tx.QueryRow(ctx, `
+			WITH stored AS (SELECT deleted_by = $11 AS restored FROM places WHERE external_id = $1)
+			INSERT INTO places (external_id, category, description, latitude, longitude, location, name, tel, website, cost, time)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, '')
+			ON CONFLICT (external_id) DO UPDATE
+			SET category = EXCLUDED.category, description = EXCLUDED.description, latitude = EXCLUDED.latitude,
+				longitude = EXCLUDED.longitude, location = EXCLUDED.location, name = EXCLUDED.name, tel = EXCLUDED.tel,
+				website = EXCLUDED.website,
+				deleted_at = CASE WHEN places.deleted_by = $11 THEN NULL ELSE places.deleted_at END,
+				deleted_by = CASE WHEN places.deleted_by = $11 THEN NULL ELSE places.deleted_by END
+			RETURNING id, xmax = 0, COALESCE((SELECT restored FROM stored), FALSE)`,
+			seed.ExternalID, place.Category, place.Description, place.Latitude, place.Longitude, place.Location,
+			place.Name, place.Tel, place.Website, place.Cost, ImportActor).Scan(&placeID, &created, &restored)
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to upsert place %s: %w", op, seed.ExternalID, err)
+		}
+		switch {
+		case created:
+			result.Created++
+		case restored:
+			result.Restored++
+		default:
+			result.Updated++
+		}
+
+		if err := syncPhotos(ctx, tx, placeID, seed.Photos); err != nil {
+			return nil, fmt.Errorf("%s: failed to update photos of place %s: %w", op, seed.ExternalID, err)
+		}
+	}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE places SET deleted_at = NOW(), deleted_by = $2
+		WHERE external_id IS NOT NULL AND deleted_at IS NULL AND NOT (external_id = ANY($1))`,
+		externalIDs, ImportActor)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to delete missing places: %w", op, err)
+	}
+	result.Deleted = int(tag.RowsAffected())
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return result, nil
+}
+
+// adoptPlace gives the external ID of seed to the place stored with its name and location before external IDs were
+// recorded, if there is one and no place has the external ID yet. It reports whether a place was adopted.
+func adoptPlace(ctx context.Context, tx pgx.Tx, seed *SeedPlace) (bool, error) {
+	tag, err := tx.Exec(ctx, `
+		UPDATE places SET external_id = $1
+		WHERE id = (
+			SELECT id FROM places WHERE external_id IS NULL AND name = $2 AND location = $3 ORDER BY id LIMIT 1
+		) AND NOT EXISTS (SELECT 1 FROM places WHERE external_id = $1)`,
+		seed.ExternalID, seed.Place.Name, seed.Place.Location)
+	if err != nil {
+		return false, fmt.Errorf("failed to match stored place: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// syncPhotos replaces the imported photos of the place with urls. Photos added by admins are kept, and photos
+// deleted by them are not added again.
+func syncPhotos(ctx context.Context, tx pgx.Tx, placeID int, urls []string) error {
+	if _, err := tx.Exec(ctx, `
+		UPDATE photos SET deleted_at = NOW()
+		WHERE place_id = $1 AND imported AND deleted_at IS NULL AND NOT (url = ANY($2))`, placeID, urls); err != nil {
+		return err
+	}
+	// Photos stored before imports were recorded become imported ones.
+	if _, err := tx.Exec(ctx, `
+		UPDATE photos SET imported = TRUE
+		WHERE place_id = $1 AND NOT imported AND deleted_at IS NULL AND url = ANY($2)`, placeID, urls); err != nil {
+		return err
+	}
+	_, err := tx.Exec(ctx, `
+		INSERT INTO photos (place_id, url, imported)
+		SELECT $1, u.url, TRUE FROM unnest($2::text[]) AS u(url)
+		WHERE NOT EXISTS (SELECT 1 FROM photos WHERE place_id = $1 AND url = u.url)`, placeID, urls)
+	return err
+}
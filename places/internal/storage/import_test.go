@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"context"
+	"testing"
+)
+
+func importedPlace(externalID, name string, photos ...string) *SeedPlace {
+	return &SeedPlace{
+		ExternalID: externalID,
+		Place: Place{
+			Category:  "Музей",
+			Latitude:  55.797112,
+			Longitude: 49.108387,
+			Location:  "Казань",
+			Name:      name,
+			Cost:      300,
+		},
+		Photos: photos,
+	}
+}
+
+func countPlaces(t *testing.T, s *PostgresStorage, externalID string) (all int, visible int) {
+	t.Helper()
+	err := s.db.QueryRow(context.Background(), `
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE deleted_at IS NULL) FROM places WHERE external_id = $1`, externalID).
+		Scan(&all, &visible)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return all, visible
+}
+
+func TestImportPlacesUpsertsByExternalID(t *testing.T) {
+	s := testStorage(t)
+	ctx := context.Background()
+
+	first, err := s.ImportPlaces(ctx, []*SeedPlace{
+		importedPlace("fsq-museum", "Музей", "https://example.com/1.jpg"),
+		importedPlace("fsq-theatre", "Театр"),
+	}, nil)
+	if err != nil {
+		t.Fatalf("first ImportPlaces() error = %v", err)
+	}
+	if *first != (ImportResult{Created: 2}) {
+		t.Errorf("first import = %+v, want 2 created", *first)
+	}
+
+	// The museum is renamed and gets another photo, the theatre is gone.
+	second, err := s.ImportPlaces(ctx, []*SeedPlace{importedPlace("fsq-museum", "Национальный музей", "https://example.com/2.jpg")}, nil)
+	if err != nil {
+		t.Fatalf("second ImportPlaces() error = %v", err)
+	}
+	if *second != (ImportResult{Updated: 1, Deleted: 1}) {
+		t.Errorf("second import = %+v, want 1 updated and 1 deleted", *second)
+	}
+	if all, visible := countPlaces(t, s, "fsq-museum"); all != 1 || visible != 1 {
+		t.Errorf("museum stored %d times, %d visible, want once", all, visible)
+	}
+	var name string
+	if err := s.db.QueryRow(ctx, `SELECT name FROM places WHERE external_id = 'fsq-museum'`).Scan(&name); err != nil {
+		t.Fatal(err)
+	}
+	if name != "Национальный музей" {
+		t.Errorf("museum name = %q, want the imported one", name)
+	}
+	var photos []string
+	rows, err := s.db.Query(ctx, `
+		SELECT url FROM photos JOIN places ON places.id = photos.place_id
+		WHERE external_id = 'fsq-museum' AND photos.deleted_at IS NULL`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for rows.Next() {
+		var url string
+		if err := rows.Scan(&url); err != nil {
+			t.Fatal(err)
+		}
+		photos = append(photos, url)
+	}
+	if len(photos) != 1 || photos[0] != "https://example.com/2.jpg" {
+		t.Errorf("museum photos = %v, want only the imported one", photos)
+	}
+
+	// The theatre comes back as the same place.
+	third, err := s.ImportPlaces(ctx, []*SeedPlace{
+		importedPlace("fsq-museum", "Национальный музей", "https://example.com/2.jpg"),
+		importedPlace("fsq-theatre", "Театр"),
+	}, nil)
+	if err != nil {
+		t.Fatalf("third ImportPlaces() error = %v", err)
+	}
+	if *third != (ImportResult{Updated: 1, Restored: 1}) {
+		t.Errorf("third import = %+v, want 1 updated and 1 restored", *third)
+	}
+	if all, visible := countPlaces(t, s, "fsq-theatre"); all != 1 || visible != 1 {
+		t.Errorf("theatre stored %d times, %d visible, want once", all, visible)
+	}
+}
+
+func TestImportPlacesKeepsSkippedPlaces(t *testing.T) {
+	s := testStorage(t)
+	ctx := context.Background()
+
+	_, err := s.ImportPlaces(ctx, []*SeedPlace{
+		importedPlace("fsq-museum", "Музей"),
+		importedPlace("fsq-theatre", "Театр"),
+	}, nil)
+	if err != nil {
+		t.Fatalf("first ImportPlaces() error = %v", err)
+	}
+
+	// The theatre is returned again but cannot be converted, so it stays as stored.
+	result, err := s.ImportPlaces(ctx, []*SeedPlace{importedPlace("fsq-museum", "Музей")}, []string{"fsq-theatre"})
+	if err != nil {
+		t.Fatalf("second ImportPlaces() error = %v", err)
+	}
+	if *result != (ImportResult{Updated: 1}) {
+		t.Errorf("second import = %+v, want 1 updated", *result)
+	}
+	if all, visible := countPlaces(t, s, "fsq-theatre"); all != 1 || visible != 1 {
+		t.Errorf("theatre stored %d times, %d visible, want kept", all, visible)
+	}
+}
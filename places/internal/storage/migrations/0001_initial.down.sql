@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS audit_log;
+DROP TABLE IF EXISTS tickets;
+DROP TABLE IF EXISTS slots;
+DROP TABLE IF EXISTS opening_hours;
+DROP TABLE IF EXISTS outbox;
+DROP TABLE IF EXISTS photos;
+DROP TABLE IF EXISTS places;
@@ -0,0 +1,93 @@
+-- Baseline of the schema created before migrations were introduced, the statements are idempotent so the
+-- migration also applies to databases created by earlier releases.
+
+CREATE TABLE IF NOT EXISTS places (
+    id SERIAL PRIMARY KEY,
+    category VARCHAR(255),
+    description TEXT,
+    latitude DOUBLE PRECISION,
+    longitude DOUBLE PRECISION,
+    location TEXT,
+    name VARCHAR(255),
+    tel VARCHAR(50),
+    website VARCHAR(255),
+    cost INT,
+    time VARCHAR(50)
+);
+
+CREATE TABLE IF NOT EXISTS photos (
+    place_id INT REFERENCES places(id) ON DELETE CASCADE,
+    url TEXT
+);
+
+CREATE TABLE IF NOT EXISTS tickets (
+    id SERIAL PRIMARY KEY,
+    name TEXT,
+    location TEXT,
+    user_token VARCHAR(255),
+    event_time TIMESTAMP
+);
+
+ALTER TABLE tickets ADD COLUMN IF NOT EXISTS idempotency_key VARCHAR(255);
+
+CREATE UNIQUE INDEX IF NOT EXISTS tickets_user_token_idempotency_key_idx ON tickets (user_token, idempotency_key);
+
+CREATE TABLE IF NOT EXISTS outbox (
+    id BIGSERIAL PRIMARY KEY,
+    queue VARCHAR(255) NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS outbox_unpublished_idx ON outbox (id) WHERE published_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS opening_hours (
+    place_id INT REFERENCES places(id) ON DELETE CASCADE,
+    weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 1 AND 7),
+    opens_at TIME NOT NULL,
+    closes_at TIME NOT NULL,
+    CHECK (opens_at < closes_at),
+    UNIQUE (place_id, weekday)
+);
+
+CREATE TABLE IF NOT EXISTS slots (
+    id SERIAL PRIMARY KEY,
+    place_id INT NOT NULL REFERENCES places(id) ON DELETE CASCADE,
+    start_time TIMESTAMP NOT NULL,
+    capacity INT NOT NULL CHECK (capacity >= 0),
+    sold INT NOT NULL DEFAULT 0 CHECK (sold >= 0 AND sold <= capacity),
+    price INT NOT NULL,
+    UNIQUE (place_id, start_time)
+);
+
+ALTER TABLE tickets ADD COLUMN IF NOT EXISTS place_id INT REFERENCES places(id);
+
+ALTER TABLE tickets ADD COLUMN IF NOT EXISTS slot_id INT REFERENCES slots(id);
+
+ALTER TABLE tickets ADD COLUMN IF NOT EXISTS cost INT;
+
+ALTER TABLE tickets ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMP;
+
+ALTER TABLE tickets ADD COLUMN IF NOT EXISTS refund_amount INT;
+
+ALTER TABLE tickets ADD COLUMN IF NOT EXISTS used_at TIMESTAMP;
+
+ALTER TABLE places ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+ALTER TABLE photos ADD COLUMN IF NOT EXISTS id SERIAL PRIMARY KEY;
+
+ALTER TABLE photos ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    actor VARCHAR(255) NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    entity VARCHAR(50) NOT NULL,
+    entity_id INT NOT NULL,
+    before JSONB,
+    after JSONB,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_entity_idx ON audit_log (entity, entity_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS places_external_id_idx;
+ALTER TABLE places DROP COLUMN IF EXISTS external_id;
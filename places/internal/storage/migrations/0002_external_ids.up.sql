@@ -0,0 +1,5 @@
+-- The ID of a place in the source it was imported from, like Foursquare, so imports update places instead of
+-- adding them again.
+ALTER TABLE places ADD COLUMN IF NOT EXISTS external_id VARCHAR(255);
+
+CREATE UNIQUE INDEX IF NOT EXISTS places_external_id_idx ON places (external_id);
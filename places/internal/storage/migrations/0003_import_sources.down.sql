@@ -0,0 +1,2 @@
+ALTER TABLE photos DROP COLUMN IF EXISTS imported;
+ALTER TABLE places DROP COLUMN IF EXISTS deleted_by;
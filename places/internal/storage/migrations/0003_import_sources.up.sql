@@ -0,0 +1,6 @@
+-- Who deleted a place, so places deleted by the importer come back when they reappear while places deleted by an
+-- admin stay deleted.
+ALTER TABLE places ADD COLUMN IF NOT EXISTS deleted_by VARCHAR(255);
+
+-- Imported photos are replaced by later imports, photos added by admins are kept.
+ALTER TABLE photos ADD COLUMN IF NOT EXISTS imported BOOLEAN NOT NULL DEFAULT FALSE;
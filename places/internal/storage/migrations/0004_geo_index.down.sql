@@ -0,0 +1,3 @@
+-- The extensions are kept, other schemas of the database may use them.
+DROP INDEX IF EXISTS places_coordinates_idx;
+DROP INDEX IF EXISTS places_earth_idx;
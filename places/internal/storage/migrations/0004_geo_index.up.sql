@@ -0,0 +1,10 @@
+-- earthdistance computes distances between places on the surface of the Earth, its ll_to_earth points are indexed so
+-- radius searches don't scan every place. Both extensions are trusted, the owner of the database can create them.
+CREATE EXTENSION IF NOT EXISTS cube;
+CREATE EXTENSION IF NOT EXISTS earthdistance;
+
+CREATE INDEX IF NOT EXISTS places_earth_idx ON places USING gist (ll_to_earth(latitude, longitude))
+    WHERE deleted_at IS NULL;
+
+-- Bounding-box searches.
+CREATE INDEX IF NOT EXISTS places_coordinates_idx ON places (latitude, longitude) WHERE deleted_at IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS places_name_trgm_idx;
+DROP INDEX IF EXISTS places_search_idx;
+ALTER TABLE places DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,11 @@
+-- Full-text search over the names and descriptions of places in Russian. Misspelled names are matched by trigram
+-- similarity, pg_trgm is a trusted extension like earthdistance.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE places ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', COALESCE(name, '')), 'A') ||
+    setweight(to_tsvector('russian', COALESCE(description, '')), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS places_search_idx ON places USING gin (search_vector) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS places_name_trgm_idx ON places USING gin (name gin_trgm_ops) WHERE deleted_at IS NULL;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS photos_place_id_idx;
@@ -0,0 +1,2 @@
+-- The photos of a page of places are loaded in one query by place ID.
+CREATE INDEX IF NOT EXISTS photos_place_id_idx ON photos (place_id, id) WHERE deleted_at IS NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS tickets_user_token_event_time_idx;
+DROP INDEX IF EXISTS tickets_user_token_id_idx;
@@ -0,0 +1,3 @@
+-- The tickets of a user are listed in pages by ID or event time.
+CREATE INDEX IF NOT EXISTS tickets_user_token_id_idx ON tickets (user_token, id);
+CREATE INDEX IF NOT EXISTS tickets_user_token_event_time_idx ON tickets (user_token, event_time, id);
@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+// benchmarkPlaces fills the storage with places of three photos each and returns their IDs.
+func benchmarkPlaces(b *testing.B, s *PostgresStorage, count int) []int {
+	b.Helper()
+	ctx := context.Background()
+	rows, err := s.db.Query(ctx, `
+		INSERT INTO places (category, description, latitude, longitude, location, name, tel, website, cost, time)
+		SELECT 'Музей', '', 55.79, 49.1, 'Казань', 'Место ' || g, '', '', 300, ''
+		FROM generate_series(1, $1) AS g
+		RETURNING id`, count)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			b.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		b.Fatal(err)
+	}
+
+	_, err = s.db.Exec(ctx, `
+		INSERT INTO photos (place_id, url)
+		SELECT p, 'https://example.com/' || p || '/' || n || '.jpg'
+		FROM unnest($1::int[]) AS p, generate_series(1, 3) AS n`, ids)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if _, err := s.db.Exec(ctx, `ANALYZE places, photos`); err != nil {
+		b.Fatal(err)
+	}
+	return ids
+}
+
+// BenchmarkPlacePhotos compares loading the photos of a page of places one place at a time, as the places list did
+// before, with loading them in one query.
+func BenchmarkPlacePhotos(b *testing.B) {
+	s := testStorage(b)
+	ctx := context.Background()
+	ids := benchmarkPlaces(b, s, 1000)
+
+	for _, size := range []int{10, 50, 100} {
+		page := ids[:size]
+		b.Run(fmt.Sprintf("per_place/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for _, id := range page {
+					if _, err := s.GetPhotosById(ctx, id); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("batched/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := s.GetPhotosByPlaceIDs(ctx, page); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+)
+
+type SearchResult struct {
+	ID       int
+	Category string
+	Name     string
+	Photo    string
+	Headline string
+	Rank     float64
+}
+
+// Search returns at most limit places whose names or descriptions match query, or whose names look like a word of
+// query, best match first. The headlines are built with headlineOptions.
+func (s *PostgresStorage) Search(ctx context.Context, query string, limit int, headlineOptions string) ([]*SearchResult, error) {
+	const op = "storage.postgresql.Search"
+
+	rows, err := s.db.Query(ctx, `
+		SELECT p.id, COALESCE(p.category, ''), COALESCE(p.name, ''),
+			COALESCE((SELECT url FROM photos WHERE place_id = p.id AND deleted_at IS NULL ORDER BY id LIMIT 1), ''),
+			ts_headline('russian', COALESCE(p.description, ''), q, $2),
+			ts_rank(p.search_vector, q) + COALESCE(word_similarity($1, p.name), 0) AS rank
+		FROM places p, websearch_to_tsquery('russian', $1) AS q
+		WHERE p.deleted_at IS NULL AND (p.search_vector @@ q OR $1 <% p.name)
+		ORDER BY rank DESC, p.id
+		LIMIT $3`, query, headlineOptions, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to search places: %w", op, err)
+	}
+	defer rows.Close()
+
+	results := []*SearchResult{}
+	for rows.Next() {
+		result := &SearchResult{}
+		if err := rows.Scan(&result.ID, &result.Category, &result.Name, &result.Photo, &result.Headline, &result.Rank); err != nil {
+			return nil, fmt.Errorf("%s: failed to scan place: %w", op, err)
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: error occurred while iterating over places: %w", op, err)
+	}
+	return results, nil
+}
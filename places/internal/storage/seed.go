@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+)
+
+// SeedPlace is a place from fixtures, ExternalID is the ID of the place in the source it was exported from.
+type SeedPlace struct {
+	ExternalID string
+	Place      Place
+	Photos     []string
+}
+
+// SeedPlaces stores the places not stored yet together with their photos and returns how many were added. A place is
+// already stored when one with the same external ID exists, deleted ones included, so seeding neither duplicates
+// places nor brings back deleted ones. Places stored before external IDs were recorded are matched by name and
+// location and get the external ID.
+func (s *PostgresStorage) SeedPlaces(ctx context.Context, places []*SeedPlace) (int, error) {
+	const op = "storage.postgresql.SeedPlaces"
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	added := 0
+	for _, seed := range places {
+		place := seed.Place
+		adopted, err := adoptPlace(ctx, tx, seed)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+		if adopted {
+			continue
+		}
+
+		var placeID int
+		err = tx.QueryRow(ctx, `
+			INSERT INTO places (external_id, category, description, latitude, longitude, location, name, tel, website, cost, time)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, '')
+			ON CONFLICT (external_id) DO NOTHING
+			RETURNING id`,
+			seed.ExternalID, place.Category, place.Description, place.Latitude, place.Longitude, place.Location,
+			place.Name, place.Tel, place.Website, place.Cost).Scan(&placeID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("%s: failed to insert place: %w", op, err)
+		}
+
+		for _, url := range seed.Photos {
+			if _, err := tx.Exec(ctx, `INSERT INTO photos (place_id, url, imported) VALUES ($1, $2, TRUE)`, placeID, url); err != nil {
+				return 0, fmt.Errorf("%s: failed to insert photo: %w", op, err)
+			}
+		}
+		added++
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return added, nil
+}
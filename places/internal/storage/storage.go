@@ -2,17 +2,24 @@ package storage
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/GP-Hacks/kdt2024-commons/outbox"
+	"github.com/GP-Hacks/kdt2024-commons/pagination"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"io"
-	"math/rand"
-	"net/http"
+	"strings"
 	"time"
 )
 
+var (
+	ErrSlotNotFound = errors.New("slot not found")
+	ErrSlotSoldOut  = errors.New("slot is sold out")
+
+	ErrTicketCancelled = errors.New("ticket is already cancelled")
+	ErrTicketUsed      = errors.New("ticket is already used")
+)
+
 type PostgresStorage struct {
 	db *pgxpool.Pool
 }
@@ -29,17 +36,40 @@ type Place struct {
 	Website     string
 	Cost        int
 	Time        string
+	// Distance in meters from the point of a search, only set by SearchPlaces.
+	Distance float64
 }
 
 type Ticket struct {
+	ID             int
+	PlaceID        int
+	SlotID         int
+	Name           string
+	Location       string
+	UserToken      string
+	EventTime      time.Time
+	Cost           int
+	IdempotencyKey string
+	Cancelled      bool
+	RefundAmount   int
+}
+
+type Slot struct {
 	ID        int
-	Name      string
-	Location  string
-	UserToken string
-	EventTime time.Time
+	PlaceID   int
+	StartTime time.Time
+	Capacity  int
+	Sold      int
+	Cost      int
 }
 
+// OutboxMessage is a message stored in the outbox of the service.
+type OutboxMessage = outbox.Message
+
+const outboxTable = "outbox"
+
 type Photo struct {
+	ID      int
 	PlaceID int
 	Url     string
 }
@@ -57,34 +87,135 @@ func (s *PostgresStorage) Close() {
 	s.db.Close()
 }
 
-func (s *PostgresStorage) GetPlaces(ctx context.Context) ([]*Place, error) {
-	const op = "storage.postgresql.GetPlaces"
-	query := "SELECT id, category, description, latitude, longitude, location, name, tel, website, cost, time FROM places"
-	places, err := s.fetchPlaces(ctx, query)
-	if err != nil {
-		return nil, fmt.Errorf("%s: failed to fetch places: %w", op, err)
+const placeColumns = "id, category, description, latitude, longitude, location, name, tel, website, cost, time"
+
+// PlacesQuery selects the places listed by SearchPlaces.
+type PlacesQuery struct {
+	// Category of the places, every category when empty.
+	Category string
+	// Latitude and Longitude are the point the places are sorted by distance from.
+	Latitude  float64
+	Longitude float64
+	// Radius in meters around the point, no limit when 0.
+	Radius float64
+	// Box limits the places to a bounding box when set.
+	Box   *BoundingBox
+	Sort  pagination.Sort
+	Limit int
+	// AfterKey and AfterID are the cursor of the page, the value of the sort key and the ID of the place before it.
+	// AfterID is 0 for the first page.
+	AfterKey float64
+	AfterID  int
+}
+
+const (
+	PlaceSortDistance = "distance"
+	PlaceSortCost     = "cost"
+)
+
+// placeSortKeys are the SQL expressions of the sort keys, $1 and $2 are the point of the query.
+var placeSortKeys = map[string]string{
+	PlaceSortDistance: "earth_distance(ll_to_earth($1, $2), ll_to_earth(latitude, longitude))",
+	PlaceSortCost:     "cost::float8",
+}
+
+// SortKey returns the value of the sort key of the place returned by SearchPlaces.
+func (p *Place) SortKey(key string) float64 {
+	if key == PlaceSortCost {
+		return float64(p.Cost)
 	}
-	return places, nil
+	return p.Distance
 }
 
-func (s *PostgresStorage) GetPlacesByCategory(ctx context.Context, category string) ([]*Place, error) {
-	const op = "storage.postgresql.GetPlacesByCategory"
-	query := "SELECT id, category, description, latitude, longitude, location, name, tel, website, cost, time FROM places WHERE category = $1"
-	places, err := s.fetchPlaces(ctx, query, category)
+// BoundingBox crosses the antimeridian when MinLongitude is greater than MaxLongitude.
+type BoundingBox struct {
+	MinLatitude  float64
+	MinLongitude float64
+	MaxLatitude  float64
+	MaxLongitude float64
+}
+
+// SearchPlaces returns a page of the places matching query in its sort order, with their distances, and whether there
+// are places after the page. A first page with no places for a filtered query is reported as pgx.ErrNoRows.
+func (s *PostgresStorage) SearchPlaces(ctx context.Context, query *PlacesQuery) ([]*Place, bool, error) {
+	const op = "storage.postgresql.SearchPlaces"
+
+	args := []interface{}{query.Latitude, query.Longitude}
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	conditions := []string{"deleted_at IS NULL"}
+	if query.Category != "" {
+		conditions = append(conditions, "category = "+arg(query.Category))
+	}
+	if query.Radius > 0 {
+		// earth_box is a cube around the circle, it uses the index and earth_distance drops the places in its corners.
+		radius := arg(query.Radius)
+		conditions = append(conditions,
+			"earth_box(ll_to_earth($1, $2), "+radius+") @> ll_to_earth(latitude, longitude)",
+			"earth_distance(ll_to_earth($1, $2), ll_to_earth(latitude, longitude)) <= "+radius,
+		)
+	}
+	if box := query.Box; box != nil {
+		conditions = append(conditions, "latitude BETWEEN "+arg(box.MinLatitude)+" AND "+arg(box.MaxLatitude))
+		if box.MinLongitude <= box.MaxLongitude {
+			conditions = append(conditions, "longitude BETWEEN "+arg(box.MinLongitude)+" AND "+arg(box.MaxLongitude))
+		} else {
+			conditions = append(conditions, "(longitude >= "+arg(box.MinLongitude)+" OR longitude <= "+arg(box.MaxLongitude)+")")
+		}
+	}
+	filtered := len(args) > 2
+
+	key, ok := placeSortKeys[query.Sort.Key]
+	if !ok {
+		return nil, false, fmt.Errorf("%s: unknown sort key %q", op, query.Sort.Key)
+	}
+	if query.AfterID > 0 {
+		conditions = append(conditions, query.Sort.After(key, "id", arg(query.AfterKey)+"::float8", arg(query.AfterID)))
+	}
+
+	// One more place than the page is fetched to know whether there are more.
+	sql := "SELECT " + placeColumns + ", " + placeSortKeys[PlaceSortDistance] + " AS distance" +
+		" FROM places WHERE " + strings.Join(conditions, " AND ") +
+		" ORDER BY " + query.Sort.OrderBy(key, "id") + " LIMIT " + arg(query.Limit+1)
+	rows, err := s.db.Query(ctx, sql, args...)
 	if err != nil {
-		return nil, fmt.Errorf("%s: failed to fetch places by category '%s': %w", op, category, err)
+		return nil, false, fmt.Errorf("%s: failed to query places: %w", op, err)
+	}
+	defer rows.Close()
+
+	var places []*Place
+	for rows.Next() {
+		place := &Place{}
+		err := rows.Scan(
+			&place.ID, &place.Category, &place.Description, &place.Latitude, &place.Longitude,
+			&place.Location, &place.Name, &place.Tel, &place.Website, &place.Cost, &place.Time, &place.Distance,
+		)
+		if err != nil {
+			return nil, false, fmt.Errorf("%s: failed to scan place: %w", op, err)
+		}
+		places = append(places, place)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, fmt.Errorf("%s: error occurred while iterating over places: %w", op, err)
+	}
+	if len(places) == 0 && filtered && query.AfterID == 0 {
+		return nil, false, fmt.Errorf("%s: no places found: %w", op, pgx.ErrNoRows)
+	}
+
+	hasMore := len(places) > query.Limit
+	if hasMore {
+		places = places[:query.Limit]
 	}
-	return places, nil
+	return places, hasMore, nil
 }
 
 func (s *PostgresStorage) GetPlaceById(ctx context.Context, placeID int) (*Place, error) {
 	const op = "storage.postgresql.GetPlaceById"
-	query := "SELECT id, category, description, latitude, longitude, location, name, tel, website, cost, time FROM places WHERE id = $1"
-	place := &Place{}
-	err := s.db.QueryRow(ctx, query, placeID).Scan(
-		&place.ID, &place.Category, &place.Description, &place.Latitude, &place.Longitude,
-		&place.Location, &place.Name, &place.Tel, &place.Website, &place.Cost, &place.Time,
-	)
+	query := "SELECT " + placeColumns + " FROM places WHERE id = $1 AND deleted_at IS NULL"
+	place, err := scanPlace(s.db.QueryRow(ctx, query, placeID))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, fmt.Errorf("%s: place with ID %d not found: %w", op, placeID, err)
@@ -96,7 +227,7 @@ func (s *PostgresStorage) GetPlaceById(ctx context.Context, placeID int) (*Place
 
 func (s *PostgresStorage) GetPhotosById(ctx context.Context, placeID int) ([]*Photo, error) {
 	const op = "storage.postgresql.GetPhotosById"
-	query := "SELECT place_id, url FROM photos WHERE place_id = $1"
+	query := "SELECT id, place_id, url FROM photos WHERE place_id = $1 AND deleted_at IS NULL ORDER BY id"
 	rows, err := s.db.Query(ctx, query, placeID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: failed to query photos for place ID %d: %w", op, placeID, err)
@@ -106,7 +237,7 @@ func (s *PostgresStorage) GetPhotosById(ctx context.Context, placeID int) ([]*Ph
 	var photos []*Photo
 	for rows.Next() {
 		photo := &Photo{}
-		err := rows.Scan(&photo.PlaceID, &photo.Url)
+		err := rows.Scan(&photo.ID, &photo.PlaceID, &photo.Url)
 		if err != nil {
 			return nil, fmt.Errorf("%s: failed to scan photo for place ID %d: %w", op, placeID, err)
 		}
@@ -121,9 +252,37 @@ func (s *PostgresStorage) GetPhotosById(ctx context.Context, placeID int) ([]*Ph
 	return photos, nil
 }
 
+// GetPhotosByPlaceIDs returns the photos of the places in one query, by place ID. Places without photos are missing
+// from the map.
+func (s *PostgresStorage) GetPhotosByPlaceIDs(ctx context.Context, placeIDs []int) (map[int][]*Photo, error) {
+	const op = "storage.postgresql.GetPhotosByPlaceIDs"
+	photos := make(map[int][]*Photo, len(placeIDs))
+	if len(placeIDs) == 0 {
+		return photos, nil
+	}
+
+	rows, err := s.db.Query(ctx, "SELECT id, place_id, url FROM photos WHERE place_id = ANY($1) AND deleted_at IS NULL ORDER BY place_id, id", placeIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to query photos: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		photo := &Photo{}
+		if err := rows.Scan(&photo.ID, &photo.PlaceID, &photo.Url); err != nil {
+			return nil, fmt.Errorf("%s: failed to scan photo: %w", op, err)
+		}
+		photos[photo.PlaceID] = append(photos[photo.PlaceID], photo)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: error occurred while iterating over photos: %w", op, err)
+	}
+	return photos, nil
+}
+
 func (s *PostgresStorage) GetCategories(ctx context.Context) ([]string, error) {
 	const op = "storage.postgresql.GetCategories"
-	rows, err := s.db.Query(ctx, "SELECT DISTINCT category FROM places")
+	rows, err := s.db.Query(ctx, "SELECT DISTINCT category FROM places WHERE deleted_at IS NULL")
 	if err != nil {
 		return nil, fmt.Errorf("%s: failed to query categories: %w", op, err)
 	}
@@ -148,220 +307,399 @@ func (s *PostgresStorage) GetCategories(ctx context.Context) ([]string, error) {
 	return categories, nil
 }
 
-func (s *PostgresStorage) GetTickets(ctx context.Context, userToken string) ([]*Ticket, error) {
+const (
+	TicketSortID        = "id"
+	TicketSortEventTime = "event_time"
+)
+
+// TicketsQuery selects a page of the tickets of a user.
+type TicketsQuery struct {
+	UserToken string
+	Sort      pagination.Sort
+	Limit     int
+	// AfterTime and AfterID are the cursor of the page, the event time and the ID of the ticket before it. AfterTime
+	// is only used when sorting by event time, AfterID is 0 for the first page.
+	AfterTime time.Time
+	AfterID   int
+}
+
+// GetTickets returns a page of the tickets of the user and whether there are tickets after it. A user without tickets
+// is reported as pgx.ErrNoRows.
+func (s *PostgresStorage) GetTickets(ctx context.Context, query *TicketsQuery) ([]*Ticket, bool, error) {
 	const op = "storage.postgresql.GetTickets"
-	rows, err := s.db.Query(ctx, `SELECT id, name, location, user_token, event_time FROM tickets WHERE user_token = $1`, userToken)
+
+	args := []interface{}{query.UserToken}
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	sql := `
+		SELECT id, name, location, user_token, event_time, cancelled_at IS NOT NULL, COALESCE(refund_amount, 0) FROM tickets
+		WHERE user_token = $1`
+	key := "id"
+	if query.Sort.Key == TicketSortEventTime {
+		key = "event_time"
+	}
+	if query.AfterID > 0 {
+		idArg := arg(query.AfterID)
+		keyArg := idArg
+		if key == "event_time" {
+			keyArg = arg(query.AfterTime)
+		}
+		sql += " AND " + query.Sort.After(key, "id", keyArg, idArg)
+	}
+	sql += " ORDER BY " + query.Sort.OrderBy(key, "id") + " LIMIT " + arg(query.Limit+1)
+
+	rows, err := s.db.Query(ctx, sql, args...)
 	if err != nil {
-		return nil, fmt.Errorf("%s: failed to query tickets: %w", op, err)
+		return nil, false, fmt.Errorf("%s: failed to query tickets: %w", op, err)
 	}
+	defer rows.Close()
+
 	var tickets []*Ticket
 	for rows.Next() {
 		var ticket Ticket
-		if err := rows.Scan(&ticket.ID, &ticket.Name, &ticket.Location, &ticket.UserToken, &ticket.EventTime); err != nil {
-			return nil, fmt.Errorf("%s: failed to scan category: %w", op, err)
+		if err := rows.Scan(&ticket.ID, &ticket.Name, &ticket.Location, &ticket.UserToken, &ticket.EventTime, &ticket.Cancelled, &ticket.RefundAmount); err != nil {
+			return nil, false, fmt.Errorf("%s: failed to scan ticket: %w", op, err)
 		}
 		tickets = append(tickets, &ticket)
 	}
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("%s: error occurred while iterating over tickets: %w", op, err)
+		return nil, false, fmt.Errorf("%s: error occurred while iterating over tickets: %w", op, err)
 	}
 
-	if len(tickets) == 0 {
-		return nil, pgx.ErrNoRows
+	if len(tickets) == 0 && query.AfterID == 0 {
+		return nil, false, pgx.ErrNoRows
 	}
-	return tickets, nil
+	hasMore := len(tickets) > query.Limit
+	if hasMore {
+		tickets = tickets[:query.Limit]
+	}
+	return tickets, hasMore, nil
 }
 
-func (s *PostgresStorage) SaveTicket(ctx context.Context, ticket *Ticket) error {
-	const op = "storage.postgresql.SaveTicket"
-	_, err := s.db.Exec(ctx, `INSERT INTO tickets (name, location, user_token, event_time) VALUES ($1, $2, $3, $4)`, ticket.Name, ticket.Location, ticket.UserToken, ticket.EventTime)
+// GetTicket returns the user's ticket. A missing ticket is reported as pgx.ErrNoRows.
+func (s *PostgresStorage) GetTicket(ctx context.Context, ticketID int, userToken string) (*Ticket, error) {
+	const op = "storage.postgresql.GetTicket"
+	ticket := &Ticket{}
+	err := s.db.QueryRow(ctx, `
+		SELECT id, COALESCE(place_id, 0), COALESCE(slot_id, 0), name, location, user_token, event_time, COALESCE(cost, 0),
+			cancelled_at IS NOT NULL, COALESCE(refund_amount, 0)
+		FROM tickets
+		WHERE id = $1 AND user_token = $2`,
+		ticketID, userToken,
+	).Scan(&ticket.ID, &ticket.PlaceID, &ticket.SlotID, &ticket.Name, &ticket.Location, &ticket.UserToken, &ticket.EventTime, &ticket.Cost,
+		&ticket.Cancelled, &ticket.RefundAmount)
 	if err != nil {
-		return fmt.Errorf("%s: failed to save ticket: %w", op, err)
+		return nil, fmt.Errorf("%s: failed to get ticket: %w", op, err)
 	}
-	return nil
+	return ticket, nil
 }
 
-func (s *PostgresStorage) fetchPlaces(ctx context.Context, query string, args ...interface{}) ([]*Place, error) {
-	const op = "storage.postgresql.fetchPlaces"
-	rows, err := s.db.Query(ctx, query, args...)
+// UseTicket marks the ticket for the place and event time as used. Each ticket can be used once: later calls get
+// ErrTicketUsed, cancelled tickets get ErrTicketCancelled and unknown tickets pgx.ErrNoRows.
+func (s *PostgresStorage) UseTicket(ctx context.Context, ticketID int, placeID int, eventTime time.Time) (*Ticket, error) {
+	const op = "storage.postgresql.UseTicket"
+
+	tx, err := s.db.Begin(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("%s: failed to execute query: %w", op, err)
+		return nil, fmt.Errorf("%s: failed to begin transaction: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	ticket := &Ticket{}
+	var used bool
+	err = tx.QueryRow(ctx, `
+		SELECT id, COALESCE(place_id, 0), COALESCE(slot_id, 0), name, location, user_token, event_time, COALESCE(cost, 0),
+			cancelled_at IS NOT NULL, used_at IS NOT NULL
+		FROM tickets
+		WHERE id = $1 AND place_id = $2 AND event_time = $3
+		FOR UPDATE`,
+		ticketID, placeID, eventTime,
+	).Scan(&ticket.ID, &ticket.PlaceID, &ticket.SlotID, &ticket.Name, &ticket.Location, &ticket.UserToken, &ticket.EventTime, &ticket.Cost,
+		&ticket.Cancelled, &used)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to lock ticket: %w", op, err)
+	}
+	if ticket.Cancelled {
+		return nil, fmt.Errorf("%s: ticket %d: %w", op, ticket.ID, ErrTicketCancelled)
+	}
+	if used {
+		return nil, fmt.Errorf("%s: ticket %d: %w", op, ticket.ID, ErrTicketUsed)
 	}
-	defer rows.Close()
 
-	var places []*Place
-	for rows.Next() {
-		place := &Place{}
-		err := rows.Scan(
-			&place.ID, &place.Category, &place.Description, &place.Latitude, &place.Longitude,
-			&place.Location, &place.Name, &place.Tel, &place.Website, &place.Cost, &place.Time,
-		)
+	if _, err := tx.Exec(ctx, `UPDATE tickets SET used_at = NOW() WHERE id = $1`, ticket.ID); err != nil {
+		return nil, fmt.Errorf("%s: failed to mark ticket as used: %w", op, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("%s: failed to commit transaction: %w", op, err)
+	}
+	return ticket, nil
+}
+
+// SaveTicket books a seat in the place's slot starting at ticket.EventTime and stores the ticket together with
+// the outbox messages built for it in one transaction. The slot row is locked until commit, so concurrent buyers
+// cannot oversell it. If the user already has a ticket with the same idempotency key, that ticket is returned with
+// created == false and nothing new is written.
+func (s *PostgresStorage) SaveTicket(ctx context.Context, ticket *Ticket, buildMessages func(saved *Ticket) ([]*OutboxMessage, error)) (saved *Ticket, created bool, err error) {
+	const op = "storage.postgresql.SaveTicket"
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: failed to begin transaction: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if ticket.IdempotencyKey != "" {
+		existing, err := scanTicket(tx.QueryRow(ctx, `
+			SELECT id, COALESCE(place_id, 0), COALESCE(slot_id, 0), name, location, user_token, event_time, COALESCE(cost, 0) FROM tickets
+			WHERE user_token = $1 AND idempotency_key = $2`,
+			ticket.UserToken, ticket.IdempotencyKey,
+		))
+		if err == nil {
+			existing.IdempotencyKey = ticket.IdempotencyKey
+			return existing, false, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, false, fmt.Errorf("%s: failed to fetch ticket for idempotency key: %w", op, err)
+		}
+	}
+
+	slot := &Slot{}
+	err = tx.QueryRow(ctx, `
+		SELECT id, place_id, start_time, capacity, sold, price FROM slots
+		WHERE place_id = $1 AND start_time = $2
+		FOR UPDATE`,
+		ticket.PlaceID, ticket.EventTime,
+	).Scan(&slot.ID, &slot.PlaceID, &slot.StartTime, &slot.Capacity, &slot.Sold, &slot.Cost)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, fmt.Errorf("%s: place %d has no slot at %s: %w", op, ticket.PlaceID, ticket.EventTime, ErrSlotNotFound)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: failed to lock slot: %w", op, err)
+	}
+	if slot.Sold >= slot.Capacity {
+		return nil, false, fmt.Errorf("%s: slot %d: %w", op, slot.ID, ErrSlotSoldOut)
+	}
+
+	saved = &Ticket{}
+	err = tx.QueryRow(ctx, `
+		INSERT INTO tickets (place_id, slot_id, name, location, user_token, event_time, cost, idempotency_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''))
+		ON CONFLICT (user_token, idempotency_key) DO NOTHING
+		RETURNING id, place_id, slot_id, name, location, user_token, event_time, cost`,
+		slot.PlaceID, slot.ID, ticket.Name, ticket.Location, ticket.UserToken, slot.StartTime, slot.Cost, ticket.IdempotencyKey,
+	).Scan(&saved.ID, &saved.PlaceID, &saved.SlotID, &saved.Name, &saved.Location, &saved.UserToken, &saved.EventTime, &saved.Cost)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// A concurrent request with the same idempotency key committed first.
+		if err := tx.Rollback(ctx); err != nil {
+			return nil, false, fmt.Errorf("%s: failed to roll back transaction: %w", op, err)
+		}
+		existing, err := scanTicket(s.db.QueryRow(ctx, `
+			SELECT id, COALESCE(place_id, 0), COALESCE(slot_id, 0), name, location, user_token, event_time, COALESCE(cost, 0) FROM tickets
+			WHERE user_token = $1 AND idempotency_key = $2`,
+			ticket.UserToken, ticket.IdempotencyKey,
+		))
 		if err != nil {
-			return nil, fmt.Errorf("%s: failed to scan place: %w", op, err)
+			return nil, false, fmt.Errorf("%s: failed to fetch ticket for idempotency key: %w", op, err)
 		}
-		places = append(places, place)
+		existing.IdempotencyKey = ticket.IdempotencyKey
+		return existing, false, nil
 	}
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("%s: error occurred while iterating over places: %w", op, err)
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: failed to save ticket: %w", op, err)
 	}
-	if len(places) == 0 && len(args) > 0 {
-		fmt.Printf("No places found for query with arguments %v\n", args)
-		return nil, pgx.ErrNoRows
+	saved.IdempotencyKey = ticket.IdempotencyKey
+
+	if _, err := tx.Exec(ctx, `UPDATE slots SET sold = sold + 1 WHERE id = $1`, slot.ID); err != nil {
+		return nil, false, fmt.Errorf("%s: failed to book slot: %w", op, err)
 	}
-	return places, nil
-}
 
-func (s *PostgresStorage) CreateTables(ctx context.Context) error {
-	const op = "storage.postgresql.CreateTables"
-	tables := []string{
-		`CREATE TABLE IF NOT EXISTS places (
-			id SERIAL PRIMARY KEY,
-			category VARCHAR(255),
-			description TEXT,
-			latitude DOUBLE PRECISION,
-			longitude DOUBLE PRECISION,
-			location TEXT,
-			name VARCHAR(255),
-			tel VARCHAR(50),
-			website VARCHAR(255),
-			cost INT,
-			time VARCHAR(50)
-		)`,
-		`CREATE TABLE IF NOT EXISTS photos (
-			place_id INT REFERENCES places(id) ON DELETE CASCADE,
-			url TEXT
-		)`,
-		`CREATE TABLE IF NOT EXISTS tickets (
-			id SERIAL PRIMARY KEY,
-			name TEXT,
-			location TEXT,
-			user_token VARCHAR(255),
-			event_time TIMESTAMP
-		)`,
-	}
-
-	for _, table := range tables {
-		if _, err := s.db.Exec(ctx, table); err != nil {
-			return fmt.Errorf("%s: %w", op, err)
-		}
+	messages, err := buildMessages(saved)
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: failed to build outbox messages: %w", op, err)
+	}
+	if err := insertOutbox(ctx, tx, messages); err != nil {
+		return nil, false, fmt.Errorf("%s: %w", op, err)
 	}
-	return nil
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, false, fmt.Errorf("%s: failed to commit transaction: %w", op, err)
+	}
+	return saved, true, nil
 }
 
-func (s *PostgresStorage) FetchAndStoreData(ctx context.Context) error {
-	const op = "storage.postgresql.FetchAndStoreData"
-	url := "https://api.foursquare.com/v3/places/search?categories=10001%2C10002%2C10004%2C10009%2C10027%2C10028%2C10029%2C10030%2C10031%2C10044%2C10046%2C10047%2C10056%2C10058%2C10059%2C10068%2C10069%2C16005%2C16011%2C16020%2C16025%2C16026%2C16031%2C16034%2C16035%2C16038%2C16039%2C16041%2C16046%2C16047%2C16052&exclude_all_chains=true&fields=categories%2Cname%2Cdescription%2Cgeocodes%2Clocation%2Ctel%2Cphotos%2Cwebsite&polygon=54.9887%2C48.0821~56.2968%2C49.1917~56.5096%2C50.3453~55.8923%2C51.4659~55.7380%2C54.0586~55.1836%2C53.0369~54.3534%2C53.2347~54.7675%2C51.1912~54.9193%2C49.2466~54.6405%2C48.6644&limit=50"
+// CancelTicket cancels the user's ticket, releases its seat in the slot and stores the outbox messages built for it
+// in one transaction. The cancel callback runs while the ticket row is locked; it decides the refund amount and may
+// return an error to keep the ticket as it is. A missing ticket is reported as pgx.ErrNoRows.
+func (s *PostgresStorage) CancelTicket(ctx context.Context, ticketID int, userToken string, cancel func(ticket *Ticket) (refundAmount int, messages []*OutboxMessage, err error)) (*Ticket, error) {
+	const op = "storage.postgresql.CancelTicket"
 
-	req, err := http.NewRequest("GET", url, nil)
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to begin transaction: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	ticket := &Ticket{}
+	err = tx.QueryRow(ctx, `
+		SELECT id, COALESCE(place_id, 0), COALESCE(slot_id, 0), name, location, user_token, event_time, COALESCE(cost, 0), cancelled_at IS NOT NULL
+		FROM tickets
+		WHERE id = $1 AND user_token = $2
+		FOR UPDATE`,
+		ticketID, userToken,
+	).Scan(&ticket.ID, &ticket.PlaceID, &ticket.SlotID, &ticket.Name, &ticket.Location, &ticket.UserToken, &ticket.EventTime, &ticket.Cost, &ticket.Cancelled)
 	if err != nil {
-		return fmt.Errorf("%s: failed to create HTTP request: %w", op, err)
+		return nil, fmt.Errorf("%s: failed to lock ticket: %w", op, err)
 	}
-	req.Header.Add("accept", "application/json")
-	req.Header.Set("Accept-Language", "ru")
-	req.Header.Add("Authorization", "fsq3VM2gW4VslOMC96mTH1K/2xXH65KOnIO/TU8GiPI4Oic=")
-	res, err := http.DefaultClient.Do(req)
+	if ticket.Cancelled {
+		return nil, fmt.Errorf("%s: ticket %d: %w", op, ticket.ID, ErrTicketCancelled)
+	}
+
+	refundAmount, messages, err := cancel(ticket)
 	if err != nil {
-		return fmt.Errorf("%s: failed to execute HTTP request: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("%s: unexpected status code %d from API: %w", op, res.StatusCode, err)
+	if _, err := tx.Exec(ctx, `UPDATE tickets SET cancelled_at = NOW(), refund_amount = $2 WHERE id = $1`, ticket.ID, refundAmount); err != nil {
+		return nil, fmt.Errorf("%s: failed to cancel ticket: %w", op, err)
+	}
+	if ticket.SlotID != 0 {
+		if _, err := tx.Exec(ctx, `UPDATE slots SET sold = sold - 1 WHERE id = $1 AND sold > 0`, ticket.SlotID); err != nil {
+			return nil, fmt.Errorf("%s: failed to release slot: %w", op, err)
+		}
+	}
+	if err := insertOutbox(ctx, tx, messages); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	var count int
-	err = s.db.QueryRow(ctx, `SELECT COUNT(*) FROM places`).Scan(&count)
-	if err != nil {
-		return fmt.Errorf("%s: failed to count existing places: %w", op, err)
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("%s: failed to commit transaction: %w", op, err)
 	}
+	ticket.Cancelled = true
+	ticket.RefundAmount = refundAmount
+	return ticket, nil
+}
 
-	if count > 0 {
-		fmt.Println("Data already exists in the database. Skipping fetch and store.")
-		return nil
+func scanTicket(row pgx.Row) (*Ticket, error) {
+	ticket := &Ticket{}
+	err := row.Scan(&ticket.ID, &ticket.PlaceID, &ticket.SlotID, &ticket.Name, &ticket.Location, &ticket.UserToken, &ticket.EventTime, &ticket.Cost)
+	if err != nil {
+		return nil, err
 	}
+	return ticket, nil
+}
 
-	body, err := io.ReadAll(res.Body)
+// GetAvailableSlots returns the place's slots starting in [from, to) that still have free seats.
+func (s *PostgresStorage) GetAvailableSlots(ctx context.Context, placeID int, from, to time.Time) ([]*Slot, error) {
+	const op = "storage.postgresql.GetAvailableSlots"
+	rows, err := s.db.Query(ctx, `
+		SELECT id, place_id, start_time, capacity, sold, price FROM slots
+		WHERE place_id = $1 AND start_time >= $2 AND start_time < $3 AND sold < capacity
+		ORDER BY start_time`,
+		placeID, from, to)
 	if err != nil {
-		return fmt.Errorf("%s: failed to read response body: %w", op, err)
-	}
-
-	var apiResponse struct {
-		Results []struct {
-			Categories  []struct{ Name string } `json:"categories"`
-			Description string                  `json:"description,omitempty"`
-			Geocodes    struct {
-				Main struct {
-					Latitude  float64 `json:"latitude"`
-					Longitude float64 `json:"longitude"`
-				} `json:"main"`
-			} `json:"geocodes"`
-			Location struct {
-				FormattedAddress string `json:"formatted_address"`
-			} `json:"location"`
-			Name    string `json:"name"`
-			Tel     string `json:"tel,omitempty"`
-			Website string `json:"website,omitempty"`
-			Photos  []struct {
-				Prefix string `json:"prefix"`
-				Suffix string `json:"suffix"`
-			} `json:"photos,omitempty"`
-		} `json:"results"`
-	}
-
-	if err := json.Unmarshal(body, &apiResponse); err != nil {
-		return fmt.Errorf("%s: failed to parse API response: %w", op, err)
-	}
-
-	var places []Place
-	var photos []Photo
-
-	for i, place := range apiResponse.Results {
-		category := place.Categories[0].Name
-		if category == "Историческое место или особо охраняемая территория" {
-			category = "Историческое место"
-		}
-		dbPlace := Place{
-			ID:          i + 1,
-			Category:    category,
-			Description: place.Description,
-			Latitude:    place.Geocodes.Main.Latitude,
-			Longitude:   place.Geocodes.Main.Longitude,
-			Location:    place.Location.FormattedAddress,
-			Name:        place.Name,
-			Tel:         place.Tel,
-			Website:     place.Website,
-			Cost:        200 + rand.Intn(500),
-			Time:        fmt.Sprintf("%02d:00", rand.Intn(11)+10),
-		}
-		places = append(places, dbPlace)
-		for _, photo := range place.Photos {
-			dbPhoto := Photo{
-				PlaceID: dbPlace.ID,
-				Url:     photo.Prefix + "original" + photo.Suffix,
-			}
-			photos = append(photos, dbPhoto)
+		return nil, fmt.Errorf("%s: failed to query slots for place ID %d: %w", op, placeID, err)
+	}
+	defer rows.Close()
+
+	var slots []*Slot
+	for rows.Next() {
+		slot := &Slot{}
+		if err := rows.Scan(&slot.ID, &slot.PlaceID, &slot.StartTime, &slot.Capacity, &slot.Sold, &slot.Cost); err != nil {
+			return nil, fmt.Errorf("%s: failed to scan slot for place ID %d: %w", op, placeID, err)
 		}
+		slots = append(slots, slot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: error occurred while iterating over slots for place ID %d: %w", op, placeID, err)
 	}
+	return slots, nil
+}
 
-	fmt.Printf("Fetched %d places and %d photos from API\n", len(places), len(photos))
+// GetAvailableSlotTimes returns start times of the slots of the places with placeIDs that have free seats in
+// [from, to), grouped by place ID.
+func (s *PostgresStorage) GetAvailableSlotTimes(ctx context.Context, placeIDs []int, from, to time.Time) (map[int][]time.Time, error) {
+	const op = "storage.postgresql.GetAvailableSlotTimes"
+	rows, err := s.db.Query(ctx, `
+		SELECT place_id, start_time FROM slots
+		WHERE start_time >= $1 AND start_time < $2 AND sold < capacity AND place_id = ANY($3)
+		ORDER BY place_id, start_time`,
+		from, to, placeIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to query slots: %w", op, err)
+	}
+	defer rows.Close()
 
-	for _, place := range places {
-		_, err := s.db.Exec(ctx, `
-			INSERT INTO places (category, description, latitude, longitude, location, name, tel, website, cost, time)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
-			place.Category, place.Description, place.Latitude, place.Longitude, place.Location, place.Name, place.Tel, place.Website, place.Cost, place.Time)
-		if err != nil {
-			return fmt.Errorf("%s: failed to insert place into database: %w", op, err)
+	times := make(map[int][]time.Time)
+	for rows.Next() {
+		var placeID int
+		var startTime time.Time
+		if err := rows.Scan(&placeID, &startTime); err != nil {
+			return nil, fmt.Errorf("%s: failed to scan slot: %w", op, err)
 		}
+		times[placeID] = append(times[placeID], startTime)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: error occurred while iterating over slots: %w", op, err)
 	}
+	return times, nil
+}
 
-	for _, photo := range photos {
-		_, err := s.db.Exec(ctx, `INSERT INTO photos (place_id, url) VALUES ($1, $2)`, photo.PlaceID, photo.Url)
-		if err != nil {
-			return fmt.Errorf("%s: failed to insert photo into database: %w", op, err)
-		}
+// GenerateSlots creates slots for the next days from the opening hours of every place that is not deleted. Places
+// without opening hours get the default 10:00-20:00 schedule. Opening hours are local time at utcOffset; slots are
+// stored in UTC. Existing slots are left untouched, so it is safe to run repeatedly.
+func (s *PostgresStorage) GenerateSlots(ctx context.Context, days int, slotDuration time.Duration, capacity int, utcOffset time.Duration) (int, error) {
+	const op = "storage.postgresql.GenerateSlots"
+
+	_, err := s.db.Exec(ctx, `
+		INSERT INTO opening_hours (place_id, weekday, opens_at, closes_at)
+		SELECT p.id, w, TIME '10:00', TIME '20:00'
+		FROM places p CROSS JOIN generate_series(1, 7) AS w
+		WHERE p.deleted_at IS NULL AND NOT EXISTS (SELECT 1 FROM opening_hours h WHERE h.place_id = p.id)`)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to add default opening hours: %w", op, err)
+	}
+
+	tag, err := s.db.Exec(ctx, `
+		INSERT INTO slots (place_id, start_time, capacity, price)
+		SELECT h.place_id, st - make_interval(secs => $4), $3, p.cost
+		FROM opening_hours h
+		JOIN places p ON p.id = h.place_id
+		CROSS JOIN generate_series(
+			date_trunc('day', NOW() AT TIME ZONE 'UTC' + make_interval(secs => $4)),
+			date_trunc('day', NOW() AT TIME ZONE 'UTC' + make_interval(secs => $4)) + make_interval(days => $1),
+			INTERVAL '1 day'
+		) AS d
+		CROSS JOIN LATERAL generate_series(d + h.opens_at, d + h.closes_at - make_interval(mins => $2), make_interval(mins => $2)) AS st
+		WHERE EXTRACT(ISODOW FROM d) = h.weekday AND p.deleted_at IS NULL
+		ON CONFLICT (place_id, start_time) DO NOTHING`,
+		days, int(slotDuration.Minutes()), capacity, utcOffset.Seconds())
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to generate slots: %w", op, err)
 	}
+	return int(tag.RowsAffected()), nil
+}
+
+// PublishOutbox hands up to limit unpublished messages of the outbox to publish, see outbox.Publish.
+func (s *PostgresStorage) PublishOutbox(ctx context.Context, limit int, publish func(*OutboxMessage) error) (int, error) {
+	return outbox.Publish(ctx, s.db, outboxTable, limit, publish)
+}
+
+func insertOutbox(ctx context.Context, tx pgx.Tx, messages []*OutboxMessage) error {
+	return outbox.Insert(ctx, tx, outboxTable, messages)
+}
 
-	fmt.Println("Data successfully fetched and stored")
-	return nil
+// scanPlace reads a place selected with placeColumns.
+func scanPlace(row pgx.Row) (*Place, error) {
+	place := &Place{}
+	err := row.Scan(
+		&place.ID, &place.Category, &place.Description, &place.Latitude, &place.Longitude,
+		&place.Location, &place.Name, &place.Tel, &place.Website, &place.Cost, &place.Time,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return place, nil
 }
@@ -0,0 +1,18 @@
+package storage
+
+import (
+	"context"
+	"github.com/GP-Hacks/kdt2024-commons/pgtest"
+	"testing"
+)
+
+// testStorage returns a migrated storage in a schema of its own, see pgtest.Connect. It skips tb without a test
+// database.
+func testStorage(tb testing.TB) *PostgresStorage {
+	tb.Helper()
+	s := &PostgresStorage{db: pgtest.Connect(tb)}
+	if _, err := s.Migrate(context.Background()); err != nil {
+		tb.Fatalf("failed to migrate: %v", err)
+	}
+	return s
+}
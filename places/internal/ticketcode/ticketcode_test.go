@@ -0,0 +1,90 @@
+package ticketcode
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testSigner(t *testing.T, secret string) *Signer {
+	t.Helper()
+	signer, err := NewSigner([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer
+}
+
+func TestSignVerifyRoundTrip(t *testing.T) {
+	signer := testSigner(t, "ticket-secret")
+	claims := Claims{TicketID: 42, PlaceID: 7, EventTime: time.Date(2024, 9, 1, 18, 30, 0, 0, time.UTC)}
+
+	code := signer.Sign(claims)
+	got, err := signer.Verify(code)
+	if err != nil {
+		t.Fatalf("Verify(%q) error = %v", code, err)
+	}
+	if *got != claims {
+		t.Errorf("Verify() = %+v, want %+v", *got, claims)
+	}
+}
+
+// flip replaces the character of code at i with another one of the base64url alphabet.
+func flip(code string, i int) string {
+	replacement := byte('A')
+	if code[i] == 'A' {
+		replacement = 'B'
+	}
+	return code[:i] + string(replacement) + code[i+1:]
+}
+
+func TestVerifyRejectsInvalidCodes(t *testing.T) {
+	signer := testSigner(t, "ticket-secret")
+	code := signer.Sign(Claims{TicketID: 42, PlaceID: 7, EventTime: time.Unix(1725215400, 0)})
+	payload, signature := code[:strings.LastIndexByte(code, '.')], code[strings.LastIndexByte(code, '.')+1:]
+
+	tests := []struct {
+		name string
+		code string
+	}{
+		{name: "tampered ticket", code: "43" + code[2:]},
+		{name: "tampered place", code: strings.Replace(code, ".7.", ".8.", 1)},
+		{name: "tampered event time", code: strings.Replace(code, ".1725215400.", ".1725219000.", 1)},
+		{name: "tampered signature", code: flip(code, len(code)-5)},
+		{name: "signature of another ticket", code: payload + "." + strings.Split(signer.Sign(Claims{TicketID: 43, PlaceID: 7}), ".")[3]},
+		{name: "wrong secret", code: testSigner(t, "other-secret").Sign(Claims{TicketID: 42, PlaceID: 7, EventTime: time.Unix(1725215400, 0)})},
+		{name: "truncated signature", code: payload + "." + signature[:len(signature)-4]},
+		{name: "missing signature", code: payload + "."},
+		{name: "payload only", code: payload},
+		{name: "non-base64 signature", code: payload + ".!!not*base64!!"},
+		{name: "extra part", code: "1." + code},
+		{name: "empty", code: ""},
+		{name: "garbage", code: "not a ticket code"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := signer.Verify(tt.code)
+			if !errors.Is(err, ErrInvalidCode) {
+				t.Fatalf("Verify(%q) = %+v, %v, want ErrInvalidCode", tt.code, claims, err)
+			}
+		})
+	}
+}
+
+func TestVerifyRejectsSignedMalformedPayload(t *testing.T) {
+	signer := testSigner(t, "ticket-secret")
+	for _, payload := range []string{"a.7.1725215400", "42.7", "42.7.1725215400.1"} {
+		code := payload + "." + signer.signature(payload)
+		if _, err := signer.Verify(code); !errors.Is(err, ErrInvalidCode) {
+			t.Errorf("Verify(%q) error = %v, want ErrInvalidCode", code, err)
+		}
+	}
+}
+
+func TestNewSignerRejectsEmptySecret(t *testing.T) {
+	if _, err := NewSigner(nil); err == nil {
+		t.Error("NewSigner(nil) succeeded")
+	}
+}
@@ -3,16 +3,28 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/migrate"
 	"github.com/GP-Hacks/kdt2024-commons/prettylogger"
 	"github.com/GP-Hacks/kdt2024-purchases/config"
+	"github.com/GP-Hacks/kdt2024-purchases/internal/grpc-server/handler"
+	"github.com/GP-Hacks/kdt2024-purchases/internal/storage"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/streadway/amqp"
+	"google.golang.org/grpc"
 	"log/slog"
+	"net"
+	"os"
 	"time"
 )
 
+// errMalformedMessage marks messages that can never be processed and must not be redelivered.
+var errMalformedMessage = errors.New("malformed message")
+
 type PurchaseMessage struct {
+	TicketID     int       `json:"ticket_id"`
 	UserToken    string    `json:"user_token"`
 	PlaceID      int       `json:"place_id"`
 	EventTime    time.Time `json:"event_time"`
@@ -20,16 +32,40 @@ type PurchaseMessage struct {
 	Cost         int       `json:"cost"`
 }
 
-type DonationMessage struct {
+type RefundMessage struct {
+	TicketID     int       `json:"ticket_id"`
 	UserToken    string    `json:"user_token"`
-	CollectionID int       `json:"collection_id"`
-	DonationTime time.Time `json:"donation_time"`
-	Amount       int       `json:"amount"`
+	RefundAmount int       `json:"refund_amount"`
+	RefundTime   time.Time `json:"refund_time"`
+}
+
+type DonationMessage struct {
+	DonationID     int       `json:"donation_id"`
+	UserToken      string    `json:"user_token"`
+	CollectionID   int       `json:"collection_id"`
+	CollectionName string    `json:"collection_name"`
+	Organization   string    `json:"organization"`
+	DonationTime   time.Time `json:"donation_time"`
+	Amount         int       `json:"amount"`
+}
+
+type DonationRefundMessage struct {
+	DonationID int       `json:"donation_id"`
+	UserToken  string    `json:"user_token"`
+	RefundedAt time.Time `json:"refunded_at"`
 }
 
 func main() {
 	cfg := config.MustLoad()
 	log := prettylogger.SetupLogger(cfg.Env)
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(cfg, log, os.Args[2:]); err != nil {
+			os.Exit(1)
+		}
+		return
+	}
+
 	log.Info("Configuration loaded")
 	log.Info("Logger initialized")
 
@@ -40,11 +76,26 @@ func main() {
 	}
 	log.Info("Postgres connected")
 
-	if err := createTables(context.Background(), dbpool, log); err != nil {
-		log.Error("Failed to create necessary tables", slog.String("error", err.Error()))
+	storage := storage.NewPostgresStorage(dbpool)
+	if err := migrate.UpWithLog(context.Background(), storage.Migrate, log); err != nil {
 		return
 	}
 
+	l, err := net.Listen("tcp", cfg.Address)
+	if err != nil {
+		log.Error("Failed to start listener for PurchasesService", slog.String("address", cfg.Address), slog.String("error", err.Error()))
+		return
+	}
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(auth.UnaryServerInterceptor()))
+	handler.NewGRPCHandler(cfg, grpcServer, storage, log)
+	go func() {
+		if err := grpcServer.Serve(l); err != nil {
+			log.Error("Error serving gRPC server for PurchasesService", slog.String("address", cfg.Address), slog.String("error", err.Error()))
+		}
+	}()
+	defer grpcServer.GracefulStop()
+	log.Info("gRPC server started", slog.String("address", cfg.Address))
+
 	conn, err := amqp.Dial(cfg.RabbitMQAddress)
 	if err != nil {
 		log.Error("RabbitMQ connection error", slog.String("error", err.Error()))
@@ -74,7 +125,7 @@ func main() {
 	msgs, err := ch.Consume(
 		cfg.QueueName,
 		"",
-		true,
+		false,
 		false,
 		false,
 		false,
@@ -88,43 +139,54 @@ func main() {
 	log.Info("RabbitMQ connected and consuming messages")
 
 	for msg := range msgs {
-		if err := processMessage(context.Background(), msg, dbpool, log); err != nil {
-			log.Error("Message processing error", slog.String("error", err.Error()))
+		err := processMessage(context.Background(), msg, dbpool, log)
+		if err == nil {
+			if err := msg.Ack(false); err != nil {
+				log.Error("Failed to ack message", slog.String("error", err.Error()))
+			}
+			continue
+		}
+
+		log.Error("Message processing error", slog.String("error", err.Error()))
+		requeue := !errors.Is(err, errMalformedMessage)
+		if err := msg.Nack(false, requeue); err != nil {
+			log.Error("Failed to nack message", slog.String("error", err.Error()))
 		}
 	}
 }
 
-func createTables(ctx context.Context, dbpool *pgxpool.Pool, log *slog.Logger) error {
-	const createTablesQuery = `
-		CREATE TABLE IF NOT EXISTS ticket_purchases (
-			user_token TEXT,
-			place_id INT,
-			event_time TIMESTAMP,
-			purchase_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-			cost INT
-		);
-		CREATE TABLE IF NOT EXISTS donations (
-			user_token TEXT,
-			collection_id INT,
-			donation_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-			amount INT
-		);
-	`
-	_, err := dbpool.Exec(ctx, createTablesQuery)
+// runMigrate runs the migrate subcommand, which applies, reverts or lists the migrations without starting the service.
+func runMigrate(cfg *config.Config, log *slog.Logger, args []string) error {
+	dbpool, err := pgxpool.New(context.Background(), cfg.PostgresAddress+"?sslmode=disable")
+	if err != nil {
+		log.Error("Postgres connection error", slog.String("error", err.Error()))
+		return err
+	}
+	defer dbpool.Close()
+
+	migrator, err := storage.NewPostgresStorage(dbpool).Migrator()
 	if err != nil {
-		return fmt.Errorf("failed to create tables: %w", err)
+		log.Error("Failed to load migrations", slog.String("error", err.Error()))
+		return err
+	}
+	if err := migrate.Run(context.Background(), migrator, args, os.Stdout); err != nil {
+		log.Error("Migrate command failed", slog.String("error", err.Error()))
+		return err
 	}
-	log.Info("Tables created or already exist")
 	return nil
 }
 
 func processMessage(ctx context.Context, msg amqp.Delivery, dbpool *pgxpool.Pool, log *slog.Logger) error {
 	var messageType map[string]interface{}
 	if err := json.Unmarshal(msg.Body, &messageType); err != nil {
-		return fmt.Errorf("failed to unmarshal message body: %w", err)
+		return fmt.Errorf("%w: failed to unmarshal message body: %w", errMalformedMessage, err)
 	}
 
-	if _, ok := messageType["place_id"]; ok {
+	if _, ok := messageType["refunded_at"]; ok {
+		return processDonationRefundMessage(ctx, msg.Body, dbpool, log)
+	} else if _, ok := messageType["refund_amount"]; ok {
+		return processRefundMessage(ctx, msg.Body, dbpool, log)
+	} else if _, ok := messageType["place_id"]; ok {
 		return processPurchaseMessage(ctx, msg.Body, dbpool, log)
 	} else if _, ok := messageType["collection_id"]; ok {
 		return processDonationMessage(ctx, msg.Body, dbpool, log)
@@ -137,39 +199,95 @@ func processMessage(ctx context.Context, msg amqp.Delivery, dbpool *pgxpool.Pool
 func processPurchaseMessage(ctx context.Context, body []byte, dbpool *pgxpool.Pool, log *slog.Logger) error {
 	var dbmsg PurchaseMessage
 	if err := json.Unmarshal(body, &dbmsg); err != nil {
-		return fmt.Errorf("failed to unmarshal purchase message: %w", err)
+		return fmt.Errorf("%w: failed to unmarshal purchase message: %w", errMalformedMessage, err)
 	}
 
-	if dbmsg.UserToken == "" || dbmsg.PlaceID == 0 || dbmsg.EventTime.IsZero() || dbmsg.Cost == 0 {
+	if dbmsg.TicketID == 0 || dbmsg.UserToken == "" || dbmsg.PlaceID == 0 || dbmsg.EventTime.IsZero() || dbmsg.Cost == 0 {
 		log.Warn("Received invalid purchase message", slog.Any("message", dbmsg))
 		return nil
 	}
 
-	_, err := dbpool.Exec(ctx, `INSERT INTO ticket_purchases(user_token, place_id, event_time, purchase_time, cost) VALUES ($1, $2, $3, $4, $5)`,
-		dbmsg.UserToken, dbmsg.PlaceID, dbmsg.EventTime, dbmsg.PurchaseTime, dbmsg.Cost)
+	tag, err := dbpool.Exec(ctx, `INSERT INTO ticket_purchases(ticket_id, user_token, place_id, event_time, purchase_time, cost) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (ticket_id) DO NOTHING`,
+		dbmsg.TicketID, dbmsg.UserToken, dbmsg.PlaceID, dbmsg.EventTime, dbmsg.PurchaseTime, dbmsg.Cost)
 	if err != nil {
 		return fmt.Errorf("failed to insert purchase message into Postgres: %w", err)
 	}
+	if tag.RowsAffected() == 0 {
+		log.Info("Ticket purchase already saved, skipping duplicate", slog.Int("ticket_id", dbmsg.TicketID))
+		return nil
+	}
 	log.Info("Saved ticket purchase", slog.Any("purchase_message", dbmsg))
 	return nil
 }
 
+func processRefundMessage(ctx context.Context, body []byte, dbpool *pgxpool.Pool, log *slog.Logger) error {
+	var dbmsg RefundMessage
+	if err := json.Unmarshal(body, &dbmsg); err != nil {
+		return fmt.Errorf("%w: failed to unmarshal refund message: %w", errMalformedMessage, err)
+	}
+
+	if dbmsg.TicketID == 0 || dbmsg.UserToken == "" || dbmsg.RefundAmount <= 0 {
+		log.Warn("Received invalid refund message", slog.Any("message", dbmsg))
+		return nil
+	}
+
+	tag, err := dbpool.Exec(ctx, `INSERT INTO refunds(ticket_id, user_token, refund_time, amount) VALUES ($1, $2, $3, $4) ON CONFLICT (ticket_id) DO NOTHING`,
+		dbmsg.TicketID, dbmsg.UserToken, dbmsg.RefundTime, dbmsg.RefundAmount)
+	if err != nil {
+		return fmt.Errorf("failed to insert refund message into Postgres: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		log.Info("Refund already saved, skipping duplicate", slog.Int("ticket_id", dbmsg.TicketID))
+		return nil
+	}
+	log.Info("Saved refund", slog.Any("refund_message", dbmsg))
+	return nil
+}
+
 func processDonationMessage(ctx context.Context, body []byte, dbpool *pgxpool.Pool, log *slog.Logger) error {
 	var dbmsg DonationMessage
 	if err := json.Unmarshal(body, &dbmsg); err != nil {
-		return fmt.Errorf("failed to unmarshal donation message: %w", err)
+		return fmt.Errorf("%w: failed to unmarshal donation message: %w", errMalformedMessage, err)
 	}
 
-	if dbmsg.UserToken == "" || dbmsg.CollectionID == 0 || dbmsg.Amount == 0 {
+	if dbmsg.DonationID == 0 || dbmsg.UserToken == "" || dbmsg.CollectionID == 0 || dbmsg.Amount == 0 {
 		log.Warn("Received invalid donation message", slog.Any("message", dbmsg))
 		return nil
 	}
 
-	_, err := dbpool.Exec(ctx, `INSERT INTO donations(user_token, collection_id, donation_time, amount) VALUES ($1, $2, $3, $4)`,
-		dbmsg.UserToken, dbmsg.CollectionID, dbmsg.DonationTime, dbmsg.Amount)
+	// The receipt number is assigned by a trigger from the year of donation_time.
+	tag, err := dbpool.Exec(ctx, `INSERT INTO donations(donation_id, user_token, collection_id, collection_name, organization, donation_time, amount) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (donation_id) DO NOTHING`,
+		dbmsg.DonationID, dbmsg.UserToken, dbmsg.CollectionID, dbmsg.CollectionName, dbmsg.Organization, dbmsg.DonationTime, dbmsg.Amount)
 	if err != nil {
 		return fmt.Errorf("failed to insert donation message into Postgres: %w", err)
 	}
+	if tag.RowsAffected() == 0 {
+		log.Info("Donation already saved, skipping duplicate", slog.Int("donation_id", dbmsg.DonationID))
+		return nil
+	}
 	log.Info("Saved donation", slog.Any("donation_message", dbmsg))
 	return nil
 }
+
+func processDonationRefundMessage(ctx context.Context, body []byte, dbpool *pgxpool.Pool, log *slog.Logger) error {
+	var dbmsg DonationRefundMessage
+	if err := json.Unmarshal(body, &dbmsg); err != nil {
+		return fmt.Errorf("%w: failed to unmarshal donation refund message: %w", errMalformedMessage, err)
+	}
+
+	if dbmsg.DonationID == 0 || dbmsg.RefundedAt.IsZero() {
+		log.Warn("Received invalid donation refund message", slog.Any("message", dbmsg))
+		return nil
+	}
+
+	tag, err := dbpool.Exec(ctx, `UPDATE donations SET refunded_at = $2 WHERE donation_id = $1 AND refunded_at IS NULL`, dbmsg.DonationID, dbmsg.RefundedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save donation refund into Postgres: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		log.Warn("Donation refund matched no unrefunded donation, skipping", slog.Int("donation_id", dbmsg.DonationID))
+		return nil
+	}
+	log.Info("Saved donation refund", slog.Any("refund_message", dbmsg))
+	return nil
+}
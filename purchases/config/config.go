@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"time"
 )
 
 type Config struct {
@@ -10,6 +11,8 @@ type Config struct {
 	RabbitMQAddress string
 	QueueName       string
 	PostgresAddress string
+	// Location is used for receipt dates and statement years.
+	Location *time.Location
 }
 
 func MustLoad() *Config {
@@ -19,5 +22,6 @@ func MustLoad() *Config {
 		RabbitMQAddress: os.Getenv("RABBITMQ_ADDRESS"),
 		QueueName:       os.Getenv("QUEUE_NAME"),
 		PostgresAddress: os.Getenv("POSTGRES_ADDRESS"),
+		Location:        time.FixedZone("MSK", 3*60*60),
 	}
 }
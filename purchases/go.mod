@@ -1,3 +1,5 @@
 module github.com/GP-Hacks/kdt2024-purchases
 
 go 1.23
+
+require github.com/jung-kurt/gofpdf v1.16.2 // indirect
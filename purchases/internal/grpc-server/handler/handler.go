@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-purchases/config"
+	"github.com/GP-Hacks/kdt2024-purchases/internal/receipt"
+	"github.com/GP-Hacks/kdt2024-purchases/internal/storage"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type GRPCHandler struct {
+	cfg *config.Config
+	proto.UnimplementedPurchasesServiceServer
+	storage *storage.PostgresStorage
+	logger  *slog.Logger
+}
+
+func NewGRPCHandler(cfg *config.Config, server *grpc.Server, storage *storage.PostgresStorage, logger *slog.Logger) *GRPCHandler {
+	handler := &GRPCHandler{cfg: cfg, storage: storage, logger: logger}
+	proto.RegisterPurchasesServiceServer(server, handler)
+	logger.Info("GRPCHandler initialized", slog.String("address", cfg.Address))
+	return handler
+}
+
+func (h *GRPCHandler) GetDonationReceipt(ctx context.Context, request *proto.GetDonationReceiptRequest) (*proto.GetDonationReceiptResponse, error) {
+	h.logger.Debug("Received GetDonationReceipt request", slog.Any("request", request))
+
+	select {
+	case <-ctx.Done():
+		h.logger.Warn("GetDonationReceipt request was cancelled by client")
+		return nil, status.Errorf(codes.Canceled, "Request was cancelled")
+	default:
+	}
+
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		h.logger.Warn("GetDonationReceipt request is not authenticated")
+		return nil, err
+	}
+
+	rec, err := h.storage.GetReceipt(ctx, int(request.GetDonationId()), userID)
+	if err != nil {
+		return nil, h.handleStorageError(err, "receipt")
+	}
+
+	response := &proto.GetDonationReceiptResponse{Receipt: toProtoReceipt(rec)}
+	if request.GetPdf() {
+		response.Pdf, err = receipt.RenderReceipt(rec, h.cfg.Location)
+		if err != nil {
+			h.logger.Error("Failed to render receipt", slog.String("number", rec.Number), slog.String("error", err.Error()))
+			return nil, status.Errorf(codes.Internal, "Internal server error, please try again later")
+		}
+	}
+
+	return response, nil
+}
+
+func (h *GRPCHandler) GetDonationStatement(ctx context.Context, request *proto.GetDonationStatementRequest) (*proto.GetDonationStatementResponse, error) {
+	h.logger.Debug("Received GetDonationStatement request", slog.Any("request", request))
+
+	select {
+	case <-ctx.Done():
+		h.logger.Warn("GetDonationStatement request was cancelled by client")
+		return nil, status.Errorf(codes.Canceled, "Request was cancelled")
+	default:
+	}
+
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		h.logger.Warn("GetDonationStatement request is not authenticated")
+		return nil, err
+	}
+
+	year := int(request.GetYear())
+	if year < 2000 || year > time.Now().In(h.cfg.Location).Year() {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid year")
+	}
+
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, h.cfg.Location)
+	receipts, err := h.storage.GetReceipts(ctx, userID, from, from.AddDate(1, 0, 0))
+	if err != nil {
+		return nil, h.handleStorageError(err, "donations")
+	}
+	statement := receipt.NewStatement(year, receipts)
+
+	response := &proto.GetDonationStatementResponse{Statement: toProtoStatement(statement)}
+	if request.GetPdf() {
+		response.Pdf, err = receipt.RenderStatement(statement, h.cfg.Location)
+		if err != nil {
+			h.logger.Error("Failed to render statement", slog.Int("year", year), slog.String("error", err.Error()))
+			return nil, status.Errorf(codes.Internal, "Internal server error, please try again later")
+		}
+	}
+
+	return response, nil
+}
+
+func (h *GRPCHandler) HealthCheck(ctx context.Context, req *proto.HealthCheckRequest) (*proto.HealthCheckResponse, error) {
+	h.logger.Debug("Received HealthCheck request")
+
+	h.logger.Info("HealthCheck passed")
+	return &proto.HealthCheckResponse{IsHealthy: true}, nil
+}
+
+func toProtoReceipt(rec *storage.Receipt) *proto.DonationReceipt {
+	return &proto.DonationReceipt{
+		Number:       rec.Number,
+		DonationId:   int32(rec.DonationID),
+		Organization: rec.Organization,
+		Collection:   rec.Collection,
+		Amount:       int32(rec.Amount),
+		Timestamp:    timestamppb.New(rec.DonationTime),
+		Refunded:     rec.RefundedAt != nil,
+	}
+}
+
+func toProtoStatement(statement *receipt.Statement) *proto.DonationStatement {
+	response := &proto.DonationStatement{
+		Year:  int32(statement.Year),
+		Total: int32(statement.Total),
+	}
+	for _, total := range statement.Organizations {
+		response.Organizations = append(response.Organizations, &proto.OrganizationDonations{
+			Organization: total.Organization,
+			Amount:       int32(total.Amount),
+			Count:        int32(total.Count),
+		})
+	}
+	for _, rec := range statement.Receipts {
+		response.Receipts = append(response.Receipts, toProtoReceipt(rec))
+	}
+	return response
+}
+
+func (h *GRPCHandler) handleStorageError(err error, entity string) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		h.logger.Warn("No records found in database", slog.String("entity", entity), slog.Any("error", err.Error()))
+		return status.Errorf(codes.NotFound, "No %s found in database", entity)
+	}
+	h.logger.Error("Database operation failed", slog.String("entity", entity), slog.Any("error", err.Error()))
+	return status.Errorf(codes.Internal, "Internal server error, please try again later")
+}
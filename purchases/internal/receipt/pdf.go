@@ -0,0 +1,137 @@
+package receipt
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/GP-Hacks/kdt2024-purchases/internal/storage"
+	"github.com/jung-kurt/gofpdf"
+)
+
+// DejaVu Sans covers Cyrillic, which the PDF core fonts do not.
+//
+//go:embed fonts/DejaVuSans.ttf
+var font []byte
+
+const (
+	fontFamily     = "DejaVu"
+	unknownOrg     = "Организация не указана"
+	dateTimeLayout = "02.01.2006 15:04"
+)
+
+// RenderReceipt renders the donation receipt as PDF. Times are shown in loc.
+func RenderReceipt(receipt *storage.Receipt, loc *time.Location) ([]byte, error) {
+	pdf := newDocument()
+
+	pdf.SetFont(fontFamily, "", 16)
+	pdf.MultiCell(0, 10, "Квитанция о пожертвовании № "+receipt.Number, "", "L", false)
+	pdf.Ln(4)
+
+	pdf.SetFont(fontFamily, "", 11)
+	field(pdf, "Дата", receipt.DonationTime.In(loc).Format(dateTimeLayout))
+	field(pdf, "Организация", organization(receipt.Organization))
+	if receipt.Collection != "" {
+		field(pdf, "Сбор", receipt.Collection)
+	}
+	field(pdf, "Сумма", formatAmount(receipt.Amount))
+	if receipt.RefundedAt != nil {
+		field(pdf, "Статус", "Возвращено "+receipt.RefundedAt.In(loc).Format(dateTimeLayout))
+	}
+
+	return output(pdf)
+}
+
+// RenderStatement renders the annual statement as PDF: totals by organization followed by every donation.
+func RenderStatement(statement *Statement, loc *time.Location) ([]byte, error) {
+	pdf := newDocument()
+
+	pdf.SetFont(fontFamily, "", 16)
+	pdf.MultiCell(0, 10, fmt.Sprintf("Справка о пожертвованиях за %d год", statement.Year), "", "L", false)
+	pdf.Ln(4)
+
+	pdf.SetFont(fontFamily, "", 10)
+	row(pdf, []float64{120, 25, 45}, []string{"Организация", "Количество", "Сумма"}, true)
+	for _, total := range statement.Organizations {
+		row(pdf, []float64{120, 25, 45}, []string{organization(total.Organization), strconv.Itoa(total.Count), formatAmount(total.Amount)}, false)
+	}
+	row(pdf, []float64{145, 45}, []string{"Итого", formatAmount(statement.Total)}, true)
+	pdf.Ln(8)
+
+	row(pdf, []float64{40, 35, 80, 35}, []string{"Квитанция", "Дата", "Организация", "Сумма"}, true)
+	for _, receipt := range statement.Receipts {
+		row(pdf, []float64{40, 35, 80, 35}, []string{
+			receipt.Number,
+			receipt.DonationTime.In(loc).Format(dateTimeLayout),
+			organization(receipt.Organization),
+			formatAmount(receipt.Amount),
+		}, false)
+	}
+
+	return output(pdf)
+}
+
+func newDocument() *gofpdf.Fpdf {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.AddUTF8FontFromBytes(fontFamily, "", font)
+	pdf.SetMargins(15, 15, 15)
+	pdf.AddPage()
+	return pdf
+}
+
+func field(pdf *gofpdf.Fpdf, name, value string) {
+	pdf.CellFormat(40, 8, name+":", "", 0, "L", false, 0, "")
+	pdf.MultiCell(0, 8, value, "", "L", false)
+}
+
+func row(pdf *gofpdf.Fpdf, widths []float64, values []string, header bool) {
+	pdf.SetFillColor(235, 235, 235)
+	for i, value := range values {
+		align := "L"
+		if i == len(values)-1 {
+			align = "R"
+		}
+		// Long values are cut to the cell instead of wrapping, keeping rows aligned.
+		for pdf.GetStringWidth(value) > widths[i]-2 && len([]rune(value)) > 1 {
+			runes := []rune(value)
+			value = string(runes[:len(runes)-2]) + "…"
+		}
+		pdf.CellFormat(widths[i], 7, value, "1", 0, align, header, 0, "")
+	}
+	pdf.Ln(-1)
+}
+
+func output(pdf *gofpdf.Fpdf) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("receipt: failed to render PDF: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func organization(name string) string {
+	if name == "" {
+		return unknownOrg
+	}
+	return name
+}
+
+// formatAmount formats whole rubles with spaces between thousands, e.g. "1 250 000 руб.".
+func formatAmount(amount int) string {
+	digits := strconv.Itoa(amount)
+	sign := ""
+	if amount < 0 {
+		sign, digits = "-", digits[1:]
+	}
+
+	var grouped []byte
+	for i := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			grouped = append(grouped, ' ')
+		}
+		grouped = append(grouped, digits[i])
+	}
+	return sign + string(grouped) + " руб."
+}
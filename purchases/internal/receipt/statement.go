@@ -0,0 +1,46 @@
+package receipt
+
+import (
+	"sort"
+
+	"github.com/GP-Hacks/kdt2024-purchases/internal/storage"
+)
+
+type OrganizationTotal struct {
+	Organization string
+	Amount       int
+	Count        int
+}
+
+// Statement is the annual summary of a user's donations for tax deduction.
+type Statement struct {
+	Year          int
+	Total         int
+	Organizations []OrganizationTotal
+	Receipts      []*storage.Receipt
+}
+
+// NewStatement aggregates the receipts by organization. Organizations are sorted by name.
+func NewStatement(year int, receipts []*storage.Receipt) *Statement {
+	statement := &Statement{Year: year, Receipts: receipts}
+
+	index := make(map[string]int)
+	for _, receipt := range receipts {
+		statement.Total += receipt.Amount
+
+		i, ok := index[receipt.Organization]
+		if !ok {
+			i = len(statement.Organizations)
+			index[receipt.Organization] = i
+			statement.Organizations = append(statement.Organizations, OrganizationTotal{Organization: receipt.Organization})
+		}
+		statement.Organizations[i].Amount += receipt.Amount
+		statement.Organizations[i].Count++
+	}
+
+	sort.Slice(statement.Organizations, func(i, j int) bool {
+		return statement.Organizations[i].Organization < statement.Organizations[j].Organization
+	})
+
+	return statement
+}
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS donations;
+DROP SEQUENCE IF EXISTS donation_receipt_seq;
+DROP TABLE IF EXISTS refunds;
+DROP TABLE IF EXISTS ticket_purchases;
@@ -0,0 +1,53 @@
+-- Baseline of the schema created before migrations were introduced, the statements are idempotent so the
+-- migration also applies to databases created by earlier releases.
+
+CREATE TABLE IF NOT EXISTS ticket_purchases (
+    user_token TEXT,
+    place_id INT,
+    event_time TIMESTAMP,
+    purchase_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    cost INT
+);
+
+ALTER TABLE ticket_purchases ADD COLUMN IF NOT EXISTS ticket_id INT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS ticket_purchases_ticket_id_idx ON ticket_purchases (ticket_id);
+
+CREATE TABLE IF NOT EXISTS refunds (
+    ticket_id INT PRIMARY KEY,
+    user_token TEXT,
+    refund_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    amount INT
+);
+
+CREATE TABLE IF NOT EXISTS donations (
+    user_token TEXT,
+    collection_id INT,
+    donation_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    amount INT
+);
+
+ALTER TABLE donations ADD COLUMN IF NOT EXISTS donation_id INT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS donations_donation_id_idx ON donations (donation_id);
+
+ALTER TABLE donations ADD COLUMN IF NOT EXISTS collection_name TEXT;
+
+ALTER TABLE donations ADD COLUMN IF NOT EXISTS organization TEXT;
+
+ALTER TABLE donations ADD COLUMN IF NOT EXISTS refunded_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS donations_user_token_donation_time_idx ON donations (user_token, donation_time);
+
+CREATE SEQUENCE IF NOT EXISTS donation_receipt_seq;
+
+ALTER TABLE donations ADD COLUMN IF NOT EXISTS receipt_number VARCHAR(32);
+
+UPDATE donations
+SET receipt_number = 'R-' || to_char(donation_time, 'YYYY') || '-' || lpad(nextval('donation_receipt_seq')::text, 6, '0')
+WHERE receipt_number IS NULL;
+
+ALTER TABLE donations ALTER COLUMN receipt_number
+SET DEFAULT 'R-' || to_char(NOW(), 'YYYY') || '-' || lpad(nextval('donation_receipt_seq')::text, 6, '0');
+
+CREATE UNIQUE INDEX IF NOT EXISTS donations_receipt_number_idx ON donations (receipt_number);
@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS donations_receipt_number ON donations;
+
+DROP FUNCTION IF EXISTS donations_assign_receipt_number();
+
+ALTER TABLE donations ALTER COLUMN receipt_number
+SET DEFAULT 'R-' || to_char(NOW(), 'YYYY') || '-' || lpad(nextval('donation_receipt_seq')::text, 6, '0');
@@ -0,0 +1,17 @@
+-- The receipt number took its year from NOW(), so a donation saved after New Year got a receipt of the next year.
+-- The trigger takes the year from donation_time, which the column default fills in before the trigger runs.
+
+ALTER TABLE donations ALTER COLUMN receipt_number DROP DEFAULT;
+
+CREATE OR REPLACE FUNCTION donations_assign_receipt_number() RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.receipt_number IS NULL THEN
+        NEW.receipt_number := 'R-' || to_char(NEW.donation_time, 'YYYY') || '-' || lpad(nextval('donation_receipt_seq')::text, 6, '0');
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER donations_receipt_number
+BEFORE INSERT ON donations
+FOR EACH ROW EXECUTE FUNCTION donations_assign_receipt_number();
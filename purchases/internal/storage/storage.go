@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"time"
+)
+
+// Receipt is a captured donation as recorded by purchases.
+type Receipt struct {
+	Number       string
+	DonationID   int
+	UserToken    string
+	CollectionID int
+	Collection   string
+	Organization string
+	Amount       int
+	DonationTime time.Time
+	RefundedAt   *time.Time
+}
+
+type PostgresStorage struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresStorage(db *pgxpool.Pool) *PostgresStorage {
+	return &PostgresStorage{db: db}
+}
+
+const receiptColumns = "receipt_number, COALESCE(donation_id, 0), user_token, collection_id, COALESCE(collection_name, ''), COALESCE(organization, ''), amount, donation_time, refunded_at"
+
+// GetReceipt returns the receipt of the user's donation or pgx.ErrNoRows.
+func (s *PostgresStorage) GetReceipt(ctx context.Context, donationID int, userToken string) (*Receipt, error) {
+	const op = "storage.postgresql.GetReceipt"
+
+	row := s.db.QueryRow(ctx, `SELECT `+receiptColumns+` FROM donations WHERE donation_id = $1 AND user_token = $2`, donationID, userToken)
+	receipt, err := scanReceipt(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return receipt, nil
+}
+
+// GetReceipts returns the user's donations made in [from, to) that were not refunded, oldest first.
+func (s *PostgresStorage) GetReceipts(ctx context.Context, userToken string, from, to time.Time) ([]*Receipt, error) {
+	const op = "storage.postgresql.GetReceipts"
+
+	rows, err := s.db.Query(ctx, `
+		SELECT `+receiptColumns+` FROM donations
+		WHERE user_token = $1 AND donation_time >= $2 AND donation_time < $3 AND refunded_at IS NULL
+		ORDER BY donation_time, receipt_number`, userToken, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var receipts []*Receipt
+	for rows.Next() {
+		receipt, err := scanReceipt(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		receipts = append(receipts, receipt)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return receipts, nil
+}
+
+func scanReceipt(row pgx.Row) (*Receipt, error) {
+	receipt := &Receipt{}
+	err := row.Scan(&receipt.Number, &receipt.DonationID, &receipt.UserToken, &receipt.CollectionID, &receipt.Collection,
+		&receipt.Organization, &receipt.Amount, &receipt.DonationTime, &receipt.RefundedAt)
+	if err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
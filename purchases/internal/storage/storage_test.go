@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"context"
+	"github.com/GP-Hacks/kdt2024-commons/pgtest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testStorage returns a migrated storage in a schema of its own, see pgtest.Connect. It skips tb without a test
+// database.
+func testStorage(tb testing.TB) *PostgresStorage {
+	tb.Helper()
+	s := &PostgresStorage{db: pgtest.Connect(tb)}
+	if _, err := s.Migrate(context.Background()); err != nil {
+		tb.Fatalf("failed to migrate: %v", err)
+	}
+	return s
+}
+
+func TestReceiptNumberTakesYearOfDonation(t *testing.T) {
+	s := testStorage(t)
+	ctx := context.Background()
+
+	donations := []struct {
+		id   int
+		time time.Time
+		want string
+	}{
+		{id: 1, time: time.Date(2023, time.December, 31, 23, 59, 0, 0, time.UTC), want: "R-2023-"},
+		{id: 2, time: time.Date(2024, time.January, 1, 0, 1, 0, 0, time.UTC), want: "R-2024-"},
+	}
+	for _, donation := range donations {
+		_, err := s.db.Exec(ctx, `INSERT INTO donations(donation_id, user_token, collection_id, donation_time, amount) VALUES ($1, 'user', 1, $2, 100)`,
+			donation.id, donation.time)
+		if err != nil {
+			t.Fatalf("failed to insert donation: %v", err)
+		}
+	}
+
+	for _, donation := range donations {
+		receipt, err := s.GetReceipt(ctx, donation.id, "user")
+		if err != nil {
+			t.Fatalf("GetReceipt(%d) error = %v", donation.id, err)
+		}
+		if !strings.HasPrefix(receipt.Number, donation.want) {
+			t.Errorf("receipt number of donation %d = %q, want prefix %q", donation.id, receipt.Number, donation.want)
+		}
+	}
+}
@@ -2,22 +2,37 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/migrate"
+	"github.com/GP-Hacks/kdt2024-commons/outbox"
 	"github.com/GP-Hacks/kdt2024-commons/prettylogger"
 	"github.com/GP-Hacks/kdt2024-votes/config"
 	"github.com/GP-Hacks/kdt2024-votes/internal/grpc-server/handler"
+	"github.com/GP-Hacks/kdt2024-votes/internal/seed"
 	"github.com/GP-Hacks/kdt2024-votes/internal/storage"
+	"github.com/streadway/amqp"
 	"google.golang.org/grpc"
 	"log/slog"
 	"net"
+	"os"
 )
 
 func main() {
 	cfg := config.MustLoad()
 	log := prettylogger.SetupLogger(cfg.Env)
+
+	if len(os.Args) > 1 {
+		if err := runCommand(cfg, log, os.Args[1], os.Args[2:]); err != nil {
+			os.Exit(1)
+		}
+		return
+	}
+
 	log.Info("Configuration loaded", slog.String("env", cfg.Env))
 	log.Info("Logger initialized")
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(auth.UnaryServerInterceptor()))
 
 	log.Info("Starting TCP listener", slog.String("address", cfg.Address))
 	l, err := net.Listen("tcp", cfg.Address)
@@ -33,6 +48,36 @@ func main() {
 	log.Info("TCP listener started successfully", slog.String("address", cfg.Address))
 
 	storage, err := setupPostgreSQL(cfg, log)
+	if err != nil {
+		return
+	}
+
+	conn, ch, err := setupRabbitMQ(cfg, log)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Error("Failed to close RabbitMQ connection", slog.String("error", err.Error()))
+		}
+		if err := ch.Close(); err != nil {
+			log.Error("Failed to close RabbitMQ channel", slog.String("error", err.Error()))
+		}
+	}()
+
+	if _, err := ch.QueueDeclare(cfg.QueueNotifications, true, false, false, false, nil); err != nil {
+		log.Error("Failed to declare a queue", slog.String("queue_name", cfg.QueueNotifications), slog.String("error", err.Error()))
+		return
+	}
+
+	relay, err := outbox.NewRelay(storage, "votes-outbox-", ch, log, cfg.OutboxInterval)
+	if err != nil {
+		log.Error("Failed to setup outbox relay", slog.String("error", err.Error()))
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go relay.Run(ctx)
 
 	handler.NewGRPCHandler(cfg, grpcServer, storage, log)
 	if err := grpcServer.Serve(l); err != nil {
@@ -48,17 +93,89 @@ func setupPostgreSQL(cfg *config.Config, log *slog.Logger) (*storage.PostgresSto
 	}
 	log.Info("PostgreSQL connected", slog.String("postgres_address", cfg.PostgresAddress))
 
-	if err := storage.CreateTables(context.Background()); err != nil {
-		log.Error("Error creating tables", slog.String("error", err.Error()))
+	if err := migrate.UpWithLog(context.Background(), storage.Migrate, log); err != nil {
 		return nil, err
 	}
-	log.Info("Tables created or already exist")
 
-	log.Info("Fetching and storing initial data")
-	if err := storage.FetchAndStoreData(context.Background()); err != nil {
-		log.Error("Failed to fetch and store initial data", slog.String("error", err.Error()))
-		return nil, err
-	}
-	log.Info("Initial data fetched and stored successfully")
 	return storage, nil
 }
+
+func setupRabbitMQ(cfg *config.Config, log *slog.Logger) (*amqp.Connection, *amqp.Channel, error) {
+	log.Info("Connecting to RabbitMQ", slog.String("address", cfg.RabbitMQAddress))
+	conn, err := amqp.Dial(cfg.RabbitMQAddress)
+	if err != nil {
+		log.Error("Failed to connect to RabbitMQ", slog.String("error", err.Error()), slog.String("address", cfg.RabbitMQAddress))
+		return nil, nil, err
+	}
+	log.Info("RabbitMQ connection established successfully", slog.String("address", cfg.RabbitMQAddress))
+
+	ch, err := conn.Channel()
+	if err != nil {
+		log.Error("Failed to open RabbitMQ channel", slog.String("error", err.Error()))
+		return nil, nil, err
+	}
+	log.Info("RabbitMQ channel opened successfully")
+	return conn, ch, nil
+}
+
+// runCommand runs a maintenance subcommand instead of starting the service.
+func runCommand(cfg *config.Config, log *slog.Logger, command string, args []string) error {
+	switch command {
+	case "migrate":
+		return runMigrate(cfg, log, args)
+	case "seed":
+		return runSeed(cfg, log, args)
+	default:
+		log.Error("Unknown command, use migrate or seed", slog.String("command", command))
+		return fmt.Errorf("unknown command %q", command)
+	}
+}
+
+// runMigrate runs the migrate subcommand, which applies, reverts or lists the migrations without starting the service.
+func runMigrate(cfg *config.Config, log *slog.Logger, args []string) error {
+	storage, err := storage.NewPostgresStorage(cfg.PostgresAddress + "?sslmode=disable")
+	if err != nil {
+		log.Error("Failed to connect to PostgreSQL", slog.String("error", err.Error()))
+		return err
+	}
+	defer storage.Close()
+
+	migrator, err := storage.Migrator()
+	if err != nil {
+		log.Error("Failed to load migrations", slog.String("error", err.Error()))
+		return err
+	}
+	if err := migrate.Run(context.Background(), migrator, args, os.Stdout); err != nil {
+		log.Error("Migrate command failed", slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
+
+// runSeed runs the seed subcommand, which stores the votes of the given fixture files, or of the bundled fixtures,
+// that are not stored yet. Seeding again adds nothing, so it is safe to run on every deployment.
+func runSeed(cfg *config.Config, log *slog.Logger, args []string) error {
+	storage, err := storage.NewPostgresStorage(cfg.PostgresAddress + "?sslmode=disable")
+	if err != nil {
+		log.Error("Failed to connect to PostgreSQL", slog.String("error", err.Error()))
+		return err
+	}
+	defer storage.Close()
+
+	if err := migrate.UpWithLog(context.Background(), storage.Migrate, log); err != nil {
+		return err
+	}
+
+	votes, err := seed.Load(args)
+	if err != nil {
+		log.Error("Failed to load fixtures", slog.String("error", err.Error()))
+		return err
+	}
+	added, err := storage.SeedVotes(context.Background(), votes)
+	if err != nil {
+		log.Error("Failed to seed votes", slog.String("error", err.Error()))
+		return err
+	}
+	log.Info("Votes seeded", slog.Int("added", added), slog.Int("skipped", len(votes)-added))
+	return nil
+}
@@ -1,17 +1,26 @@
 package config
 
-import "os"
+import (
+	"os"
+	"time"
+)
 
 type Config struct {
-	Env             string
-	Address         string
-	PostgresAddress string
+	Env                string
+	Address            string
+	PostgresAddress    string
+	RabbitMQAddress    string
+	QueueNotifications string
+	OutboxInterval     time.Duration
 }
 
 func MustLoad() *Config {
 	return &Config{
-		Env:             "local",
-		Address:         os.Getenv("SERVICE_ADDRESS"),
-		PostgresAddress: os.Getenv("POSTGRES_ADDRESS"),
+		Env:                "local",
+		Address:            os.Getenv("SERVICE_ADDRESS"),
+		PostgresAddress:    os.Getenv("POSTGRES_ADDRESS"),
+		RabbitMQAddress:    os.Getenv("RABBITMQ_ADDRESS"),
+		QueueNotifications: os.Getenv("QUEUE_NOTIFICATIONS"),
+		OutboxInterval:     time.Second,
 	}
 }
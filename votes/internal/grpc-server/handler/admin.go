@@ -0,0 +1,363 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-votes/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log/slog"
+	"net/url"
+	"time"
+	"unicode/utf8"
+)
+
+// maxOptionLength is the size of the options.option column.
+const maxOptionLength = 255
+
+// maxResponseLength is the longest official response to a petition.
+const maxResponseLength = 5000
+
+// petitionStates tells for every petition state whether moving a petition to it needs an official response.
+var petitionStates = map[string]bool{
+	storage.PetitionCollecting:       false,
+	storage.PetitionThresholdReached: false,
+	storage.PetitionUnderReview:      false,
+	storage.PetitionAnswered:         true,
+	storage.PetitionRejected:         true,
+}
+
+func (h *GRPCHandler) CreateVote(ctx context.Context, request *proto.CreateVoteRequest) (*proto.AdminVoteResponse, error) {
+	h.logger.Debug("Received CreateVote request", slog.Any("request", request))
+
+	actor, err := h.requireAdmin(ctx, "CreateVote")
+	if err != nil {
+		return nil, err
+	}
+
+	vote, err := voteFromInput(request.GetVote())
+	if err != nil {
+		return nil, err
+	}
+	if !vote.EndTime.After(time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "End must be in the future")
+	}
+	switch vote.Category {
+	case "rate", "petition":
+		if len(vote.Options) > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Only choice, multiselect and ranked votes have options")
+		}
+	case "choice", "multiselect", "ranked":
+		if err := validateOptions(vote.Options); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Category must be one of rate, petition, choice, multiselect or ranked")
+	}
+	if vote.Category == "petition" && vote.SignatureThreshold <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Petitions need a positive signature threshold")
+	}
+	if vote.Category != "petition" {
+		vote.SignatureThreshold = 0
+	}
+	if vote.Category == "multiselect" {
+		if vote.MinSelections < 1 || vote.MinSelections > vote.MaxSelections || vote.MaxSelections > len(vote.Options) {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Multiselect votes need 1 <= min_selections <= max_selections <= %d options", len(vote.Options))
+		}
+	} else {
+		vote.MinSelections, vote.MaxSelections = 0, 0
+	}
+
+	created, options, err := h.storage.CreateVote(ctx, actor, vote)
+	if err != nil {
+		return nil, h.handleStorageError(err, "vote")
+	}
+
+	h.logger.Info("Vote created", slog.String("actor", actor), slog.Int("vote_id", created.ID))
+	return &proto.AdminVoteResponse{Response: "Vote created", Vote: toProtoVote(created), Options: toProtoOptions(options)}, nil
+}
+
+func (h *GRPCHandler) UpdateVote(ctx context.Context, request *proto.UpdateVoteRequest) (*proto.AdminVoteResponse, error) {
+	h.logger.Debug("Received UpdateVote request", slog.Any("request", request))
+
+	actor, err := h.requireAdmin(ctx, "UpdateVote")
+	if err != nil {
+		return nil, err
+	}
+
+	if request.GetId() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid vote id")
+	}
+	vote, err := voteFromInput(request.GetVote())
+	if err != nil {
+		return nil, err
+	}
+	vote.ID = int(request.GetId())
+	if vote.SignatureThreshold < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Signature threshold cannot be negative")
+	}
+	if vote.MinSelections < 0 || vote.MaxSelections < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Selection limits cannot be negative")
+	}
+
+	// The category and options are kept, an end in the past closes the vote early. The threshold of a petition
+	// applies to the signatures already given, lowering it can make the petition reach it. The selection limits of a
+	// multiselect vote apply to later ballots only.
+	updated, options, err := h.storage.UpdateVote(ctx, actor, vote, h.petitionMessages)
+	switch {
+	case errors.Is(err, storage.ErrInvalidSelections):
+		return nil, status.Errorf(codes.FailedPrecondition, "Min selections cannot exceed max selections")
+	case errors.Is(err, storage.ErrTooFewOptions):
+		return nil, status.Errorf(codes.FailedPrecondition, "Vote has less options than min selections")
+	case err != nil:
+		return nil, h.handleStorageError(err, "vote")
+	}
+
+	h.logger.Info("Vote updated", slog.String("actor", actor), slog.Int("vote_id", updated.ID))
+	return &proto.AdminVoteResponse{Response: "Vote updated", Vote: toProtoVote(updated), Options: toProtoOptions(options)}, nil
+}
+
+func (h *GRPCHandler) DeleteVote(ctx context.Context, request *proto.DeleteVoteRequest) (*proto.DeleteVoteResponse, error) {
+	h.logger.Debug("Received DeleteVote request", slog.Any("request", request))
+
+	actor, err := h.requireAdmin(ctx, "DeleteVote")
+	if err != nil {
+		return nil, err
+	}
+
+	voteID := int(request.GetId())
+	if voteID <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid vote id")
+	}
+
+	if err := h.storage.DeleteVote(ctx, actor, voteID); err != nil {
+		return nil, h.handleStorageError(err, "vote")
+	}
+
+	h.logger.Info("Vote deleted", slog.String("actor", actor), slog.Int("vote_id", voteID))
+	return &proto.DeleteVoteResponse{Response: "Vote deleted"}, nil
+}
+
+func (h *GRPCHandler) AddVoteOption(ctx context.Context, request *proto.AddVoteOptionRequest) (*proto.VoteOptionResponse, error) {
+	h.logger.Debug("Received AddVoteOption request", slog.Any("request", request))
+
+	actor, err := h.requireAdmin(ctx, "AddVoteOption")
+	if err != nil {
+		return nil, err
+	}
+
+	voteID := int(request.GetVoteId())
+	if voteID <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid vote id")
+	}
+	if request.GetOption() == "" || utf8.RuneCountInString(request.GetOption()) > maxOptionLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Option must be between 1 and %d characters", maxOptionLength)
+	}
+
+	option, err := h.storage.AddOption(ctx, actor, voteID, request.GetOption())
+	switch {
+	case errors.Is(err, storage.ErrNotChoiceVote):
+		return nil, status.Errorf(codes.FailedPrecondition, "Only choice, multiselect and ranked votes have options")
+	case errors.Is(err, storage.ErrOptionExists):
+		return nil, status.Errorf(codes.FailedPrecondition, "Vote already has this option")
+	case err != nil:
+		return nil, h.handleStorageError(err, "vote")
+	}
+
+	h.logger.Info("Vote option added", slog.String("actor", actor), slog.Int("vote_id", voteID), slog.Int("option_id", option.ID))
+	return &proto.VoteOptionResponse{Response: "Option added", Option: &proto.VoteOption{Id: int32(option.ID), Option: option.Option}}, nil
+}
+
+func (h *GRPCHandler) DeleteVoteOption(ctx context.Context, request *proto.DeleteVoteOptionRequest) (*proto.DeleteVoteResponse, error) {
+	h.logger.Debug("Received DeleteVoteOption request", slog.Any("request", request))
+
+	actor, err := h.requireAdmin(ctx, "DeleteVoteOption")
+	if err != nil {
+		return nil, err
+	}
+
+	voteID := int(request.GetVoteId())
+	optionID := int(request.GetOptionId())
+	if voteID <= 0 || optionID <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid vote or option id")
+	}
+
+	err = h.storage.DeleteOption(ctx, actor, voteID, optionID)
+	if errors.Is(err, storage.ErrTooFewOptions) {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Votes need at least %d options and multiselect votes at least min_selections", storage.MinOptions)
+	}
+	if err != nil {
+		return nil, h.handleStorageError(err, "vote option")
+	}
+
+	h.logger.Info("Vote option deleted", slog.String("actor", actor), slog.Int("vote_id", voteID), slog.Int("option_id", optionID))
+	return &proto.DeleteVoteResponse{Response: "Option deleted"}, nil
+}
+
+func (h *GRPCHandler) UpdatePetitionState(ctx context.Context, request *proto.UpdatePetitionStateRequest) (*proto.GetPetitionInfoResponse, error) {
+	h.logger.Debug("Received UpdatePetitionState request", slog.Any("request", request))
+
+	actor, err := h.requireAdmin(ctx, "UpdatePetitionState")
+	if err != nil {
+		return nil, err
+	}
+
+	voteID := int(request.GetVoteId())
+	if voteID <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid vote id")
+	}
+	needsResponse, ok := petitionStates[request.GetState()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown petition state %q", request.GetState())
+	}
+	response := request.GetOfficialResponse()
+	if needsResponse && response == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Official response is required to answer or reject a petition")
+	}
+	if utf8.RuneCountInString(response) > maxResponseLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Official response must be at most %d characters", maxResponseLength)
+	}
+
+	petition, err := h.storage.UpdatePetitionState(ctx, actor, voteID, request.GetState(), response, h.petitionMessages)
+	switch {
+	case errors.Is(err, storage.ErrNotPetition):
+		return nil, status.Errorf(codes.FailedPrecondition, "Vote is not a petition")
+	case errors.Is(err, storage.ErrInvalidTransition):
+		return nil, status.Errorf(codes.FailedPrecondition, "Petition cannot be moved to %s from its current state", request.GetState())
+	case err != nil:
+		return nil, h.handleStorageError(err, "petition vote")
+	}
+
+	h.logger.Info("Petition state updated", slog.String("actor", actor), slog.Int("vote_id", voteID), slog.String("state", petition.State))
+	return &proto.GetPetitionInfoResponse{Response: toProtoPetition(petition)}, nil
+}
+
+// requireAdmin returns the ID of the admin making the request.
+func (h *GRPCHandler) requireAdmin(ctx context.Context, method string) (string, error) {
+	select {
+	case <-ctx.Done():
+		h.logger.Warn(method + " request was cancelled by client")
+		return "", status.Errorf(codes.Canceled, "Request was cancelled")
+	default:
+	}
+
+	actor, err := auth.RequireRole(ctx, auth.RoleAdmin)
+	if err != nil {
+		h.logger.Warn(method+" request is not allowed", slog.String("error", err.Error()))
+		return "", err
+	}
+	return actor, nil
+}
+
+// voteFromInput validates the fields of a vote shared by creation and updates.
+func voteFromInput(input *proto.VoteInput) (*storage.Vote, error) {
+	if input == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Vote is required")
+	}
+
+	required := []struct{ name, value string }{
+		{"name", input.GetName()},
+		{"description", input.GetDescription()},
+		{"organization", input.GetOrganization()},
+	}
+	for _, field := range required {
+		if field.value == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "Field %s is required", field.name)
+		}
+	}
+	if input.GetEnd() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "End is required")
+	}
+	if input.GetPhoto() != "" && !isURL(input.GetPhoto()) {
+		return nil, status.Errorf(codes.InvalidArgument, "Photo must be an http(s) URL")
+	}
+
+	return &storage.Vote{
+		Category:     input.GetCategory(),
+		Name:         input.GetName(),
+		Description:  input.GetDescription(),
+		Organization: input.GetOrganization(),
+		EndTime:      input.GetEnd().AsTime(),
+		Photo:        input.GetPhoto(),
+		Options:      input.GetOptions(),
+
+		SignatureThreshold: int(input.GetSignatureThreshold()),
+		MinSelections:      int(input.GetMinSelections()),
+		MaxSelections:      int(input.GetMaxSelections()),
+	}, nil
+}
+
+func validateOptions(options []string) error {
+	if len(options) < storage.MinOptions {
+		return status.Errorf(codes.InvalidArgument, "Votes with options need at least %d options", storage.MinOptions)
+	}
+	seen := make(map[string]bool, len(options))
+	for _, option := range options {
+		if option == "" || utf8.RuneCountInString(option) > maxOptionLength {
+			return status.Errorf(codes.InvalidArgument, "Options must be between 1 and %d characters", maxOptionLength)
+		}
+		if seen[option] {
+			return status.Errorf(codes.InvalidArgument, "Duplicate option %q", option)
+		}
+		seen[option] = true
+	}
+	return nil
+}
+
+func toProtoVote(vote *storage.Vote) *proto.Vote {
+	return &proto.Vote{
+		Id:           int32(vote.ID),
+		Category:     vote.Category,
+		Name:         vote.Name,
+		Description:  vote.Description,
+		Organization: vote.Organization,
+		End:          timestamppb.New(vote.EndTime),
+		Photo:        vote.Photo,
+		Options:      vote.Options,
+
+		SignatureThreshold: int32(vote.SignatureThreshold),
+		PetitionState:      vote.PetitionState,
+		MinSelections:      int32(vote.MinSelections),
+		MaxSelections:      int32(vote.MaxSelections),
+	}
+}
+
+func toProtoPetition(petition *storage.PetitionInfo) *proto.PetitionInfo {
+	info := &proto.PetitionInfo{
+		Id:                 int32(petition.ID),
+		Category:           petition.Category,
+		Name:               petition.Name,
+		Description:        petition.Description,
+		Organization:       petition.Organization,
+		End:                timestamppb.New(petition.EndTime),
+		Options:            petition.Options,
+		Photo:              petition.Photo,
+		Stats:              petition.Stats,
+		SignatureThreshold: int32(petition.SignatureThreshold),
+		Signatures:         int32(petition.Signatures),
+		State:              petition.State,
+		OfficialResponse:   petition.OfficialResponse,
+	}
+	if !petition.StateChangedAt.IsZero() {
+		info.StateChangedAt = timestamppb.New(petition.StateChangedAt)
+	}
+	return info
+}
+
+func toProtoOptions(options []*storage.VoteOption) []*proto.VoteOption {
+	result := make([]*proto.VoteOption, 0, len(options))
+	for _, option := range options {
+		result = append(result, &proto.VoteOption{Id: int32(option.ID), Option: option.Option})
+	}
+	return result
+}
+
+func isURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
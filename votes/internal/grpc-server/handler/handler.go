@@ -2,9 +2,14 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"github.com/GP-Hacks/kdt2024-commons/api/proto"
+	"github.com/GP-Hacks/kdt2024-commons/auth"
+	"github.com/GP-Hacks/kdt2024-commons/pagination"
 	"github.com/GP-Hacks/kdt2024-votes/config"
 	"github.com/GP-Hacks/kdt2024-votes/internal/storage"
+	"github.com/GP-Hacks/kdt2024-votes/internal/tally"
+	"github.com/jackc/pgx/v5"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -36,34 +41,45 @@ func (h *GRPCHandler) GetVotes(ctx context.Context, request *proto.GetVotesReque
 	default:
 	}
 
-	var votes []*storage.Vote
+	query := &storage.VotesQuery{Category: request.GetCategory()}
+	if query.Category == "all" {
+		query.Category = ""
+	}
 	var err error
-
-	category := request.GetCategory()
-	if category == "all" {
-		votes, err = h.storage.GetVotes(ctx)
-	} else {
-		votes, err = h.storage.GetVotesByCategory(ctx, category)
+	if query.Sort, err = pagination.ParseSort(request.GetSort(), storage.VoteSortID, storage.VoteSortID, storage.VoteSortEndTime); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if query.Limit, err = pagination.PageSize(request.GetPageSize()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if query.AfterID, err = pagination.Decode(request.GetPageToken(), query.Sort, &query.AfterEndTime); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+
+	votes, hasMore, err := h.storage.GetVotes(ctx, query)
 	if err != nil {
 		return nil, h.handleStorageError(err, "votes")
 	}
 
 	var protoVotes []*proto.Vote
 	for _, vote := range votes {
-		protoVotes = append(protoVotes, &proto.Vote{
-			Id:           int32(vote.ID),
-			Category:     vote.Category,
-			Name:         vote.Name,
-			Description:  vote.Description,
-			Organization: vote.Organization,
-			End:          timestamppb.New(vote.EndTime),
-			Photo:        vote.Photo,
-			Options:      vote.Options,
-		})
+		protoVotes = append(protoVotes, toProtoVote(vote))
+	}
+
+	response := &proto.GetVotesResponse{Response: protoVotes}
+	if hasMore {
+		last := votes[len(votes)-1]
+		var key interface{}
+		if query.Sort.Key == storage.VoteSortEndTime {
+			key = last.EndTime
+		}
+		if response.NextPageToken, err = pagination.Encode(query.Sort, key, last.ID); err != nil {
+			h.logger.Error("Failed to encode page token", slog.String("error", err.Error()))
+			return nil, status.Errorf(codes.Internal, "An internal error occurred, please try again later")
+		}
 	}
 
-	return &proto.GetVotesResponse{Response: protoVotes}, nil
+	return response, nil
 }
 
 func (h *GRPCHandler) GetCategories(ctx context.Context, request *proto.GetCategoriesRequest) (*proto.GetCategoriesResponse, error) {
@@ -94,7 +110,12 @@ func (h *GRPCHandler) GetRateInfo(ctx context.Context, request *proto.GetVoteInf
 	default:
 	}
 
-	rates, err := h.storage.GetUserRates(ctx, request.Token)
+	if err := validateVoteID(request.GetVoteId()); err != nil {
+		return nil, err
+	}
+
+	userID, _ := auth.UserIDFromContext(ctx)
+	rates, err := h.storage.GetUserRates(ctx, userID)
 	if err != nil {
 		return nil, h.handleStorageError(err, "rates")
 	}
@@ -113,7 +134,7 @@ func (h *GRPCHandler) GetRateInfo(ctx context.Context, request *proto.GetVoteInf
 
 	rateInfo, err := h.storage.GetRateInfo(ctx, int(request.VoteId))
 	if err != nil {
-		return nil, h.handleStorageError(err, "fetching rate info")
+		return nil, h.handleStorageError(err, "rate vote")
 	}
 
 	return &proto.GetRateInfoResponse{
@@ -135,7 +156,12 @@ func (h *GRPCHandler) GetRateInfo(ctx context.Context, request *proto.GetVoteInf
 func (h *GRPCHandler) GetPetitionInfo(ctx context.Context, request *proto.GetVoteInfoRequest) (*proto.GetPetitionInfoResponse, error) {
 	h.logger.Debug("Received GetPetitionInfo request", slog.Any("request", request))
 
-	petitions, err := h.storage.GetUserPetitions(ctx, request.Token)
+	if err := validateVoteID(request.GetVoteId()); err != nil {
+		return nil, err
+	}
+
+	userID, _ := auth.UserIDFromContext(ctx)
+	petitions, err := h.storage.GetUserPetitions(ctx, userID)
 	if err != nil {
 		return nil, h.handleStorageError(err, "petitions")
 	}
@@ -155,29 +181,67 @@ func (h *GRPCHandler) GetPetitionInfo(ctx context.Context, request *proto.GetVot
 
 	petitionInfo, err := h.storage.GetPetitionInfo(ctx, int(request.VoteId))
 	if err != nil {
-		return nil, h.handleStorageError(err, "fetching petition info")
-	}
-
-	return &proto.GetPetitionInfoResponse{
-		Response: &proto.PetitionInfo{
-			Id:           int32(petitionInfo.ID),
-			Category:     petitionInfo.Category,
-			Name:         petitionInfo.Name,
-			Description:  petitionInfo.Description,
-			Organization: petitionInfo.Organization,
-			End:          timestamppb.New(petitionInfo.EndTime),
-			Options:      petitionInfo.Options,
-			Photo:        petitionInfo.Photo,
-			Stats:        petitionInfo.Stats,
-			Support:      xxx,
-		},
-	}, nil
+		return nil, h.handleStorageError(err, "petition vote")
+	}
+
+	response := toProtoPetition(petitionInfo)
+	response.Support = xxx
+	return &proto.GetPetitionInfoResponse{Response: response}, nil
+}
+
+func (h *GRPCHandler) GetPetitions(ctx context.Context, request *proto.GetPetitionsRequest) (*proto.GetPetitionsResponse, error) {
+	h.logger.Debug("Received GetPetitions request", slog.Any("request", request))
+
+	select {
+	case <-ctx.Done():
+		h.logger.Warn("GetPetitions request was cancelled by client")
+		return nil, status.Errorf(codes.Canceled, "Request was cancelled")
+	default:
+	}
+
+	query := &storage.PetitionsQuery{State: request.GetState()}
+	if _, ok := petitionStates[query.State]; !ok && query.State != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown petition state %q", query.State)
+	}
+	var err error
+	if query.Limit, err = pagination.PageSize(request.GetPageSize()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// Petitions are listed by ID, the cursor has no sort key value.
+	sort := pagination.Sort{Key: storage.VoteSortID}
+	var key interface{}
+	if query.AfterID, err = pagination.Decode(request.GetPageToken(), sort, &key); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	petitions, hasMore, err := h.storage.GetPetitions(ctx, query)
+	if err != nil {
+		return nil, h.handleStorageError(err, "petitions")
+	}
+
+	response := &proto.GetPetitionsResponse{Response: make([]*proto.PetitionInfo, 0, len(petitions))}
+	for _, petition := range petitions {
+		response.Response = append(response.Response, toProtoPetition(petition))
+	}
+	if hasMore {
+		if response.NextPageToken, err = pagination.Encode(sort, nil, petitions[len(petitions)-1].ID); err != nil {
+			h.logger.Error("Failed to encode page token", slog.String("error", err.Error()))
+			return nil, status.Errorf(codes.Internal, "An internal error occurred, please try again later")
+		}
+	}
+
+	return response, nil
 }
 
 func (h *GRPCHandler) GetChoiceInfo(ctx context.Context, request *proto.GetVoteInfoRequest) (*proto.GetChoiceInfoResponse, error) {
 	h.logger.Debug("Received GetChoiceInfo request", slog.Any("request", request))
 
-	choices, err := h.storage.GetUserChoices(ctx, request.Token)
+	if err := validateVoteID(request.GetVoteId()); err != nil {
+		return nil, err
+	}
+
+	userID, _ := auth.UserIDFromContext(ctx)
+	choices, err := h.storage.GetUserChoices(ctx, userID)
 
 	if err != nil {
 		return nil, h.handleStorageError(err, "choices")
@@ -198,7 +262,7 @@ func (h *GRPCHandler) GetChoiceInfo(ctx context.Context, request *proto.GetVoteI
 
 	choiceInfo, err := h.storage.GetChoiceInfo(ctx, int(request.VoteId))
 	if err != nil {
-		return nil, h.handleStorageError(err, "fetching choice info")
+		return nil, h.handleStorageError(err, "choice vote")
 	}
 
 	return &proto.GetChoiceInfoResponse{
@@ -220,36 +284,207 @@ func (h *GRPCHandler) GetChoiceInfo(ctx context.Context, request *proto.GetVoteI
 func (h *GRPCHandler) VoteRate(ctx context.Context, request *proto.VoteRateRequest) (*proto.VoteResponse, error) {
 	h.logger.Debug("Received VoteRate request", slog.Any("request", request))
 
-	err := h.storage.VoteRate(ctx, request.Token, int(request.VoteId), int(request.Rating))
+	userID, err := auth.RequireUserID(ctx)
 	if err != nil {
-		return nil, h.handleStorageError(err, "voting rate")
+		h.logger.Warn("VoteRate request is not authenticated")
+		return nil, err
+	}
+	if err := validateVoteID(request.GetVoteId()); err != nil {
+		return nil, err
+	}
+	if err := validateRating(request.GetRating()); err != nil {
+		return nil, err
 	}
 
-	h.logger.Info("Successfully recorded rate vote", slog.String("token", request.Token), slog.Int("vote_id", int(request.VoteId)))
+	err = h.storage.VoteRate(ctx, userID, int(request.VoteId), int(request.Rating))
+	if err != nil {
+		return nil, h.handleStorageError(err, "rate vote")
+	}
+
+	h.logger.Info("Successfully recorded rate vote", slog.String("user_id", userID), slog.Int("vote_id", int(request.VoteId)))
 	return &proto.VoteResponse{Response: "Vote recorded successfully"}, nil
 }
 
 func (h *GRPCHandler) VotePetition(ctx context.Context, request *proto.VotePetitionRequest) (*proto.VoteResponse, error) {
 	h.logger.Debug("Received VotePetition request", slog.Any("request", request))
 
-	err := h.storage.VotePetition(ctx, request.Token, int(request.VoteId), request.Support)
+	userID, err := auth.RequireUserID(ctx)
 	if err != nil {
-		return nil, h.handleStorageError(err, "voting petition")
+		h.logger.Warn("VotePetition request is not authenticated")
+		return nil, err
+	}
+	if err := validateVoteID(request.GetVoteId()); err != nil {
+		return nil, err
+	}
+	if err := validateSupport(request.GetSupport()); err != nil {
+		return nil, err
 	}
 
-	h.logger.Info("Successfully recorded petition vote", slog.String("token", request.Token), slog.Int("vote_id", int(request.VoteId)))
+	reached, err := h.storage.VotePetition(ctx, userID, int(request.VoteId), request.Support, h.petitionMessages)
+	if errors.Is(err, storage.ErrPetitionClosed) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Petition no longer collects signatures")
+	}
+	if err != nil {
+		return nil, h.handleStorageError(err, "petition vote")
+	}
+
+	h.logger.Info("Successfully recorded petition vote", slog.String("user_id", userID), slog.Int("vote_id", int(request.VoteId)))
+	if reached {
+		h.logger.Info("Petition reached its signature threshold", slog.Int("vote_id", int(request.VoteId)))
+	}
 	return &proto.VoteResponse{Response: "Vote recorded successfully"}, nil
 }
 
 func (h *GRPCHandler) VoteChoice(ctx context.Context, request *proto.VoteChoiceRequest) (*proto.VoteResponse, error) {
 	h.logger.Debug("Received VoteChoice request", slog.Any("request", request))
 
-	err := h.storage.VoteChoice(ctx, request.Token, int(request.VoteId), request.Choice)
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		h.logger.Warn("VoteChoice request is not authenticated")
+		return nil, err
+	}
+	if err := validateVoteID(request.GetVoteId()); err != nil {
+		return nil, err
+	}
+	if err := validateChoice(request.GetChoice()); err != nil {
+		return nil, err
+	}
+
+	err = h.storage.VoteChoice(ctx, userID, int(request.VoteId), request.Choice)
 	if err != nil {
-		return nil, h.handleStorageError(err, "voting choice")
+		return nil, h.handleStorageError(err, "choice vote")
 	}
 
-	h.logger.Info("Successfully recorded choice vote", slog.String("token", request.Token), slog.Int("vote_id", int(request.VoteId)))
+	h.logger.Info("Successfully recorded choice vote", slog.String("user_id", userID), slog.Int("vote_id", int(request.VoteId)))
+	return &proto.VoteResponse{Response: "Vote recorded successfully"}, nil
+}
+
+func (h *GRPCHandler) GetMultiSelectInfo(ctx context.Context, request *proto.GetVoteInfoRequest) (*proto.GetMultiSelectInfoResponse, error) {
+	h.logger.Debug("Received GetMultiSelectInfo request", slog.Any("request", request))
+
+	if err := validateVoteID(request.GetVoteId()); err != nil {
+		return nil, err
+	}
+
+	userID, _ := auth.UserIDFromContext(ctx)
+	info, err := h.storage.GetMultiSelectInfo(ctx, int(request.VoteId), userID)
+	if err != nil {
+		return nil, h.handleStorageError(err, "multiselect vote")
+	}
+
+	return &proto.GetMultiSelectInfoResponse{
+		Response: &proto.MultiSelectInfo{
+			Id:            int32(info.ID),
+			Category:      info.Category,
+			Name:          info.Name,
+			Description:   info.Description,
+			Organization:  info.Organization,
+			End:           timestamppb.New(info.EndTime),
+			Options:       info.Options,
+			Photo:         info.Photo,
+			MinSelections: int32(info.MinSelections),
+			MaxSelections: int32(info.MaxSelections),
+			Stats:         info.Stats,
+			Voters:        int32(info.Voters),
+			Selected:      info.Selected,
+		},
+	}, nil
+}
+
+func (h *GRPCHandler) GetRankedInfo(ctx context.Context, request *proto.GetVoteInfoRequest) (*proto.GetRankedInfoResponse, error) {
+	h.logger.Debug("Received GetRankedInfo request", slog.Any("request", request))
+
+	if err := validateVoteID(request.GetVoteId()); err != nil {
+		return nil, err
+	}
+
+	userID, _ := auth.UserIDFromContext(ctx)
+	info, err := h.storage.GetRankedInfo(ctx, int(request.VoteId), userID)
+	if err != nil {
+		return nil, h.handleStorageError(err, "ranked vote")
+	}
+
+	result := tally.InstantRunoff(info.Options, info.Ballots)
+	rounds := make([]*proto.RankedRound, 0, len(result.Rounds))
+	for _, round := range result.Rounds {
+		counts := make(map[string]int32, len(round.Counts))
+		for option, count := range round.Counts {
+			counts[option] = int32(count)
+		}
+		rounds = append(rounds, &proto.RankedRound{
+			Counts:     counts,
+			Eliminated: round.Eliminated,
+			Exhausted:  int32(round.Exhausted),
+		})
+	}
+
+	return &proto.GetRankedInfoResponse{
+		Response: &proto.RankedInfo{
+			Id:           int32(info.ID),
+			Category:     info.Category,
+			Name:         info.Name,
+			Description:  info.Description,
+			Organization: info.Organization,
+			End:          timestamppb.New(info.EndTime),
+			Options:      info.Options,
+			Photo:        info.Photo,
+			Ballots:      int32(len(info.Ballots)),
+			Rounds:       rounds,
+			Winner:       result.Winner,
+			Ranking:      info.Ranking,
+		},
+	}, nil
+}
+
+func (h *GRPCHandler) VoteMultiSelect(ctx context.Context, request *proto.VoteMultiSelectRequest) (*proto.VoteResponse, error) {
+	h.logger.Debug("Received VoteMultiSelect request", slog.Any("request", request))
+
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		h.logger.Warn("VoteMultiSelect request is not authenticated")
+		return nil, err
+	}
+	if err := validateVoteID(request.GetVoteId()); err != nil {
+		return nil, err
+	}
+	if err := validateBallotOptions("Selection", request.GetOptions()); err != nil {
+		return nil, err
+	}
+
+	err = h.storage.VoteMultiSelect(ctx, userID, int(request.VoteId), request.Options)
+	var countErr *storage.SelectionCountError
+	if errors.As(err, &countErr) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Select from %d to %d options", countErr.Min, countErr.Max)
+	}
+	if err != nil {
+		return nil, h.handleStorageError(err, "multiselect vote")
+	}
+
+	h.logger.Info("Successfully recorded multiselect vote", slog.String("user_id", userID), slog.Int("vote_id", int(request.VoteId)))
+	return &proto.VoteResponse{Response: "Vote recorded successfully"}, nil
+}
+
+func (h *GRPCHandler) VoteRanked(ctx context.Context, request *proto.VoteRankedRequest) (*proto.VoteResponse, error) {
+	h.logger.Debug("Received VoteRanked request", slog.Any("request", request))
+
+	userID, err := auth.RequireUserID(ctx)
+	if err != nil {
+		h.logger.Warn("VoteRanked request is not authenticated")
+		return nil, err
+	}
+	if err := validateVoteID(request.GetVoteId()); err != nil {
+		return nil, err
+	}
+	if err := validateBallotOptions("Ranking", request.GetRanking()); err != nil {
+		return nil, err
+	}
+
+	err = h.storage.VoteRanked(ctx, userID, int(request.VoteId), request.Ranking)
+	if err != nil {
+		return nil, h.handleStorageError(err, "ranked vote")
+	}
+
+	h.logger.Info("Successfully recorded ranked vote", slog.String("user_id", userID), slog.Int("vote_id", int(request.VoteId)))
 	return &proto.VoteResponse{Response: "Vote recorded successfully"}, nil
 }
 
@@ -261,6 +496,21 @@ func (h *GRPCHandler) HealthCheck(ctx context.Context, request *proto.HealthChec
 }
 
 func (h *GRPCHandler) handleStorageError(err error, context string) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		h.logger.Warn("No records found in database", slog.String("context", context), slog.String("error", err.Error()))
+		return status.Errorf(codes.NotFound, "No %s found", context)
+	}
+	switch {
+	case errors.Is(err, storage.ErrWrongCategory):
+		h.logger.Warn("Vote has another category", slog.String("context", context), slog.String("error", err.Error()))
+		return status.Errorf(codes.FailedPrecondition, "Vote is not a %s", context)
+	case errors.Is(err, storage.ErrVoteEnded):
+		h.logger.Warn("Vote has ended", slog.String("context", context), slog.String("error", err.Error()))
+		return status.Errorf(codes.FailedPrecondition, "Vote has ended")
+	case errors.Is(err, storage.ErrUnknownOption):
+		h.logger.Warn("Unknown option chosen", slog.String("context", context), slog.String("error", err.Error()))
+		return status.Errorf(codes.FailedPrecondition, "Ballot has an option the vote does not have")
+	}
 	h.logger.Error("Storage operation failed", slog.String("context", context), slog.String("error", err.Error()))
 	return status.Errorf(codes.Internal, "Failed to process %s: %v", context, err)
 }
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/GP-Hacks/kdt2024-votes/internal/storage"
+	"time"
+)
+
+type NotificationMessage struct {
+	UserID       string                             `json:"user_id"`
+	Category     string                             `json:"category,omitempty"`
+	Header       string                             `json:"header"`
+	Content      string                             `json:"content"`
+	Time         time.Time                          `json:"time"`
+	Translations map[string]NotificationTranslation `json:"translations,omitempty"`
+}
+
+type NotificationTranslation struct {
+	Header  string `json:"header"`
+	Content string `json:"content"`
+}
+
+// notificationCategory lets users opt out of vote notifications.
+const notificationCategory = "votes"
+
+// petitionStateTexts are the headers and contents of the notifications about each petition state, in Russian and
+// English. The contents are formatted with the petition name.
+var petitionStateTexts = map[string]struct{ header, content, headerEn, contentEn string }{
+	storage.PetitionThresholdReached: {
+		"Петиция набрала подписи!",
+		"Петиция «%s» набрала нужное число подписей и будет передана на рассмотрение",
+		"The petition has enough signatures!",
+		"«%s» has collected the signatures it needs and will be reviewed",
+	},
+	storage.PetitionUnderReview: {
+		"Петиция на рассмотрении",
+		"Организация рассматривает петицию «%s»",
+		"The petition is under review",
+		"The organization is reviewing «%s»",
+	},
+	storage.PetitionAnswered: {
+		"Получен ответ на петицию",
+		"Организация ответила на петицию «%s»",
+		"The petition is answered",
+		"The organization has answered «%s»",
+	},
+	storage.PetitionRejected: {
+		"Петиция отклонена",
+		"Петиция «%s» отклонена",
+		"The petition is rejected",
+		"«%s» has been rejected",
+	},
+}
+
+// petitionMessages tells every signer of the petition about its new state.
+func (h *GRPCHandler) petitionMessages(petition *storage.PetitionInfo, signers []string) ([]*storage.OutboxMessage, error) {
+	texts, ok := petitionStateTexts[petition.State]
+	if !ok {
+		return nil, nil
+	}
+
+	now := time.Now()
+	messages := make([]*storage.OutboxMessage, 0, len(signers))
+	for _, signer := range signers {
+		payload, err := json.Marshal(&NotificationMessage{
+			UserID:   signer,
+			Category: notificationCategory,
+			Header:   texts.header,
+			Content:  fmt.Sprintf(texts.content, petition.Name),
+			Time:     now,
+			Translations: map[string]NotificationTranslation{
+				"en": {Header: texts.headerEn, Content: fmt.Sprintf(texts.contentEn, petition.Name)},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, &storage.OutboxMessage{Queue: h.cfg.QueueNotifications, Payload: payload})
+	}
+	return messages, nil
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"github.com/GP-Hacks/kdt2024-votes/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"unicode/utf8"
+)
+
+// Ratings of a rate vote are whole numbers in this range.
+const (
+	minRating = 1
+	maxRating = 5
+)
+
+// The checks below only need the request, whether the vote accepts the ballot is checked by the storage.
+
+func validateVoteID(voteID int32) error {
+	if voteID <= 0 {
+		return status.Errorf(codes.InvalidArgument, "Invalid vote id")
+	}
+	return nil
+}
+
+func validateRating(rating float32) error {
+	if rating < minRating || rating > maxRating || rating != float32(math.Trunc(float64(rating))) {
+		return status.Errorf(codes.InvalidArgument, "Rating must be a whole number from %d to %d", minRating, maxRating)
+	}
+	return nil
+}
+
+func validateSupport(support string) error {
+	if support != storage.SupportFor && support != storage.SupportAgainst {
+		return status.Errorf(codes.InvalidArgument, "Support must be %q or %q", storage.SupportFor, storage.SupportAgainst)
+	}
+	return nil
+}
+
+func validateChoice(choice string) error {
+	if choice == "" || utf8.RuneCountInString(choice) > maxOptionLength {
+		return status.Errorf(codes.InvalidArgument, "Choice must be between 1 and %d characters", maxOptionLength)
+	}
+	return nil
+}
+
+// validateBallotOptions checks the options of a multiselect or ranked ballot, name is the field named in the errors.
+func validateBallotOptions(name string, options []string) error {
+	if len(options) == 0 {
+		return status.Errorf(codes.InvalidArgument, "%s must have at least one option", name)
+	}
+	seen := make(map[string]bool, len(options))
+	for _, option := range options {
+		if option == "" || utf8.RuneCountInString(option) > maxOptionLength {
+			return status.Errorf(codes.InvalidArgument, "Options must be between 1 and %d characters", maxOptionLength)
+		}
+		if seen[option] {
+			return status.Errorf(codes.InvalidArgument, "Duplicate option %q", option)
+		}
+		seen[option] = true
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+// Package seed loads the fixture votes used to populate local and test environments.
+package seed
+
+import (
+	"embed"
+	"fmt"
+	"github.com/GP-Hacks/kdt2024-commons/fixtures"
+	"github.com/GP-Hacks/kdt2024-votes/internal/storage"
+	"time"
+)
+
+//go:embed fixtures/votes.yaml
+var bundled embed.FS
+
+type file struct {
+	Votes []vote `yaml:"votes" json:"votes"`
+}
+
+type vote struct {
+	Category     string `yaml:"category" json:"category"`
+	Name         string `yaml:"name" json:"name"`
+	Description  string `yaml:"description" json:"description"`
+	Organization string `yaml:"organization" json:"organization"`
+	// EndsIn is the duration from seeding until the vote ends, like "168h", so fixtures stay open when reused.
+	EndsIn  string   `yaml:"ends_in" json:"ends_in"`
+	Photo   string   `yaml:"photo" json:"photo"`
+	Options []string `yaml:"options" json:"options"`
+	// SignatureThreshold is required for petitions.
+	SignatureThreshold int `yaml:"signature_threshold" json:"signature_threshold"`
+	// MinSelections and MaxSelections are required for multiselect votes.
+	MinSelections int `yaml:"min_selections" json:"min_selections"`
+	MaxSelections int `yaml:"max_selections" json:"max_selections"`
+}
+
+// Load reads the votes in the YAML or JSON fixture files at paths, or the bundled fixtures when no paths are given.
+func Load(paths []string) ([]*storage.Vote, error) {
+	const op = "seed.Load"
+
+	var files []file
+	if len(paths) == 0 {
+		var f file
+		if err := fixtures.ReadFS(bundled, "fixtures/votes.yaml", &f); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		files = append(files, f)
+	}
+	for _, path := range paths {
+		var f file
+		if err := fixtures.Read(path, &f); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		files = append(files, f)
+	}
+
+	now := time.Now()
+	var votes []*storage.Vote
+	for _, f := range files {
+		for i, v := range f.Votes {
+			if v.Name == "" {
+				return nil, fmt.Errorf("%s: vote %d needs a name", op, i+1)
+			}
+			switch {
+			case storage.HasOptions(v.Category) && len(v.Options) < storage.MinOptions:
+				return nil, fmt.Errorf("%s: %s vote %q needs at least %d options", op, v.Category, v.Name, storage.MinOptions)
+			case (v.Category == "rate" || v.Category == "petition") && len(v.Options) > 0:
+				return nil, fmt.Errorf("%s: only choice, multiselect and ranked votes have options, %q has some", op, v.Name)
+			case !storage.HasOptions(v.Category) && v.Category != "rate" && v.Category != "petition":
+				return nil, fmt.Errorf("%s: vote %q has unknown category %q", op, v.Name, v.Category)
+			case v.Category == "petition" && v.SignatureThreshold <= 0:
+				return nil, fmt.Errorf("%s: petition %q needs a positive signature_threshold", op, v.Name)
+			case v.Category != "petition" && v.SignatureThreshold != 0:
+				return nil, fmt.Errorf("%s: only petitions have a signature_threshold, %q has one", op, v.Name)
+			case v.Category == "multiselect" &&
+				(v.MinSelections < 1 || v.MinSelections > v.MaxSelections || v.MaxSelections > len(v.Options)):
+				return nil, fmt.Errorf("%s: multiselect vote %q needs 1 <= min_selections <= max_selections <= %d",
+					op, v.Name, len(v.Options))
+			case v.Category != "multiselect" && (v.MinSelections != 0 || v.MaxSelections != 0):
+				return nil, fmt.Errorf("%s: only multiselect votes have selection limits, %q has some", op, v.Name)
+			}
+			endsIn, err := time.ParseDuration(v.EndsIn)
+			if err != nil {
+				return nil, fmt.Errorf("%s: vote %q has invalid ends_in: %w", op, v.Name, err)
+			}
+
+			votes = append(votes, &storage.Vote{
+				Category:     v.Category,
+				Name:         v.Name,
+				Description:  v.Description,
+				Organization: v.Organization,
+				EndTime:      now.Add(endsIn),
+				Photo:        v.Photo,
+				Options:      v.Options,
+
+				SignatureThreshold: v.SignatureThreshold,
+				MinSelections:      v.MinSelections,
+				MaxSelections:      v.MaxSelections,
+			})
+		}
+	}
+	return votes, nil
+}
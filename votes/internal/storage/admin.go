@@ -0,0 +1,278 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/GP-Hacks/kdt2024-commons/audit"
+	"github.com/jackc/pgx/v5"
+)
+
+// auditTable is the audit log of the admin changes, see audit.Change.
+const auditTable = "votes_audit_log"
+
+var (
+	// ErrNotChoiceVote is returned when managing the options of a vote without options, see HasOptions.
+	ErrNotChoiceVote = errors.New("vote has no options")
+	// ErrOptionExists is returned when adding an option the vote already has.
+	ErrOptionExists = errors.New("option already exists")
+	// ErrTooFewOptions is returned when a vote would be left with less than MinOptions options, or a multiselect
+	// vote with less options than its ballots select.
+	ErrTooFewOptions = errors.New("too few options")
+	// ErrInvalidSelections is returned when the least number of selections of a multiselect vote exceeds the most.
+	ErrInvalidSelections = errors.New("min selections exceed max selections")
+)
+
+// MinOptions is the least number of options a vote with options has.
+const MinOptions = 2
+
+// HasOptions reports whether the votes of the category have options: choice, multiselect and ranked votes.
+func HasOptions(category string) bool {
+	return category == "choice" || category == "multiselect" || category == "ranked"
+}
+
+type VoteOption struct {
+	ID     int
+	VoteID int
+	Option string
+}
+
+const voteColumns = "id, category, name, description, organization, photo, end_time, signature_threshold, " +
+	"COALESCE(petition_state, ''), min_selections, max_selections"
+
+// CreateVote stores a new vote with its options from every field of vote but the ID.
+func (s *PostgresStorage) CreateVote(ctx context.Context, actor string, vote *Vote) (*Vote, []*VoteOption, error) {
+	const op = "storage.postgresql.CreateVote"
+
+	var created *Vote
+	var options []*VoteOption
+	err := s.audited(ctx, actor, audit.Create, "votes", 0, func(tx pgx.Tx) (int, error) {
+		var err error
+		created, err = scanVote(tx.QueryRow(ctx, `
+			INSERT INTO votes (category, name, description, organization, photo, end_time, signature_threshold, petition_state,
+				state_changed_at, min_selections, max_selections)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), $9, $10)
+			RETURNING `+voteColumns,
+			vote.Category, vote.Name, vote.Description, vote.Organization, vote.Photo, vote.EndTime,
+			vote.SignatureThreshold, initialPetitionState(vote.Category), vote.MinSelections, vote.MaxSelections))
+		if err != nil {
+			return 0, err
+		}
+
+		for _, text := range vote.Options {
+			option := &VoteOption{VoteID: created.ID, Option: text}
+			err := tx.QueryRow(ctx, `INSERT INTO options (vote_id, option) VALUES ($1, $2) RETURNING id`, created.ID, text).Scan(&option.ID)
+			if err != nil {
+				return 0, fmt.Errorf("failed to insert option: %w", err)
+			}
+			if err := audit.Write(ctx, tx, auditTable, actor, audit.Create, "options", option.ID, nil); err != nil {
+				return 0, err
+			}
+			options = append(options, option)
+			created.Options = append(created.Options, text)
+		}
+		return created.ID, nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return created, options, nil
+}
+
+// UpdateVote replaces the name, description, organization, photo and end time of the vote with the ID of vote, the
+// signature threshold of a petition and the selection limits of a multiselect vote, a zero limit is kept. A petition
+// still collecting signatures that has enough of them for the new threshold reaches it, and its signers get the
+// messages of buildMessages. It returns pgx.ErrNoRows when the vote does not exist or was deleted, and for a
+// multiselect vote ErrInvalidSelections when its limits contradict each other and ErrTooFewOptions when it has less
+// options than its new least number of selections.
+func (s *PostgresStorage) UpdateVote(ctx context.Context, actor string, vote *Vote, buildMessages PetitionMessages) (*Vote, []*VoteOption, error) {
+	const op = "storage.postgresql.UpdateVote"
+
+	var updated *Vote
+	var options []*VoteOption
+	err := s.audited(ctx, actor, audit.Update, "votes", vote.ID, func(tx pgx.Tx) (int, error) {
+		var err error
+		updated, err = scanVote(tx.QueryRow(ctx, `
+			UPDATE votes
+			SET name = $2, description = $3, organization = $4, photo = $5, end_time = $6,
+				signature_threshold = CASE WHEN category = 'petition' THEN $7 ELSE 0 END,
+				min_selections = CASE WHEN category = 'multiselect' AND $8 > 0 THEN $8 ELSE min_selections END,
+				max_selections = CASE WHEN category = 'multiselect' AND $9 > 0 THEN $9 ELSE max_selections END
+			WHERE id = $1
+			RETURNING `+voteColumns,
+			vote.ID, vote.Name, vote.Description, vote.Organization, vote.Photo, vote.EndTime, vote.SignatureThreshold,
+			vote.MinSelections, vote.MaxSelections))
+		if err != nil {
+			return 0, err
+		}
+
+		if updated.Category == "petition" {
+			reached, err := reachThreshold(ctx, tx, updated.ID, buildMessages)
+			if err != nil {
+				return 0, err
+			}
+			if reached {
+				updated.PetitionState = PetitionThresholdReached
+			}
+		}
+
+		options, err = voteOptions(ctx, tx, vote.ID)
+		if err != nil {
+			return 0, err
+		}
+		if updated.MinSelections > updated.MaxSelections {
+			return 0, ErrInvalidSelections
+		}
+		if len(options) < updated.MinSelections {
+			return 0, ErrTooFewOptions
+		}
+		updated.Options = []string{}
+		for _, option := range options {
+			updated.Options = append(updated.Options, option.Option)
+		}
+		return updated.ID, nil
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, ErrTooFewOptions) || errors.Is(err, ErrInvalidSelections) {
+			return nil, nil, err
+		}
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return updated, options, nil
+}
+
+// DeleteVote hides the vote from users and stops accepting ballots for it. The ballots cast are kept.
+// It returns pgx.ErrNoRows when the vote does not exist or was already deleted.
+func (s *PostgresStorage) DeleteVote(ctx context.Context, actor string, voteID int) error {
+	const op = "storage.postgresql.DeleteVote"
+
+	err := s.audited(ctx, actor, audit.Delete, "votes", voteID, func(tx pgx.Tx) (int, error) {
+		_, err := tx.Exec(ctx, `UPDATE votes SET deleted_at = NOW() WHERE id = $1`, voteID)
+		return voteID, err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// AddOption adds an option to the vote. It returns pgx.ErrNoRows when the vote does not exist or was deleted,
+// ErrNotChoiceVote when it has no options and ErrOptionExists when it already has the option.
+func (s *PostgresStorage) AddOption(ctx context.Context, actor string, voteID int, text string) (*VoteOption, error) {
+	const op = "storage.postgresql.AddOption"
+
+	option := &VoteOption{VoteID: voteID, Option: text}
+	err := s.audited(ctx, actor, audit.Create, "options", 0, func(tx pgx.Tx) (int, error) {
+		options, err := lockChoiceVote(ctx, tx, voteID)
+		if err != nil {
+			return 0, err
+		}
+		for _, existing := range options {
+			if existing.Option == text {
+				return 0, ErrOptionExists
+			}
+		}
+
+		err = tx.QueryRow(ctx, `INSERT INTO options (vote_id, option) VALUES ($1, $2) RETURNING id`, voteID, text).Scan(&option.ID)
+		return option.ID, err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, ErrNotChoiceVote) || errors.Is(err, ErrOptionExists) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return option, nil
+}
+
+// DeleteOption removes the option from the vote. Ballots cast for it are kept but no longer counted. It returns
+// pgx.ErrNoRows when the vote has no such option and ErrTooFewOptions when the vote would be left with less than
+// MinOptions, or a multiselect vote with less options than its ballots select.
+func (s *PostgresStorage) DeleteOption(ctx context.Context, actor string, voteID int, optionID int) error {
+	const op = "storage.postgresql.DeleteOption"
+
+	err := s.audited(ctx, actor, audit.Delete, "options", optionID, func(tx pgx.Tx) (int, error) {
+		options, err := lockChoiceVote(ctx, tx, voteID)
+		if errors.Is(err, ErrNotChoiceVote) {
+			return 0, pgx.ErrNoRows
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		found := false
+		for _, option := range options {
+			found = found || option.ID == optionID
+		}
+		if !found {
+			return 0, pgx.ErrNoRows
+		}
+		var minSelections int
+		if err := tx.QueryRow(ctx, `SELECT min_selections FROM votes WHERE id = $1`, voteID).Scan(&minSelections); err != nil {
+			return 0, err
+		}
+		if len(options) <= MinOptions || len(options) <= minSelections {
+			return 0, ErrTooFewOptions
+		}
+
+		_, err = tx.Exec(ctx, `UPDATE options SET deleted_at = NOW() WHERE id = $1`, optionID)
+		return optionID, err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, ErrTooFewOptions) {
+			return err
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// lockChoiceVote locks the vote so its options are changed one at a time and returns its options. Ballots lock the
+// vote too, so they never refer to an option deleted meanwhile.
+func lockChoiceVote(ctx context.Context, tx pgx.Tx, voteID int) ([]*VoteOption, error) {
+	var category string
+	err := tx.QueryRow(ctx, `SELECT category FROM votes WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, voteID).Scan(&category)
+	if err != nil {
+		return nil, err
+	}
+	if !HasOptions(category) {
+		return nil, ErrNotChoiceVote
+	}
+	return voteOptions(ctx, tx, voteID)
+}
+
+func voteOptions(ctx context.Context, tx pgx.Tx, voteID int) ([]*VoteOption, error) {
+	rows, err := tx.Query(ctx, `SELECT id, vote_id, option FROM options WHERE vote_id = $1 AND deleted_at IS NULL ORDER BY id`, voteID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query options: %w", err)
+	}
+	defer rows.Close()
+
+	var options []*VoteOption
+	for rows.Next() {
+		option := &VoteOption{}
+		if err := rows.Scan(&option.ID, &option.VoteID, &option.Option); err != nil {
+			return nil, fmt.Errorf("failed to scan option: %w", err)
+		}
+		options = append(options, option)
+	}
+	return options, rows.Err()
+}
+
+func scanVote(row pgx.Row) (*Vote, error) {
+	vote := &Vote{Options: []string{}}
+	err := row.Scan(&vote.ID, &vote.Category, &vote.Name, &vote.Description, &vote.Organization, &vote.Photo, &vote.EndTime,
+		&vote.SignatureThreshold, &vote.PetitionState, &vote.MinSelections, &vote.MaxSelections)
+	if err != nil {
+		return nil, err
+	}
+	return vote, nil
+}
+
+// audited runs change in a transaction and records it in the audit log, see audit.Change.
+func (s *PostgresStorage) audited(ctx context.Context, actor string, action string, table string, id int, change func(tx pgx.Tx) (int, error)) error {
+	return audit.Change(ctx, s.db, auditTable, actor, action, table, id, change)
+}
@@ -0,0 +1,61 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+var (
+	// ErrWrongCategory is returned when a vote is used as a vote of another category.
+	ErrWrongCategory = errors.New("vote has another category")
+	// ErrVoteEnded is returned when casting a ballot for a vote past its end time.
+	ErrVoteEnded = errors.New("vote has ended")
+	// ErrUnknownOption is returned when a ballot has an option the vote does not have.
+	ErrUnknownOption = errors.New("vote has no such option")
+)
+
+// lockOpenVote locks the vote of the category against changes while a ballot for it is cast and checks that it still
+// accepts ballots. lock is the row lock, FOR SHARE unless the ballot changes the vote itself. It returns
+// pgx.ErrNoRows when the vote does not exist or was deleted, ErrWrongCategory when it is not a vote of the category
+// and ErrVoteEnded when it has ended.
+func lockOpenVote(ctx context.Context, tx pgx.Tx, voteID int, category string, lock string) error {
+	var voteCategory string
+	var endTime time.Time
+	err := tx.QueryRow(ctx, `SELECT category, end_time FROM votes WHERE id = $1 AND deleted_at IS NULL `+lock, voteID).
+		Scan(&voteCategory, &endTime)
+	if err != nil {
+		return err
+	}
+	if voteCategory != category {
+		return ErrWrongCategory
+	}
+	if !endTime.After(time.Now()) {
+		return ErrVoteEnded
+	}
+	return nil
+}
+
+// castBallot runs cast in a transaction after locking the open vote of the category with FOR SHARE, so options are
+// not deleted and the vote is not changed while the ballot is cast.
+func (s *PostgresStorage) castBallot(ctx context.Context, voteID int, category string, cast func(tx pgx.Tx) error) error {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockOpenVote(ctx, tx, voteID, category, "FOR SHARE"); err != nil {
+		return err
+	}
+	if err := cast(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
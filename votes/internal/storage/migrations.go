@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/GP-Hacks/kdt2024-commons/migrate"
+	"io/fs"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// migrationsTable records the applied migrations, it is named after the service because services may share a database.
+const migrationsTable = "votes_schema_migrations"
+
+func (s *PostgresStorage) Migrator() (*migrate.Migrator, error) {
+	const op = "storage.postgresql.Migrator"
+	files, err := fs.Sub(migrations, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	migrator, err := migrate.New(s.db, files, migrationsTable)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return migrator, nil
+}
+
+// Migrate applies the pending migrations and returns them.
+func (s *PostgresStorage) Migrate(ctx context.Context) ([]migrate.Migration, error) {
+	migrator, err := s.Migrator()
+	if err != nil {
+		return nil, err
+	}
+	return migrator.Up(ctx)
+}
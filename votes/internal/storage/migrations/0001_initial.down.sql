@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS votes_audit_log;
+DROP TABLE IF EXISTS choices_results;
+DROP TABLE IF EXISTS petition_results;
+DROP TABLE IF EXISTS rate_results;
+DROP TABLE IF EXISTS options;
+DROP TABLE IF EXISTS votes;
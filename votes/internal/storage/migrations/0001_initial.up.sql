@@ -0,0 +1,57 @@
+-- Baseline of the schema created before migrations were introduced, the statements are idempotent so the
+-- migration also applies to databases created by earlier releases.
+
+CREATE TABLE IF NOT EXISTS votes (
+    id SERIAL PRIMARY KEY,
+    category VARCHAR(255),
+    name TEXT,
+    description TEXT,
+    organization TEXT,
+    photo TEXT,
+    end_time TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS options (
+    vote_id INT REFERENCES votes(id) ON DELETE CASCADE,
+    option VARCHAR(255)
+);
+
+CREATE TABLE IF NOT EXISTS rate_results (
+    vote_id INT REFERENCES votes(id) ON DELETE CASCADE,
+    user_token TEXT,
+    rate INT,
+    UNIQUE (vote_id, user_token)
+);
+
+CREATE TABLE IF NOT EXISTS petition_results (
+    vote_id INT REFERENCES votes(id) ON DELETE CASCADE,
+    user_token TEXT,
+    support VARCHAR(50),
+    UNIQUE (vote_id, user_token)
+);
+
+CREATE TABLE IF NOT EXISTS choices_results (
+    vote_id INT REFERENCES votes(id) ON DELETE CASCADE,
+    user_token TEXT,
+    choice TEXT,
+    UNIQUE (vote_id, user_token)
+);
+
+ALTER TABLE votes ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+ALTER TABLE options ADD COLUMN IF NOT EXISTS id SERIAL PRIMARY KEY;
+
+ALTER TABLE options ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS votes_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    actor VARCHAR(255) NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    entity VARCHAR(50) NOT NULL,
+    entity_id INT NOT NULL,
+    before JSONB,
+    after JSONB,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS votes_audit_log_entity_idx ON votes_audit_log (entity, entity_id);
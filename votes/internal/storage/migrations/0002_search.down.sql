@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS votes_organization_trgm_idx;
+DROP INDEX IF EXISTS votes_name_trgm_idx;
+DROP INDEX IF EXISTS votes_search_idx;
+ALTER TABLE votes DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,14 @@
+-- Full-text search over the names, organizations and descriptions of votes in Russian. Misspelled names and
+-- organizations are matched by trigram similarity, pg_trgm is a trusted extension the owner of the database can create.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE votes ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', COALESCE(name, '')), 'A') ||
+    setweight(to_tsvector('russian', COALESCE(organization, '')), 'B') ||
+    setweight(to_tsvector('russian', COALESCE(description, '')), 'C')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS votes_search_idx ON votes USING gin (search_vector) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS votes_name_trgm_idx ON votes USING gin (name gin_trgm_ops) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS votes_organization_trgm_idx ON votes USING gin (organization gin_trgm_ops)
+    WHERE deleted_at IS NULL;
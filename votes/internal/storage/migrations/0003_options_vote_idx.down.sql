@@ -0,0 +1 @@
+DROP INDEX IF EXISTS options_vote_id_idx;
@@ -0,0 +1,2 @@
+-- The options of the listed choice votes are loaded in one query by vote ID.
+CREATE INDEX IF NOT EXISTS options_vote_id_idx ON options (vote_id, id) WHERE deleted_at IS NULL;
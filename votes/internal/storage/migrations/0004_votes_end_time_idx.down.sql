@@ -0,0 +1 @@
+DROP INDEX IF EXISTS votes_end_time_idx;
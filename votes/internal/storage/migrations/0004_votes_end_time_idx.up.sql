@@ -0,0 +1,2 @@
+-- Votes are listed in pages by end time.
+CREATE INDEX IF NOT EXISTS votes_end_time_idx ON votes (end_time, id) WHERE deleted_at IS NULL;
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS votes_outbox;
+
+DROP INDEX IF EXISTS votes_petition_state_idx;
+
+ALTER TABLE votes DROP COLUMN IF EXISTS state_changed_at;
+ALTER TABLE votes DROP COLUMN IF EXISTS official_response;
+ALTER TABLE votes DROP COLUMN IF EXISTS petition_state;
+ALTER TABLE votes DROP COLUMN IF EXISTS signature_threshold;
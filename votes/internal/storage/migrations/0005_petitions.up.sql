@@ -0,0 +1,25 @@
+ALTER TABLE votes ADD COLUMN IF NOT EXISTS signature_threshold INT NOT NULL DEFAULT 0;
+ALTER TABLE votes ADD COLUMN IF NOT EXISTS petition_state VARCHAR(20);
+ALTER TABLE votes ADD COLUMN IF NOT EXISTS official_response TEXT NOT NULL DEFAULT '';
+ALTER TABLE votes ADD COLUMN IF NOT EXISTS state_changed_at TIMESTAMPTZ;
+
+-- Petitions created before thresholds existed need 100 signatures, the ones that already have them are waiting for
+-- review. Their signers are not notified.
+UPDATE votes SET signature_threshold = 100, petition_state = 'collecting', state_changed_at = NOW()
+WHERE category = 'petition' AND petition_state IS NULL;
+
+UPDATE votes SET petition_state = 'threshold_reached'
+WHERE category = 'petition' AND petition_state = 'collecting'
+    AND signature_threshold <= (SELECT COUNT(*) FROM petition_results WHERE vote_id = votes.id AND support = 'for');
+
+CREATE INDEX IF NOT EXISTS votes_petition_state_idx ON votes (petition_state, id) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS votes_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    queue VARCHAR(255) NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS votes_outbox_unpublished_idx ON votes_outbox (id) WHERE published_at IS NULL;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS ranked_results;
+DROP TABLE IF EXISTS multiselect_results;
+
+ALTER TABLE votes DROP COLUMN IF EXISTS max_selections;
+ALTER TABLE votes DROP COLUMN IF EXISTS min_selections;
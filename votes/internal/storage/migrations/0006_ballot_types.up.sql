@@ -0,0 +1,20 @@
+ALTER TABLE votes ADD COLUMN IF NOT EXISTS min_selections INT NOT NULL DEFAULT 0;
+ALTER TABLE votes ADD COLUMN IF NOT EXISTS max_selections INT NOT NULL DEFAULT 0;
+
+-- Ballots of multiselect votes, a row for every selected option.
+CREATE TABLE IF NOT EXISTS multiselect_results (
+    vote_id INT NOT NULL REFERENCES votes(id) ON DELETE CASCADE,
+    user_token TEXT NOT NULL,
+    option_id INT NOT NULL REFERENCES options(id) ON DELETE CASCADE,
+    PRIMARY KEY (vote_id, user_token, option_id)
+);
+
+-- Ballots of ranked votes, a row for every ranked option, rank 1 being the most preferred one.
+CREATE TABLE IF NOT EXISTS ranked_results (
+    vote_id INT NOT NULL REFERENCES votes(id) ON DELETE CASCADE,
+    user_token TEXT NOT NULL,
+    option_id INT NOT NULL REFERENCES options(id) ON DELETE CASCADE,
+    rank INT NOT NULL,
+    PRIMARY KEY (vote_id, user_token, option_id),
+    UNIQUE (vote_id, user_token, rank)
+);
@@ -0,0 +1,210 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+type MultiSelectInfo struct {
+	ID            int
+	Category      string
+	Name          string
+	Description   string
+	Organization  string
+	EndTime       time.Time
+	Photo         string
+	Options       []string
+	MinSelections int
+	MaxSelections int
+	// Stats is the number of ballots selecting each option.
+	Stats  map[string]int32
+	Voters int
+	// Selected are the options selected by the user, empty when the user has not voted.
+	Selected []string
+}
+
+// SelectionCountError is returned when a multiselect ballot selects less than Min or more than Max options.
+type SelectionCountError struct {
+	Min int
+	Max int
+}
+
+func (e *SelectionCountError) Error() string {
+	return fmt.Sprintf("ballot must select from %d to %d options", e.Min, e.Max)
+}
+
+// GetMultiSelectInfo returns the multiselect vote with its results and the options selected by the user with token.
+// Selections of deleted options are not counted. It returns pgx.ErrNoRows when the vote does not exist or was
+// deleted and ErrWrongCategory when it is not a multiselect vote.
+func (s *PostgresStorage) GetMultiSelectInfo(ctx context.Context, voteId int, token string) (*MultiSelectInfo, error) {
+	const op = "storage.postgresql.GetMultiSelectInfo"
+
+	query := `
+		SELECT id, category, name, description, organization, photo, end_time, min_selections, max_selections
+		FROM votes
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+	var info MultiSelectInfo
+	err := s.db.QueryRow(ctx, query, voteId).Scan(
+		&info.ID, &info.Category, &info.Name, &info.Description,
+		&info.Organization, &info.Photo, &info.EndTime, &info.MinSelections, &info.MaxSelections,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if info.Category != "multiselect" {
+		return nil, fmt.Errorf("%s: %w", op, ErrWrongCategory)
+	}
+
+	options, err := s.getOptions(ctx, voteId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	info.Options = options
+
+	stats, err := s.calculateMultiSelectStats(ctx, voteId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	info.Stats = stats
+
+	err = s.db.QueryRow(ctx, `SELECT COUNT(DISTINCT user_token) FROM multiselect_results WHERE vote_id = $1`, voteId).
+		Scan(&info.Voters)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	selected, err := s.getUserSelection(ctx, voteId, token)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	info.Selected = selected
+
+	return &info, nil
+}
+
+// VoteMultiSelect replaces the selection of the user for the multiselect vote with options. It returns pgx.ErrNoRows
+// when the vote does not exist or was deleted, ErrWrongCategory when it is not a multiselect vote, ErrVoteEnded when
+// it has ended, ErrUnknownOption when one of options is not an option of the vote and *SelectionCountError when the
+// number of options is out of the limits of the vote.
+func (s *PostgresStorage) VoteMultiSelect(ctx context.Context, token string, voteId int, options []string) error {
+	const op = "storage.postgresql.VoteMultiSelect"
+
+	err := s.castBallot(ctx, voteId, "multiselect", func(tx pgx.Tx) error {
+		var minSelections, maxSelections int
+		err := tx.QueryRow(ctx, `SELECT min_selections, max_selections FROM votes WHERE id = $1`, voteId).
+			Scan(&minSelections, &maxSelections)
+		if err != nil {
+			return fmt.Errorf("failed to get selection limits: %w", err)
+		}
+		if len(options) < minSelections || len(options) > maxSelections {
+			return &SelectionCountError{Min: minSelections, Max: maxSelections}
+		}
+
+		ids, err := optionIDs(ctx, tx, voteId, options)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, `DELETE FROM multiselect_results WHERE vote_id = $1 AND user_token = $2`, voteId, token)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, `
+			INSERT INTO multiselect_results (vote_id, user_token, option_id)
+			SELECT $1, $2, UNNEST($3::INT[])`, voteId, token, ids)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *PostgresStorage) calculateMultiSelectStats(ctx context.Context, voteId int) (map[string]int32, error) {
+	const op = "storage.postgresql.calculateMultiSelectStats"
+
+	query := `
+		SELECT o.option, COUNT(*)
+		FROM multiselect_results r
+		JOIN options o ON o.id = r.option_id AND o.deleted_at IS NULL
+		WHERE r.vote_id = $1
+		GROUP BY o.option
+	`
+	rows, err := s.db.Query(ctx, query, voteId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	stats := make(map[string]int32)
+	for rows.Next() {
+		var option string
+		var count int
+		if err := rows.Scan(&option, &count); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		stats[option] = int32(count)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
+
+func (s *PostgresStorage) getUserSelection(ctx context.Context, voteId int, token string) ([]string, error) {
+	const op = "storage.postgresql.getUserSelection"
+
+	query := `
+		SELECT o.option
+		FROM multiselect_results r
+		JOIN options o ON o.id = r.option_id AND o.deleted_at IS NULL
+		WHERE r.vote_id = $1 AND r.user_token = $2
+		ORDER BY o.id
+	`
+	rows, err := s.db.Query(ctx, query, voteId, token)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	selected, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return selected, nil
+}
+
+// optionIDs returns the IDs of the options of the vote in the order of options. It returns ErrUnknownOption when
+// one of them is not an option of the vote.
+func optionIDs(ctx context.Context, tx pgx.Tx, voteID int, options []string) ([]int, error) {
+	rows, err := tx.Query(ctx, `SELECT id, option FROM options WHERE vote_id = $1 AND deleted_at IS NULL`, voteID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get options: %w", err)
+	}
+	defer rows.Close()
+
+	ids := make(map[string]int)
+	for rows.Next() {
+		var id int
+		var option string
+		if err := rows.Scan(&id, &option); err != nil {
+			return nil, fmt.Errorf("failed to get options: %w", err)
+		}
+		ids[option] = id
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get options: %w", err)
+	}
+
+	result := make([]int, 0, len(options))
+	for _, option := range options {
+		id, ok := ids[option]
+		if !ok {
+			return nil, ErrUnknownOption
+		}
+		result = append(result, id)
+	}
+	return result, nil
+}
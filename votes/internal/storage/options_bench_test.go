@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+// benchmarkVotes fills the storage with choice votes of four options each and returns their IDs.
+func benchmarkVotes(b *testing.B, s *PostgresStorage, count int) []int {
+	b.Helper()
+	ctx := context.Background()
+	rows, err := s.db.Query(ctx, `
+		INSERT INTO votes (category, name, description, organization, photo, end_time)
+		SELECT 'choice', 'Голосование ' || g, '', 'Организация', '', NOW() + INTERVAL '7 days'
+		FROM generate_series(1, $1) AS g
+		RETURNING id`, count)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			b.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		b.Fatal(err)
+	}
+
+	_, err = s.db.Exec(ctx, `
+		INSERT INTO options (vote_id, option)
+		SELECT v, 'Вариант ' || n
+		FROM unnest($1::int[]) AS v, generate_series(1, 4) AS n`, ids)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if _, err := s.db.Exec(ctx, `ANALYZE votes, options`); err != nil {
+		b.Fatal(err)
+	}
+	return ids
+}
+
+// BenchmarkVoteOptions compares loading the options of a page of votes one vote at a time, as the votes list did
+// before, with loading them in one query.
+func BenchmarkVoteOptions(b *testing.B) {
+	s := testStorage(b)
+	ctx := context.Background()
+	ids := benchmarkVotes(b, s, 1000)
+
+	for _, size := range []int{10, 50, 100} {
+		page := ids[:size]
+		b.Run(fmt.Sprintf("per_vote/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for _, id := range page {
+					if _, err := s.getOptions(ctx, id); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("batched/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := s.getOptionsByVoteIDs(ctx, page); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
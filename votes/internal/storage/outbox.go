@@ -0,0 +1,21 @@
+package storage
+
+import (
+	"context"
+	"github.com/GP-Hacks/kdt2024-commons/outbox"
+	"github.com/jackc/pgx/v5"
+)
+
+// OutboxMessage is a message stored in the outbox of the service.
+type OutboxMessage = outbox.Message
+
+const outboxTable = "votes_outbox"
+
+// PublishOutbox hands up to limit unpublished messages of the outbox to publish, see outbox.Publish.
+func (s *PostgresStorage) PublishOutbox(ctx context.Context, limit int, publish func(*OutboxMessage) error) (int, error) {
+	return outbox.Publish(ctx, s.db, outboxTable, limit, publish)
+}
+
+func insertOutbox(ctx context.Context, tx pgx.Tx, messages []*OutboxMessage) error {
+	return outbox.Insert(ctx, tx, outboxTable, messages)
+}
@@ -0,0 +1,363 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/GP-Hacks/kdt2024-commons/audit"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+// States of a petition. It collects signatures until it has SignatureThreshold of them, then the organization reviews
+// it and answers or rejects it with an official response.
+const (
+	PetitionCollecting       = "collecting"
+	PetitionThresholdReached = "threshold_reached"
+	PetitionUnderReview      = "under_review"
+	PetitionAnswered         = "answered"
+	PetitionRejected         = "rejected"
+)
+
+// Values of the support of a petition ballot, only SupportFor counts as a signature.
+const (
+	SupportFor     = "for"
+	SupportAgainst = "against"
+)
+
+// petitionTransitions are the states a petition can be moved to by the organization from each state. Reaching the
+// threshold is not among them, it happens when the last signature needed is given.
+var petitionTransitions = map[string][]string{
+	PetitionCollecting:       {PetitionRejected},
+	PetitionThresholdReached: {PetitionUnderReview, PetitionRejected},
+	PetitionUnderReview:      {PetitionAnswered, PetitionRejected},
+}
+
+var (
+	// ErrPetitionClosed is returned when signing a petition that is already reviewed.
+	ErrPetitionClosed = errors.New("petition no longer collects signatures")
+	// ErrInvalidTransition is returned when a petition cannot be moved from its state to the requested one.
+	ErrInvalidTransition = errors.New("invalid petition state transition")
+	// ErrNotPetition is returned when changing the petition state of another vote.
+	ErrNotPetition = errors.New("vote is not a petition")
+)
+
+// PetitionMessages returns the outbox messages telling the signers of the petition about its new state.
+type PetitionMessages func(petition *PetitionInfo, signers []string) ([]*OutboxMessage, error)
+
+// PetitionsQuery selects a page of the petitions, only the ones in State unless it is empty.
+type PetitionsQuery struct {
+	State string
+	Limit int
+	// AfterID is the ID of the petition before the page, 0 for the first page.
+	AfterID int
+}
+
+const petitionColumns = "id, category, name, description, organization, photo, end_time, signature_threshold, " +
+	"petition_state, official_response, state_changed_at"
+
+// CanTransition reports whether the organization can move a petition from one state to the other.
+func CanTransition(from string, to string) bool {
+	for _, state := range petitionTransitions[from] {
+		if state == to {
+			return true
+		}
+	}
+	return false
+}
+
+func initialPetitionState(category string) interface{} {
+	if category != "petition" {
+		return nil
+	}
+	return PetitionCollecting
+}
+
+func (s *PostgresStorage) GetPetitionInfo(ctx context.Context, voteId int) (*PetitionInfo, error) {
+	const op = "storage.postgresql.GetPetitionInfo"
+
+	petitionInfo, err := scanPetition(s.db.QueryRow(ctx, `
+		SELECT `+petitionColumns+`
+		FROM votes
+		WHERE id = $1 AND deleted_at IS NULL`, voteId))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if petitionInfo.Category != "petition" {
+		return nil, fmt.Errorf("%s: %w", op, ErrWrongCategory)
+	}
+
+	stats, err := s.calculatePetitionStats(ctx, []int{voteId})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	petitionInfo.setStats(stats[voteId])
+
+	return petitionInfo, nil
+}
+
+// GetPetitions returns a page of the petitions matching query and whether there are petitions after it.
+func (s *PostgresStorage) GetPetitions(ctx context.Context, query *PetitionsQuery) ([]*PetitionInfo, bool, error) {
+	const op = "storage.postgresql.GetPetitions"
+
+	rows, err := s.db.Query(ctx, `
+		SELECT `+petitionColumns+`
+		FROM votes
+		WHERE category = 'petition' AND deleted_at IS NULL AND ($1 = '' OR petition_state = $1) AND id > $2
+		ORDER BY id
+		LIMIT $3`, query.State, query.AfterID, query.Limit+1)
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var petitions []*PetitionInfo
+	var ids []int
+	for rows.Next() {
+		petition, err := scanPetition(rows)
+		if err != nil {
+			return nil, false, fmt.Errorf("%s: %w", op, err)
+		}
+		petitions = append(petitions, petition)
+		ids = append(ids, petition.ID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, fmt.Errorf("%s: %w", op, err)
+	}
+	rows.Close()
+
+	hasMore := len(petitions) > query.Limit
+	if hasMore {
+		petitions = petitions[:query.Limit]
+		ids = ids[:query.Limit]
+	}
+
+	stats, err := s.calculatePetitionStats(ctx, ids)
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, petition := range petitions {
+		petition.setStats(stats[petition.ID])
+	}
+	return petitions, hasMore, nil
+}
+
+// VotePetition records the support of the user for the petition. The signature that makes a collecting petition
+// reach its threshold moves it to PetitionThresholdReached, and its signers get the messages of buildMessages.
+// It returns pgx.ErrNoRows when the petition does not exist or was deleted, ErrWrongCategory when the vote is not a
+// petition, ErrVoteEnded when it has ended and ErrPetitionClosed when it is no longer collecting signatures.
+func (s *PostgresStorage) VotePetition(ctx context.Context, token string, voteId int, support string, buildMessages PetitionMessages) (bool, error) {
+	const op = "storage.postgresql.VotePetition"
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx)
+
+	// The petition is locked so the signature reaching the threshold is counted by exactly one transaction.
+	if err := lockOpenVote(ctx, tx, voteId, "petition", "FOR NO KEY UPDATE"); err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	var state string
+	if err := tx.QueryRow(ctx, `SELECT petition_state FROM votes WHERE id = $1`, voteId).Scan(&state); err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	if state != PetitionCollecting && state != PetitionThresholdReached {
+		return false, ErrPetitionClosed
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO petition_results (vote_id, user_token, support)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (vote_id, user_token)
+		DO UPDATE SET support = EXCLUDED.support`, voteId, token, support)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	reached := false
+	if state == PetitionCollecting && support == SupportFor {
+		if reached, err = reachThreshold(ctx, tx, voteId, buildMessages); err != nil {
+			return false, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return reached, nil
+}
+
+// UpdatePetitionState moves the petition to state, recording the official response of the organization, and stores
+// the messages of buildMessages for its signers in the same transaction. It returns pgx.ErrNoRows when the petition
+// does not exist or was deleted, ErrNotPetition when the vote is not a petition and ErrInvalidTransition when the
+// petition cannot be moved from its current state to state.
+func (s *PostgresStorage) UpdatePetitionState(ctx context.Context, actor string, voteID int, state string, response string, buildMessages PetitionMessages) (*PetitionInfo, error) {
+	const op = "storage.postgresql.UpdatePetitionState"
+
+	var petition *PetitionInfo
+	err := s.audited(ctx, actor, audit.Update, "votes", voteID, func(tx pgx.Tx) (int, error) {
+		var category string
+		var current *string
+		if err := tx.QueryRow(ctx, `SELECT category, petition_state FROM votes WHERE id = $1`, voteID).Scan(&category, &current); err != nil {
+			return 0, err
+		}
+		if category != "petition" || current == nil {
+			return 0, ErrNotPetition
+		}
+		if !CanTransition(*current, state) {
+			return 0, ErrInvalidTransition
+		}
+
+		var err error
+		petition, err = changePetitionState(ctx, tx, voteID, state, response, buildMessages)
+		return voteID, err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, ErrNotPetition) || errors.Is(err, ErrInvalidTransition) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	stats, err := s.calculatePetitionStats(ctx, []int{voteID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	petition.setStats(stats[voteID])
+	return petition, nil
+}
+
+// reachThreshold moves the locked petition to PetitionThresholdReached when it is collecting signatures and has
+// enough of them. It reports whether the petition reached the threshold.
+func reachThreshold(ctx context.Context, tx pgx.Tx, voteID int, buildMessages PetitionMessages) (bool, error) {
+	var reached bool
+	err := tx.QueryRow(ctx, `
+		SELECT COALESCE(petition_state = $2, FALSE) AND signature_threshold > 0 AND signature_threshold <= (
+			SELECT COUNT(*) FROM petition_results WHERE vote_id = votes.id AND support = $3
+		)
+		FROM votes WHERE id = $1`, voteID, PetitionCollecting, SupportFor).Scan(&reached)
+	if err != nil {
+		return false, fmt.Errorf("failed to count signatures: %w", err)
+	}
+	if !reached {
+		return false, nil
+	}
+	if _, err := changePetitionState(ctx, tx, voteID, PetitionThresholdReached, "", buildMessages); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// changePetitionState moves the petition to state and stores the messages for its signers in the outbox. The
+// official response is kept when response is empty.
+func changePetitionState(ctx context.Context, tx pgx.Tx, voteID int, state string, response string, buildMessages PetitionMessages) (*PetitionInfo, error) {
+	petition, err := scanPetition(tx.QueryRow(ctx, `
+		UPDATE votes
+		SET petition_state = $2, official_response = CASE WHEN $3 = '' THEN official_response ELSE $3 END,
+			state_changed_at = NOW()
+		WHERE id = $1
+		RETURNING `+petitionColumns, voteID, state, response))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update petition state: %w", err)
+	}
+
+	signers, err := petitionSigners(ctx, tx, voteID)
+	if err != nil {
+		return nil, err
+	}
+	messages, err := buildMessages(petition, signers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build notifications: %w", err)
+	}
+	if err := insertOutbox(ctx, tx, messages); err != nil {
+		return nil, err
+	}
+	return petition, nil
+}
+
+func petitionSigners(ctx context.Context, tx pgx.Tx, voteID int) ([]string, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT user_token FROM petition_results
+		WHERE vote_id = $1 AND support = $2 AND user_token <> ''`, voteID, SupportFor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query signers: %w", err)
+	}
+	defer rows.Close()
+
+	var signers []string
+	for rows.Next() {
+		var signer string
+		if err := rows.Scan(&signer); err != nil {
+			return nil, fmt.Errorf("failed to scan signer: %w", err)
+		}
+		signers = append(signers, signer)
+	}
+	return signers, rows.Err()
+}
+
+// calculatePetitionStats counts the ballots of the petitions by support, by vote ID. Petitions without ballots are
+// missing from the map.
+func (s *PostgresStorage) calculatePetitionStats(ctx context.Context, voteIDs []int) (map[int]map[string]int32, error) {
+	const op = "storage.postgresql.calculatePetitionStats"
+
+	stats := make(map[int]map[string]int32, len(voteIDs))
+	if len(voteIDs) == 0 {
+		return stats, nil
+	}
+
+	query := `
+		SELECT vote_id, support, COUNT(*)
+		FROM petition_results
+		WHERE vote_id = ANY($1)
+		GROUP BY vote_id, support
+	`
+	rows, err := s.db.Query(ctx, query, voteIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var voteID int
+		var support string
+		var count int
+		if err := rows.Scan(&voteID, &support, &count); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if stats[voteID] == nil {
+			stats[voteID] = make(map[string]int32)
+		}
+		stats[voteID][support] = int32(count)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
+
+func (p *PetitionInfo) setStats(stats map[string]int32) {
+	if stats == nil {
+		stats = make(map[string]int32)
+	}
+	p.Stats = stats
+	p.Signatures = int(stats[SupportFor])
+}
+
+func scanPetition(row pgx.Row) (*PetitionInfo, error) {
+	petition := &PetitionInfo{Options: []string{}, Stats: map[string]int32{}}
+	var stateChangedAt *time.Time
+	err := row.Scan(&petition.ID, &petition.Category, &petition.Name, &petition.Description, &petition.Organization,
+		&petition.Photo, &petition.EndTime, &petition.SignatureThreshold, &petition.State, &petition.OfficialResponse,
+		&stateChangedAt)
+	if err != nil {
+		return nil, err
+	}
+	if stateChangedAt != nil {
+		petition.StateChangedAt = *stateChangedAt
+	}
+	return petition, nil
+}
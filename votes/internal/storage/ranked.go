@@ -0,0 +1,127 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+type RankedInfo struct {
+	ID           int
+	Category     string
+	Name         string
+	Description  string
+	Organization string
+	EndTime      time.Time
+	Photo        string
+	Options      []string
+	// Ballots are the rankings of every user who voted, deleted options left out.
+	Ballots [][]string
+	// Ranking is the ranking of the user, empty when the user has not voted.
+	Ranking []string
+}
+
+// GetRankedInfo returns the ranked vote with the ballots cast for it and the ranking of the user with token. It
+// returns pgx.ErrNoRows when the vote does not exist or was deleted and ErrWrongCategory when it is not a ranked vote.
+func (s *PostgresStorage) GetRankedInfo(ctx context.Context, voteId int, token string) (*RankedInfo, error) {
+	const op = "storage.postgresql.GetRankedInfo"
+
+	query := `
+		SELECT id, category, name, description, organization, photo, end_time
+		FROM votes
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+	var info RankedInfo
+	err := s.db.QueryRow(ctx, query, voteId).Scan(
+		&info.ID, &info.Category, &info.Name, &info.Description,
+		&info.Organization, &info.Photo, &info.EndTime,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if info.Category != "ranked" {
+		return nil, fmt.Errorf("%s: %w", op, ErrWrongCategory)
+	}
+
+	options, err := s.getOptions(ctx, voteId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	info.Options = options
+
+	ballots, err := s.getRankedBallots(ctx, voteId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	info.Ballots = make([][]string, 0, len(ballots))
+	for user, ballot := range ballots {
+		info.Ballots = append(info.Ballots, ballot)
+		if user == token {
+			info.Ranking = ballot
+		}
+	}
+
+	return &info, nil
+}
+
+// VoteRanked replaces the ranking of the user for the ranked vote, ranking lists options from the most preferred one.
+// It returns pgx.ErrNoRows when the vote does not exist or was deleted, ErrWrongCategory when it is not a ranked
+// vote, ErrVoteEnded when it has ended and ErrUnknownOption when one of ranking is not an option of the vote.
+func (s *PostgresStorage) VoteRanked(ctx context.Context, token string, voteId int, ranking []string) error {
+	const op = "storage.postgresql.VoteRanked"
+
+	err := s.castBallot(ctx, voteId, "ranked", func(tx pgx.Tx) error {
+		ids, err := optionIDs(ctx, tx, voteId, ranking)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, `DELETE FROM ranked_results WHERE vote_id = $1 AND user_token = $2`, voteId, token)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, `
+			INSERT INTO ranked_results (vote_id, user_token, option_id, rank)
+			SELECT $1, $2, option_id, rank
+			FROM UNNEST($3::INT[]) WITH ORDINALITY AS ranking(option_id, rank)`, voteId, token, ids)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// getRankedBallots returns the rankings of the ranked vote by user token. Deleted options are left out, so a ballot
+// ranking only deleted options is missing.
+func (s *PostgresStorage) getRankedBallots(ctx context.Context, voteId int) (map[string][]string, error) {
+	const op = "storage.postgresql.getRankedBallots"
+
+	query := `
+		SELECT r.user_token, o.option
+		FROM ranked_results r
+		JOIN options o ON o.id = r.option_id AND o.deleted_at IS NULL
+		WHERE r.vote_id = $1
+		ORDER BY r.user_token, r.rank
+	`
+	rows, err := s.db.Query(ctx, query, voteId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	ballots := make(map[string][]string)
+	for rows.Next() {
+		var token, option string
+		if err := rows.Scan(&token, &option); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		ballots[token] = append(ballots[token], option)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ballots, nil
+}
@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+)
+
+type SearchResult struct {
+	ID       int
+	Category string
+	Name     string
+	Photo    string
+	Headline string
+	Rank     float64
+}
+
+// Search returns at most limit votes whose names, organizations or descriptions match query,
+// or whose names or organizations look like a word of query, best match first. The headlines are built with
+// headlineOptions.
+func (s *PostgresStorage) Search(ctx context.Context, query string, limit int, headlineOptions string) ([]*SearchResult, error) {
+	const op = "storage.postgresql.Search"
+
+	rows, err := s.db.Query(ctx, `
+		SELECT v.id, COALESCE(v.category, ''), COALESCE(v.name, ''), COALESCE(v.photo, ''),
+			ts_headline('russian', COALESCE(v.description, ''), q, $2),
+			ts_rank(v.search_vector, q) +
+				GREATEST(COALESCE(word_similarity($1, v.name), 0), COALESCE(word_similarity($1, v.organization), 0)) AS rank
+		FROM votes v, websearch_to_tsquery('russian', $1) AS q
+		WHERE v.deleted_at IS NULL AND (v.search_vector @@ q OR $1 <% v.name OR $1 <% v.organization)
+		ORDER BY rank DESC, v.id
+		LIMIT $3`, query, headlineOptions, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to search votes: %w", op, err)
+	}
+	defer rows.Close()
+
+	results := []*SearchResult{}
+	for rows.Next() {
+		result := &SearchResult{}
+		if err := rows.Scan(&result.ID, &result.Category, &result.Name, &result.Photo, &result.Headline, &result.Rank); err != nil {
+			return nil, fmt.Errorf("%s: failed to scan vote: %w", op, err)
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: error occurred while iterating over votes: %w", op, err)
+	}
+	return results, nil
+}
@@ -2,7 +2,9 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/GP-Hacks/kdt2024-commons/pagination"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"time"
@@ -17,6 +19,12 @@ type Vote struct {
 	EndTime      time.Time
 	Photo        string
 	Options      []string
+	// SignatureThreshold and PetitionState are only set for petitions.
+	SignatureThreshold int
+	PetitionState      string
+	// MinSelections and MaxSelections are only set for multiselect votes.
+	MinSelections int
+	MaxSelections int
 }
 
 type RateInfo struct {
@@ -32,15 +40,21 @@ type RateInfo struct {
 }
 
 type PetitionInfo struct {
-	ID           int
-	Category     string
-	Name         string
-	Description  string
-	Organization string
-	EndTime      time.Time
-	Photo        string
-	Options      []string
-	Stats        map[string]int32
+	ID                 int
+	Category           string
+	Name               string
+	Description        string
+	Organization       string
+	EndTime            time.Time
+	Photo              string
+	Options            []string
+	Stats              map[string]int32
+	SignatureThreshold int
+	// Signatures is the number of users supporting the petition, Stats[SupportFor].
+	Signatures       int
+	State            string
+	OfficialResponse string
+	StateChangedAt   time.Time
 }
 
 type ChoiceInfo struct {
@@ -90,7 +104,7 @@ func (s *PostgresStorage) Close() {
 func (s *PostgresStorage) GetCategories(ctx context.Context) ([]string, error) {
 	const op = "storage.postgresql.GetCategories"
 
-	rows, err := s.db.Query(ctx, "SELECT DISTINCT category FROM votes")
+	rows, err := s.db.Query(ctx, "SELECT DISTINCT category FROM votes WHERE deleted_at IS NULL")
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -112,24 +126,58 @@ func (s *PostgresStorage) GetCategories(ctx context.Context) ([]string, error) {
 	return categories, nil
 }
 
-func (s *PostgresStorage) GetVotes(ctx context.Context) ([]*Vote, error) {
-	const op = "storage.postgresql.GetVotes"
+const (
+	VoteSortID      = "id"
+	VoteSortEndTime = "end_time"
+)
 
-	query := `
-		SELECT id, category, name, description, organization, photo, end_time 
-		FROM votes
-	`
-	return s.fetchVotes(ctx, query)
+// VotesQuery selects a page of the votes, only the ones of Category unless it is empty.
+type VotesQuery struct {
+	Category string
+	Sort     pagination.Sort
+	Limit    int
+	// AfterEndTime and AfterID are the cursor of the page, the end time and the ID of the vote before it.
+	// AfterEndTime is only used when sorting by end time, AfterID is 0 for the first page.
+	AfterEndTime time.Time
+	AfterID      int
 }
 
-func (s *PostgresStorage) GetVotesByCategory(ctx context.Context, category string) ([]*Vote, error) {
+// GetVotes returns a page of the votes matching query and whether there are votes after it.
+func (s *PostgresStorage) GetVotes(ctx context.Context, query *VotesQuery) ([]*Vote, bool, error) {
 	const op = "storage.postgresql.GetVotes"
 
-	query := `
-		SELECT id, category, name, description, organization, photo, end_time 
-		FROM votes WHERE category = $1
-	`
-	return s.fetchVotes(ctx, query, category)
+	args := []interface{}{query.Category}
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	sql := `
+		SELECT ` + voteColumns + `
+		FROM votes WHERE ($1 = '' OR category = $1) AND deleted_at IS NULL`
+	key := "id"
+	if query.Sort.Key == VoteSortEndTime {
+		key = "end_time"
+	}
+	if query.AfterID > 0 {
+		idArg := arg(query.AfterID)
+		keyArg := idArg
+		if key == "end_time" {
+			keyArg = arg(query.AfterEndTime)
+		}
+		sql += " AND " + query.Sort.After(key, "id", keyArg, idArg)
+	}
+	sql += " ORDER BY " + query.Sort.OrderBy(key, "id") + " LIMIT " + arg(query.Limit+1)
+
+	votes, err := s.fetchVotes(ctx, sql, args...)
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: %w", op, err)
+	}
+	hasMore := len(votes) > query.Limit
+	if hasMore {
+		votes = votes[:query.Limit]
+	}
+	return votes, hasMore, nil
 }
 
 func (s *PostgresStorage) GetUserRates(ctx context.Context, token string) ([]*UserRate, error) {
@@ -218,27 +266,33 @@ func (s *PostgresStorage) fetchVotes(ctx context.Context, query string, args ...
 
 	var votes []*Vote
 	for rows.Next() {
-		var vote Vote
-		if err := rows.Scan(&vote.ID, &vote.Category, &vote.Name, &vote.Description, &vote.Organization, &vote.Photo, &vote.EndTime); err != nil {
+		vote, err := scanVote(rows)
+		if err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
-
-		if vote.Category == "choice" {
-			options, err := s.getOptions(ctx, vote.ID)
-			if err != nil {
-				return nil, fmt.Errorf("%s: %w", op, err)
-			}
-			vote.Options = options
-		} else {
-			vote.Options = []string{}
-		}
-
-		votes = append(votes, &vote)
+		votes = append(votes, vote)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	rows.Close()
+
+	var optionVoteIDs []int
+	for _, vote := range votes {
+		if HasOptions(vote.Category) {
+			optionVoteIDs = append(optionVoteIDs, vote.ID)
+		}
+	}
+	options, err := s.getOptionsByVoteIDs(ctx, optionVoteIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, vote := range votes {
+		if voteOptions, ok := options[vote.ID]; ok {
+			vote.Options = voteOptions
+		}
+	}
 
 	return votes, nil
 }
@@ -249,7 +303,7 @@ func (s *PostgresStorage) GetRateInfo(ctx context.Context, voteId int) (*RateInf
 	query := `
 		SELECT id, category, name, description, organization, photo, end_time 
 		FROM votes 
-		WHERE id = $1 AND category = 'rate'
+		WHERE id = $1 AND deleted_at IS NULL
 	`
 	var rateInfo RateInfo
 	err := s.db.QueryRow(ctx, query, voteId).Scan(
@@ -259,6 +313,9 @@ func (s *PostgresStorage) GetRateInfo(ctx context.Context, voteId int) (*RateInf
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	if rateInfo.Category != "rate" {
+		return nil, fmt.Errorf("%s: %w", op, ErrWrongCategory)
+	}
 
 	mid, err := s.calculateAverageRating(ctx, voteId)
 	if err != nil {
@@ -270,40 +327,13 @@ func (s *PostgresStorage) GetRateInfo(ctx context.Context, voteId int) (*RateInf
 	return &rateInfo, nil
 }
 
-func (s *PostgresStorage) GetPetitionInfo(ctx context.Context, voteId int) (*PetitionInfo, error) {
-	const op = "storage.postgresql.GetPetitionInfo"
-
-	query := `
-		SELECT id, category, name, description, organization, photo, end_time 
-		FROM votes 
-		WHERE id = $1 AND category = 'petition'
-	`
-	var petitionInfo PetitionInfo
-	err := s.db.QueryRow(ctx, query, voteId).Scan(
-		&petitionInfo.ID, &petitionInfo.Category, &petitionInfo.Name, &petitionInfo.Description,
-		&petitionInfo.Organization, &petitionInfo.Photo, &petitionInfo.EndTime,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-
-	stats, err := s.calculatePetitionStats(ctx, voteId)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-	petitionInfo.Stats = stats
-	petitionInfo.Options = []string{}
-
-	return &petitionInfo, nil
-}
-
 func (s *PostgresStorage) GetChoiceInfo(ctx context.Context, voteId int) (*ChoiceInfo, error) {
 	const op = "storage.postgresql.GetChoiceInfo"
 
 	query := `
 		SELECT id, category, name, description, organization, photo, end_time 
 		FROM votes 
-		WHERE id = $1 AND category = 'choice'
+		WHERE id = $1 AND deleted_at IS NULL
 	`
 	var choiceInfo ChoiceInfo
 	err := s.db.QueryRow(ctx, query, voteId).Scan(
@@ -313,6 +343,9 @@ func (s *PostgresStorage) GetChoiceInfo(ctx context.Context, voteId int) (*Choic
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	if choiceInfo.Category != "choice" {
+		return nil, fmt.Errorf("%s: %w", op, ErrWrongCategory)
+	}
 
 	options, err := s.getOptions(ctx, voteId)
 	if err != nil {
@@ -329,52 +362,92 @@ func (s *PostgresStorage) GetChoiceInfo(ctx context.Context, voteId int) (*Choic
 	return &choiceInfo, nil
 }
 
+// VoteRate records the rating of the user for the rate vote. It returns pgx.ErrNoRows when the vote does not exist
+// or was deleted, ErrWrongCategory when it is not a rate vote and ErrVoteEnded when it has ended.
 func (s *PostgresStorage) VoteRate(ctx context.Context, token string, voteId int, rating int) error {
 	const op = "storage.postgresql.VoteRate"
 
-	query := `
-		INSERT INTO rate_results (vote_id, user_token, rate)
-		VALUES ($1, $2, $3)
-		ON CONFLICT (vote_id, user_token) 
-		DO UPDATE SET rate = EXCLUDED.rate
-	`
-	_, err := s.db.Exec(ctx, query, voteId, token, rating)
+	err := s.castBallot(ctx, voteId, "rate", func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO rate_results (vote_id, user_token, rate)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (vote_id, user_token)
+			DO UPDATE SET rate = EXCLUDED.rate`, voteId, token, rating)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 	return nil
 }
 
-func (s *PostgresStorage) VotePetition(ctx context.Context, token string, voteId int, support string) error {
-	const op = "storage.postgresql.VotePetition"
+// VoteChoice records the choice of the user for the choice vote. It returns pgx.ErrNoRows when the vote does not
+// exist or was deleted, ErrWrongCategory when it is not a choice vote, ErrVoteEnded when it has ended and
+// ErrUnknownOption when choice is not one of its options.
+func (s *PostgresStorage) VoteChoice(ctx context.Context, token string, voteId int, choice string) error {
+	const op = "storage.postgresql.VoteChoice"
 
-	query := `
-		INSERT INTO petition_results (vote_id, user_token, support)
-		VALUES ($1, $2, $3)
-		ON CONFLICT (vote_id, user_token) 
-		DO UPDATE SET support = EXCLUDED.support
-	`
-	_, err := s.db.Exec(ctx, query, voteId, token, support)
+	err := s.castBallot(ctx, voteId, "choice", func(tx pgx.Tx) error {
+		var known bool
+		err := tx.QueryRow(ctx, `
+			SELECT EXISTS (SELECT 1 FROM options WHERE vote_id = $1 AND option = $2 AND deleted_at IS NULL)`,
+			voteId, choice).Scan(&known)
+		if err != nil {
+			return fmt.Errorf("failed to check option: %w", err)
+		}
+		if !known {
+			return ErrUnknownOption
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO choices_results (vote_id, user_token, choice)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (vote_id, user_token)
+			DO UPDATE SET choice = EXCLUDED.choice`, voteId, token, choice)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 	return nil
 }
 
-func (s *PostgresStorage) VoteChoice(ctx context.Context, token string, voteId int, choice string) error {
-	const op = "storage.postgresql.VoteChoice"
+// getOptionsByVoteIDs returns the options of the votes in one query, by vote ID. Votes without options are missing
+// from the map.
+func (s *PostgresStorage) getOptionsByVoteIDs(ctx context.Context, voteIDs []int) (map[int][]string, error) {
+	const op = "storage.postgresql.getOptionsByVoteIDs"
+
+	options := make(map[int][]string, len(voteIDs))
+	if len(voteIDs) == 0 {
+		return options, nil
+	}
 
 	query := `
-		INSERT INTO choices_results (vote_id, user_token, choice)
-		VALUES ($1, $2, $3)
-		ON CONFLICT (vote_id, user_token) 
-		DO UPDATE SET choice = EXCLUDED.choice
+		SELECT vote_id, option
+		FROM options
+		WHERE vote_id = ANY($1) AND deleted_at IS NULL
+		ORDER BY vote_id, id
 	`
-	_, err := s.db.Exec(ctx, query, voteId, token, choice)
+	rows, err := s.db.Query(ctx, query, voteIDs)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-	return nil
+	defer rows.Close()
+
+	for rows.Next() {
+		var voteID int
+		var option string
+		if err := rows.Scan(&voteID, &option); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		options[voteID] = append(options[voteID], option)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return options, nil
 }
 
 func (s *PostgresStorage) getOptions(ctx context.Context, voteId int) ([]string, error) {
@@ -383,7 +456,8 @@ func (s *PostgresStorage) getOptions(ctx context.Context, voteId int) ([]string,
 	query := `
 		SELECT option 
 		FROM options 
-		WHERE vote_id = $1
+		WHERE vote_id = $1 AND deleted_at IS NULL
+		ORDER BY id
 	`
 	rows, err := s.db.Query(ctx, query, voteId)
 	if err != nil {
@@ -424,38 +498,6 @@ func (s *PostgresStorage) calculateAverageRating(ctx context.Context, voteId int
 	return mid, nil
 }
 
-func (s *PostgresStorage) calculatePetitionStats(ctx context.Context, voteId int) (map[string]int32, error) {
-	const op = "storage.postgresql.calculatePetitionStats"
-
-	query := `
-		SELECT support, COUNT(*) 
-		FROM petition_results 
-		WHERE vote_id = $1 
-		GROUP BY support
-	`
-	rows, err := s.db.Query(ctx, query, voteId)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-	defer rows.Close()
-
-	stats := make(map[string]int32)
-	for rows.Next() {
-		var support string
-		var count int
-		if err := rows.Scan(&support, &count); err != nil {
-			return nil, fmt.Errorf("%s: %w", op, err)
-		}
-		stats[support] = int32(count)
-	}
-
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
-	}
-
-	return stats, nil
-}
-
 func (s *PostgresStorage) calculateChoiceStats(ctx context.Context, voteId int) (map[string]int32, error) {
 	const op = "storage.postgresql.calculateChoiceStats"
 
@@ -488,123 +530,47 @@ func (s *PostgresStorage) calculateChoiceStats(ctx context.Context, voteId int)
 	return stats, nil
 }
 
-func (s *PostgresStorage) FetchAndStoreData(ctx context.Context) error {
-	const op = "storage.postgresql.FetchAndStoreData"
-
-	var count int
-	err := s.db.QueryRow(ctx, "SELECT COUNT(*) FROM votes").Scan(&count)
-	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
-
-	if count > 0 {
-		return nil
-	}
-
-	votes := []Vote{
-		{1, "choice", "Лучший кружок по интересам", "Опрос о том, какой кружок по интересам в вашем районе вы считаете самым интересным и полезным.", "Управление молодежной политики Республики Татарстан", time.Now().Add(154 * time.Hour), "https://krupki.by/images/zastavki/deti_tvorchestvo_2.jpg", []string{"Кружок робототехники", "Художественная студия", "Спортивная секция", "Музыкальная группа"}},
-		{2, "choice", "Лучшее место для отдыха в Татарстане", "Опрос о том, какое место для отдыха в Татарстане вы считаете самым привлекательным.", "Министерство туризма Республики Татарстан", time.Now().Add(254 * time.Hour), "https://cdn.tripster.ru/thumbs2/1d8c9102-e90d-11ed-9add-42476a0af5aa.1220x600.jpeg", []string{"Казанская набережная", "Национальный парк «Шульган-Таш»", "Озеро Кабан", "Гора Муслюмово"}},
-		{3, "petition", "Создание велодорожек в Казани", "Поддержите петицию о создании велодорожек для безопасного передвижения велосипедистов по городу.", "Группа инициативных граждан", time.Now().Add(204 * time.Hour), "https://sun9-66.userapi.com/impg/0PdgWVSRvBbkcwrwuNbNhTZfU-Tk6S0oPH4cKQ/5awLbsk3B_M.jpg?size=1052x596&quality=95&sign=c1b6b3e55f319113dbd14a8e0fd03ada&type=album", []string{}},
-		{4, "petition", "Запрос на улучшение общественного транспорта", "Подпишите петицию за улучшение качества общественного транспорта в нашем районе.", "Общественное движение «Транспорт для всех»", time.Now().Add(554 * time.Hour), "https://kazantransport.ru/information_items_property_761.jpg", []string{}},
-		{5, "rate", "Отзыв о работе общественного транспорта", "Поделитесь своим мнением о качестве работы общественного транспорта в вашем районе. Ваши отзывы помогут улучшить сервис.", "Министерство транспорта Республики Татарстан", time.Now().Add(354 * time.Hour), "https://sun9-68.userapi.com/s/v1/ig2/ZcNGIpVANdONHaduKo_AyI_ZGO70gCmsJoERl6ueb2qWLKHp20zyZ0VT1XjRrqjNDCdtNMFiphriuiolRj5PyDls.jpg?quality=95&as=32x24,48x36,72x54,108x81,160x120,240x180,360x270,480x360,540x405,640x480,720x540,870x653&from=bu&u=bAdxtPh4rqpatU9DDn8YeaUbV95ztvCXd3J8ADBTqaQ&cs=807x606", []string{}},
-		{6, "rate", "Отзыв о культурном мероприятии", "Поделитесь своим впечатлением о культурном мероприятии, которое вы посетили. Ваши отзывы помогут организовать лучшие события в будущем.", "Управление культуры Республики Татарстан", time.Now().Add(194 * time.Hour), "https://ucare.timepad.ru/a7c550ce-b1a7-4ee2-ab8f-81759077108c/-/preview/600x600/", []string{}},
-	}
+// SeedVotes stores the votes not stored yet together with their options and returns how many were added. A vote is
+// already stored when one with the same category and name exists, deleted ones included, so seeding neither
+// duplicates votes nor brings back deleted ones.
+func (s *PostgresStorage) SeedVotes(ctx context.Context, votes []*Vote) (int, error) {
+	const op = "storage.postgresql.SeedVotes"
 
 	tx, err := s.db.Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("%s: %w", op, err)
+		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 	defer tx.Rollback(ctx)
 
+	added := 0
 	for _, vote := range votes {
 		var voteID int
 		err := tx.QueryRow(ctx, `
-            INSERT INTO votes (category, name, description, organization, photo, end_time)
-            VALUES ($1, $2, $3, $4, $5, $6)
-            RETURNING id`,
-			vote.Category, vote.Name, vote.Description, vote.Organization, vote.Photo, vote.EndTime).Scan(&voteID)
+			INSERT INTO votes (category, name, description, organization, photo, end_time, signature_threshold, petition_state,
+				state_changed_at, min_selections, max_selections)
+			SELECT $1, $2, $3, $4, $5, $6, $7, $8, NOW(), $9, $10
+			WHERE NOT EXISTS (SELECT 1 FROM votes WHERE category = $1 AND name = $2)
+			RETURNING id`,
+			vote.Category, vote.Name, vote.Description, vote.Organization, vote.Photo, vote.EndTime,
+			vote.SignatureThreshold, initialPetitionState(vote.Category), vote.MinSelections, vote.MaxSelections).Scan(&voteID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			continue
+		}
 		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
+			return 0, fmt.Errorf("%s: %w", op, err)
 		}
 
 		for _, option := range vote.Options {
 			_, err = tx.Exec(ctx, `INSERT INTO options (vote_id, option) VALUES ($1, $2)`, voteID, option)
 			if err != nil {
-				return fmt.Errorf("%s: %w", op, err)
+				return 0, fmt.Errorf("%s: %w", op, err)
 			}
 		}
+		added++
 	}
 
 	if err := tx.Commit(ctx); err != nil {
-		return fmt.Errorf("%s: %w", op, err)
-	}
-	return nil
-}
-
-func (s *PostgresStorage) CreateTables(ctx context.Context) error {
-	const op = "storage.postgresql.CreateTables"
-	tables := []struct {
-		name  string
-		query string
-	}{
-		{
-			name: "votes",
-			query: `
-				CREATE TABLE IF NOT EXISTS votes (
-					id SERIAL PRIMARY KEY,
-					category VARCHAR(255),
-					name TEXT,
-					description TEXT,
-					organization TEXT,
-					photo TEXT,
-					end_time TIMESTAMP
-				)`,
-		},
-		{
-			name: "options",
-			query: `
-				CREATE TABLE IF NOT EXISTS options (
-					vote_id INT REFERENCES votes(id) ON DELETE CASCADE,
-					option VARCHAR(255)
-				)`,
-		},
-		{
-			name: "rate_results",
-			query: `
-				CREATE TABLE IF NOT EXISTS rate_results (
-					vote_id INT REFERENCES votes(id) ON DELETE CASCADE,
-					user_token TEXT,
-					rate INT,
-					UNIQUE (vote_id, user_token)
-				)`,
-		},
-		{
-			name: "petition_results",
-			query: `
-				CREATE TABLE IF NOT EXISTS petition_results (
-					vote_id INT REFERENCES votes(id) ON DELETE CASCADE,
-					user_token TEXT,
-					support VARCHAR(50),
-					UNIQUE (vote_id, user_token)
-				)`,
-		},
-		{
-			name: "choices_results",
-			query: `
-				CREATE TABLE IF NOT EXISTS choices_results (
-					vote_id INT REFERENCES votes(id) ON DELETE CASCADE,
-					user_token TEXT,
-					choice TEXT,
-					UNIQUE (vote_id, user_token)
-				)`,
-		},
-	}
-
-	for _, table := range tables {
-		_, err := s.db.Exec(ctx, table.query)
-		if err != nil {
-			return fmt.Errorf("%s: %w", op, err)
-		}
+		return 0, fmt.Errorf("%s: %w", op, err)
 	}
-	return nil
+	return added, nil
 }
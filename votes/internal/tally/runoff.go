@@ -0,0 +1,123 @@
+// Package tally computes the results of ranked-choice votes.
+package tally
+
+// Round is a round of an instant-runoff count.
+type Round struct {
+	// Counts is the number of ballots counted for each option still in the count.
+	Counts map[string]int
+	// Eliminated is the option eliminated after the round, empty in the last round.
+	Eliminated string
+	// Exhausted is the number of ballots whose ranked options were all eliminated.
+	Exhausted int
+}
+
+// Result is an instant-runoff count, the last round has the winner or the tie.
+type Result struct {
+	Rounds []Round
+	// Winner is empty when there are no ballots or the remaining options are tied.
+	Winner string
+}
+
+// InstantRunoff counts ballots, each ranking some of options from the most preferred one. Every round a ballot counts
+// for its most preferred option still in the count. An option counted by more than half of the ballots not exhausted
+// wins, otherwise the option with the fewest ballots is eliminated and the next round is counted. A tie for the fewest
+// ballots is broken by the counts of the previous rounds, latest first, and then by eliminating the option listed
+// last in options. The count stops without a winner when all the remaining options have the same number of ballots.
+// Options of a ballot missing from options are ignored.
+func InstantRunoff(options []string, ballots [][]string) Result {
+	remaining := make(map[string]bool, len(options))
+	for _, option := range options {
+		remaining[option] = true
+	}
+
+	var result Result
+	if len(ballots) == 0 || len(options) == 0 {
+		return result
+	}
+
+	for {
+		round := Round{Counts: make(map[string]int, len(remaining))}
+		for option := range remaining {
+			round.Counts[option] = 0
+		}
+		active := 0
+		for _, ballot := range ballots {
+			if option, ok := firstRemaining(ballot, remaining); ok {
+				round.Counts[option]++
+				active++
+			} else {
+				round.Exhausted++
+			}
+		}
+
+		for option, count := range round.Counts {
+			if 2*count > active {
+				result.Rounds = append(result.Rounds, round)
+				result.Winner = option
+				return result
+			}
+		}
+		if len(remaining) == 1 || allEqual(round.Counts) {
+			// A single remaining option without a majority means every ballot is exhausted.
+			result.Rounds = append(result.Rounds, round)
+			return result
+		}
+
+		round.Eliminated = loser(options, remaining, round.Counts, result.Rounds)
+		delete(remaining, round.Eliminated)
+		result.Rounds = append(result.Rounds, round)
+	}
+}
+
+func firstRemaining(ballot []string, remaining map[string]bool) (string, bool) {
+	for _, option := range ballot {
+		if remaining[option] {
+			return option, true
+		}
+	}
+	return "", false
+}
+
+func allEqual(counts map[string]int) bool {
+	first := -1
+	for _, count := range counts {
+		if first == -1 {
+			first = count
+		} else if count != first {
+			return false
+		}
+	}
+	return true
+}
+
+// loser returns the remaining option to eliminate after the round with counts, previous are the rounds before it.
+func loser(options []string, remaining map[string]bool, counts map[string]int, previous []Round) string {
+	tied := fewest(options, remaining, counts)
+	for i := len(previous) - 1; i >= 0 && len(tied) > 1; i-- {
+		candidates := make(map[string]bool, len(tied))
+		for _, option := range tied {
+			candidates[option] = true
+		}
+		tied = fewest(options, candidates, previous[i].Counts)
+	}
+	return tied[len(tied)-1]
+}
+
+// fewest returns the options of candidates with the fewest counts, in the order of options.
+func fewest(options []string, candidates map[string]bool, counts map[string]int) []string {
+	var result []string
+	least := -1
+	for _, option := range options {
+		if !candidates[option] {
+			continue
+		}
+		switch count := counts[option]; {
+		case least == -1 || count < least:
+			least = count
+			result = []string{option}
+		case count == least:
+			result = append(result, option)
+		}
+	}
+	return result
+}
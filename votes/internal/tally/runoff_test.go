@@ -0,0 +1,159 @@
+package tally
+
+import (
+	"reflect"
+	"testing"
+)
+
+func repeat(ballot []string, n int) [][]string {
+	ballots := make([][]string, n)
+	for i := range ballots {
+		ballots[i] = ballot
+	}
+	return ballots
+}
+
+func join(groups ...[][]string) [][]string {
+	var ballots [][]string
+	for _, group := range groups {
+		ballots = append(ballots, group...)
+	}
+	return ballots
+}
+
+func TestInstantRunoff(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []string
+		ballots [][]string
+		want    Result
+	}{
+		{
+			name:    "first-round majority",
+			options: []string{"A", "B", "C"},
+			ballots: [][]string{{"A", "B"}, {"A"}, {"B", "A"}},
+			want: Result{
+				Rounds: []Round{{Counts: map[string]int{"A": 2, "B": 1, "C": 0}}},
+				Winner: "A",
+			},
+		},
+		{
+			name:    "multi-round elimination",
+			options: []string{"A", "B", "C", "D"},
+			ballots: join(repeat([]string{"A"}, 4), repeat([]string{"B"}, 3), repeat([]string{"C", "B"}, 2), repeat([]string{"D", "B"}, 1)),
+			want: Result{
+				Rounds: []Round{
+					{Counts: map[string]int{"A": 4, "B": 3, "C": 2, "D": 1}, Eliminated: "D"},
+					{Counts: map[string]int{"A": 4, "B": 4, "C": 2}, Eliminated: "C"},
+					{Counts: map[string]int{"A": 4, "B": 6}},
+				},
+				Winner: "B",
+			},
+		},
+		{
+			// B and C tie in the second round, B had fewer ballots in the first one, so it goes although it is listed
+			// before C.
+			name:    "previous-round tie-break",
+			options: []string{"A", "B", "C", "D"},
+			ballots: join(repeat([]string{"A"}, 4), repeat([]string{"B"}, 2), repeat([]string{"C"}, 3), repeat([]string{"D", "B"}, 1)),
+			want: Result{
+				Rounds: []Round{
+					{Counts: map[string]int{"A": 4, "B": 2, "C": 3, "D": 1}, Eliminated: "D"},
+					{Counts: map[string]int{"A": 4, "B": 3, "C": 3}, Eliminated: "B"},
+					{Counts: map[string]int{"A": 4, "C": 3}, Exhausted: 3},
+				},
+				Winner: "A",
+			},
+		},
+		{
+			name:    "options-order tie-break",
+			options: []string{"A", "B", "C"},
+			ballots: [][]string{{"A"}, {"A"}, {"B", "A"}, {"C", "B"}},
+			want: Result{
+				Rounds: []Round{
+					{Counts: map[string]int{"A": 2, "B": 1, "C": 1}, Eliminated: "C"},
+					{Counts: map[string]int{"A": 2, "B": 2}},
+				},
+			},
+		},
+		{
+			name:    "options-order tie-break with reversed options",
+			options: []string{"C", "B", "A"},
+			ballots: [][]string{{"A"}, {"A"}, {"B", "A"}, {"C", "B"}},
+			want: Result{
+				Rounds: []Round{
+					{Counts: map[string]int{"A": 2, "B": 1, "C": 1}, Eliminated: "B"},
+					{Counts: map[string]int{"A": 3, "C": 1}},
+				},
+				Winner: "A",
+			},
+		},
+		{
+			// A wins with 3 of 7 ballots, a majority of the 5 ballots not exhausted.
+			name:    "exhausted ballots",
+			options: []string{"A", "B", "C"},
+			ballots: join(repeat([]string{"A"}, 3), repeat([]string{"B"}, 2), repeat([]string{"C"}, 2)),
+			want: Result{
+				Rounds: []Round{
+					{Counts: map[string]int{"A": 3, "B": 2, "C": 2}, Eliminated: "C"},
+					{Counts: map[string]int{"A": 3, "B": 2}, Exhausted: 2},
+				},
+				Winner: "A",
+			},
+		},
+		{
+			name:    "all equal stops",
+			options: []string{"A", "B", "C"},
+			ballots: [][]string{{"A"}, {"B"}, {"C"}},
+			want: Result{
+				Rounds: []Round{{Counts: map[string]int{"A": 1, "B": 1, "C": 1}}},
+			},
+		},
+		{
+			name:    "all equal after elimination",
+			options: []string{"A", "B", "C"},
+			ballots: [][]string{{"A"}, {"B"}},
+			want: Result{
+				Rounds: []Round{
+					{Counts: map[string]int{"A": 1, "B": 1, "C": 0}, Eliminated: "C"},
+					{Counts: map[string]int{"A": 1, "B": 1}},
+				},
+			},
+		},
+		{
+			name:    "unknown options",
+			options: []string{"A", "B"},
+			ballots: [][]string{{"X", "A"}, {"B"}, {"Y"}, {"A", "Z"}},
+			want: Result{
+				Rounds: []Round{{Counts: map[string]int{"A": 2, "B": 1}, Exhausted: 1}},
+				Winner: "A",
+			},
+		},
+		{
+			name:    "only unknown options",
+			options: []string{"A"},
+			ballots: [][]string{{"X"}},
+			want: Result{
+				Rounds: []Round{{Counts: map[string]int{"A": 0}, Exhausted: 1}},
+			},
+		},
+		{
+			name:    "no ballots",
+			options: []string{"A", "B"},
+			want:    Result{},
+		},
+		{
+			name:    "no options",
+			ballots: [][]string{{"A"}},
+			want:    Result{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InstantRunoff(tt.options, tt.ballots); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InstantRunoff() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}